@@ -1,14 +1,24 @@
 package main
 
 import (
+	"context"
+	_ "expvar"
+	"flag"
 	"log"
 	"net"
+	"net/http"
 
 	"github.com/Anacardo89/lenic_api/config"
+	"github.com/Anacardo89/lenic_api/internal/bridge"
+	"github.com/Anacardo89/lenic_api/internal/data/cache"
+	"github.com/Anacardo89/lenic_api/internal/data/loader"
+	"github.com/Anacardo89/lenic_api/internal/data/memory"
 	"github.com/Anacardo89/lenic_api/internal/data/orm"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
 	"github.com/Anacardo89/lenic_api/internal/endpoints"
 	"github.com/Anacardo89/lenic_api/internal/interceptor"
-	"github.com/Anacardo89/lenic_api/internal/pb"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"github.com/Anacardo89/lenic_api/internal/search"
 	"github.com/Anacardo89/lenic_api/internal/server"
 	"github.com/Anacardo89/lenic_api/pkg/db"
 	"github.com/Anacardo89/lenic_api/pkg/logger"
@@ -16,20 +26,56 @@ import (
 )
 
 func main() {
+	inMemory := flag.Bool("memory", false, "keep all data in memory instead of the database")
+	flag.Parse()
+
 	logger.CreateLogger()
+
+	if flag.Arg(0) == "migrate" {
+		_, dialect := connectDB()
+		if err := migrate(db.Dbase, dialect, flag.Args()[1:]); err != nil {
+			logger.Error.Fatalln("Migrate failed: ", err)
+		}
+		return
+	}
+
 	logger.Info.Println("System start")
 
 	// DB
-	dbConfig, err := config.LoadDBConfig()
-	if err != nil {
-		logger.Error.Fatalln("Could not load dbConfig:", err)
+	var (
+		repos repo.Repositories
+		err   error
+	)
+	if *inMemory {
+		repos = memory.New().Repositories()
+		logger.Info.Println("Using in-memory store")
+	} else {
+		dbConfig, dialect := connectDB()
+		warnPendingMigrations(db.Dbase, dialect)
+		da := orm.New(db.Dbase, dialect, dbConfig.QueryTimeout)
+		replicas, err := db.LoginReplicas(dbConfig)
+		if err != nil {
+			logger.Error.Fatalln("Could not connect to DB replicas: ", err)
+		}
+		if len(replicas) > 0 {
+			da.UseReplicas(replicas, dbConfig.PrimaryPin)
+			logger.Info.Println("Reading from", len(replicas), "replicas")
+		}
+		repos = da.Repositories()
+		if dbConfig.CacheSize > 0 {
+			repos = cache.New(dbConfig.CacheSize, dbConfig.CacheTTL).Wrap(repos)
+			logger.Info.Println("Caching up to", dbConfig.CacheSize, "entries per table")
+		}
 	}
-	db.Dbase, err = db.LoginDB(dbConfig)
-	if err != nil {
-		logger.Error.Fatalln("Could not connect to DB: ", err)
+
+	index := search.New()
+	if err := index.Build(context.Background(), repos); err != nil {
+		logger.Error.Fatalln("Could not build search index: ", err)
 	}
-	orm.Da.Db = db.Dbase
-	logger.Info.Println("Connecting to DB OK")
+	repos = index.Wrap(repos)
+	logger.Info.Println("Search index built")
+
+	repos = loader.Wrap(repos)
 
 	// Server
 	server.Server, err = config.LoadServerConfig()
@@ -38,14 +84,32 @@ func main() {
 	}
 	logger.Info.Println("Loading serverConfig OK")
 
+	if server.Server.MetricsPort != "" {
+		go func() {
+			logger.Info.Println("Serving metrics on port " + server.Server.MetricsPort)
+			if err := http.ListenAndServe(":"+server.Server.MetricsPort, nil); err != nil {
+				logger.Error.Println("Metrics listener stopped: ", err)
+			}
+		}()
+	}
+
+	accountGrace := server.Server.AccountGrace
+	if accountGrace <= 0 {
+		accountGrace = defaultAccountGrace
+	}
+	go purgeDeletedUsers(repos, accountGrace)
+
+	auth := interceptor.New(repos)
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(interceptor.AuthUnaryInterceptor),
-		grpc.StreamInterceptor(interceptor.AuthStreamInterceptor),
+		grpc.ChainUnaryInterceptor(interceptor.ValidateUnaryInterceptor, auth.AuthUnaryInterceptor),
+		grpc.ChainStreamInterceptor(interceptor.ValidateStreamInterceptor, auth.AuthStreamInterceptor),
 	}
 
 	s := grpc.NewServer(opts...)
 
-	pb.RegisterLenicServer(s, &endpoints.ApiService{})
+	api := endpoints.NewApiService(repos, accountGrace, index)
+	pb.RegisterLenicServer(s, api)
+	bridge.Register(s, api)
 
 	lis, err := net.Listen("tcp", ":"+server.Server.GrpcPort)
 	if err != nil {
@@ -57,3 +121,20 @@ func main() {
 		logger.Error.Fatalln("failed to serve: ", err)
 	}
 }
+
+func connectDB() (*db.Config, db.Dialect) {
+	dbConfig, err := config.LoadDBConfig()
+	if err != nil {
+		logger.Error.Fatalln("Could not load dbConfig:", err)
+	}
+	db.Dbase, err = db.LoginDB(dbConfig)
+	if err != nil {
+		logger.Error.Fatalln("Could not connect to DB: ", err)
+	}
+	dialect, err := dbConfig.Dialect()
+	if err != nil {
+		logger.Error.Fatalln("Could not load DB dialect: ", err)
+	}
+	logger.Info.Println("Connecting to DB OK")
+	return dbConfig, dialect
+}
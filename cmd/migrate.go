@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/Anacardo89/lenic_api/internal/data/migrations"
+	"github.com/Anacardo89/lenic_api/pkg/db"
+	"github.com/Anacardo89/lenic_api/pkg/logger"
+)
+
+const migrateUsage = `usage: lenic_api migrate <command>
+
+commands:
+  up                 apply every pending migration
+  down [n]           revert the last n migrations, 1 by default
+  status             list migrations and when they were applied
+  baseline <version> mark migrations up to version as applied without
+                     running them, e.g. baseline 1 on a lenic database`
+
+// migrate runs the migrate subcommand with its arguments
+func migrate(database *sql.DB, dialect db.Dialect, args []string) error {
+	m, err := migrations.New(database, dialect)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return usageError()
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := m.Up(ctx)
+		for _, mig := range applied {
+			fmt.Printf("applied %04d_%s\n", mig.Version, mig.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("nothing to apply")
+		}
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return usageError()
+			}
+		}
+		reverted, err := m.Down(ctx, steps)
+		for _, mig := range reverted {
+			fmt.Printf("reverted %04d_%s\n", mig.Version, mig.Name)
+		}
+		return err
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			applied := "pending"
+			if !s.AppliedAt.IsZero() {
+				applied = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-30s %s\n", s.Version, s.Name, applied)
+		}
+		return nil
+	case "baseline":
+		if len(args) < 2 {
+			return usageError()
+		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return usageError()
+		}
+		return m.Baseline(ctx, version)
+	default:
+		return usageError()
+	}
+}
+
+// warnPendingMigrations logs migrations the database is missing, the API
+// doesn't apply them by itself
+func warnPendingMigrations(database *sql.DB, dialect db.Dialect) {
+	m, err := migrations.New(database, dialect)
+	if err != nil {
+		logger.Error.Println("Could not load migrations: ", err)
+		return
+	}
+	pending, err := m.Pending(context.Background())
+	if err != nil {
+		logger.Error.Println("Could not check migrations: ", err)
+		return
+	}
+	for _, mig := range pending {
+		logger.Error.Printf("Migration %04d_%s is pending, run `migrate up`\n", mig.Version, mig.Name)
+	}
+}
+
+func usageError() error {
+	fmt.Fprintln(os.Stderr, migrateUsage)
+	return fmt.Errorf("invalid migrate arguments")
+}
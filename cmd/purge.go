@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"github.com/Anacardo89/lenic_api/pkg/logger"
+)
+
+const (
+	// defaultAccountGrace is how long a deleted account can be restored
+	// when serverConfig doesn't say
+	defaultAccountGrace = 30 * 24 * time.Hour
+	// purgeInterval is how often accounts past their grace period are
+	// looked for
+	purgeInterval = time.Hour
+)
+
+// purgeDeletedUsers deletes the accounts deleted more than grace ago for
+// good every purgeInterval, it runs for the life of the process
+func purgeDeletedUsers(repos repo.Repositories, grace time.Duration) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+	for {
+		n, err := repos.Users.PurgeDeletedUsers(context.Background(), time.Now().Add(-grace))
+		if err != nil {
+			logger.Error.Println("Could not purge deleted users: ", err)
+		} else if n > 0 {
+			logger.Info.Println("Purged", n, "deleted users")
+		}
+		<-ticker.C
+	}
+}
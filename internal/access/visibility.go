@@ -0,0 +1,60 @@
+package access
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+// CanViewPost reports whether the user with viewer_id is allowed to see p.
+// Comments and ratings of a post follow the same rules as the post itself.
+// Posts of deleted accounts can't be seen by anyone, nor posts whose author
+// and viewer are blocked either way.
+func CanViewPost(ctx context.Context, r repo.Repositories, viewer_id int, p *model.Post) (bool, error) {
+	if p.Active == model.ContentAuthorDeleted {
+		return false, nil
+	}
+	if p.AuthorId == viewer_id {
+		return true, nil
+	}
+	blocked, err := Blocked(ctx, r, p.AuthorId, viewer_id)
+	if err != nil {
+		return false, err
+	}
+	if blocked {
+		return false, nil
+	}
+	switch p.Visibility {
+	case model.VisibilityPublic, model.VisibilityUnlisted:
+		return true, nil
+	case model.VisibilityFollowers:
+		f, err := r.Follows.GetUserFollows(ctx, viewer_id, p.AuthorId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return false, nil
+			}
+			return false, err
+		}
+		return f.Status == model.FollowAccepted, nil
+	case model.VisibilityCloseFriends:
+		return r.Follows.IsCloseFriend(ctx, p.AuthorId, viewer_id)
+	case model.VisibilitySpecificUsers:
+		return r.Posts.IsInPostAudience(ctx, p.Id, viewer_id)
+	default:
+		return false, nil
+	}
+}
+
+// Blocked reports whether either of the users with a_id and b_id blocked
+// the other
+func Blocked(ctx context.Context, r repo.Repositories, a_id int, b_id int) (bool, error) {
+	for _, pair := range [][2]int{{a_id, b_id}, {b_id, a_id}} {
+		blocked, err := r.Follows.IsBlocked(ctx, pair[0], pair[1])
+		if err != nil || blocked {
+			return blocked, err
+		}
+	}
+	return false, nil
+}
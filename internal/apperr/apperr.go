@@ -0,0 +1,179 @@
+// Package apperr is the error model of the API.
+//
+// Handlers return an *Error for anything the client should be told about.
+// It reaches the client as a status with its code, an ErrorInfo with a
+// reason the client can switch on and, for invalid requests, a BadRequest
+// with the fields at fault. Any other error is Internal: it's logged and
+// the client only gets "internal error", so SQL and driver errors stay on
+// the server.
+package apperr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain is the ErrorInfo domain of every error
+const Domain = "lenic.api"
+
+// Error is a failure the client is told about
+type Error struct {
+	Code codes.Code
+	// Reason is the ErrorInfo reason, an UPPER_SNAKE_CASE constant
+	Reason string
+	Msg    string
+	// Violations are the fields an InvalidArgument error is about
+	Violations []Violation
+	// Metadata goes in the ErrorInfo, like the resource that wasn't found
+	Metadata map[string]string
+}
+
+// Violation is a field of the request that isn't valid, Field is its path
+// in the request, like "post.title"
+type Violation struct {
+	Field       string
+	Description string
+}
+
+func (e *Error) Error() string {
+	return e.Msg
+}
+
+// GRPCStatus returns the status the client gets for e
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.Code, e.Msg)
+	info := &errdetails.ErrorInfo{
+		Reason:   e.Reason,
+		Domain:   Domain,
+		Metadata: e.Metadata,
+	}
+	var err error
+	if len(e.Violations) == 0 {
+		st, err = st.WithDetails(info)
+	} else {
+		br := &errdetails.BadRequest{}
+		for _, v := range e.Violations {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		st, err = st.WithDetails(info, br)
+	}
+	if err != nil {
+		return status.New(e.Code, e.Msg)
+	}
+	return st
+}
+
+func newError(code codes.Code, reason string, format string, a ...interface{}) *Error {
+	return &Error{Code: code, Reason: reason, Msg: fmt.Sprintf(format, a...)}
+}
+
+// NotFound reports that the resource, like "user" or "post", doesn't exist
+// or can't be seen by the caller
+func NotFound(resource string) *Error {
+	e := newError(codes.NotFound, reasonOf(resource, "NOT_FOUND"), "%s not found", resource)
+	e.Metadata = map[string]string{"resource": resource}
+	return e
+}
+
+// AlreadyExists reports that the resource clashes with one that exists
+func AlreadyExists(resource string) *Error {
+	e := newError(codes.AlreadyExists, reasonOf(resource, "ALREADY_EXISTS"), "%s already exists", resource)
+	e.Metadata = map[string]string{"resource": resource}
+	return e
+}
+
+// PermissionDenied reports that the caller may not do what they asked
+func PermissionDenied(reason string, format string, a ...interface{}) *Error {
+	return newError(codes.PermissionDenied, reason, format, a...)
+}
+
+// Conflict reports a request that doesn't apply to the current state of
+// what it acts on, like accepting a follow that isn't pending
+func Conflict(reason string, format string, a ...interface{}) *Error {
+	return newError(codes.FailedPrecondition, reason, format, a...)
+}
+
+// Unauthenticated reports a missing or invalid token or credentials
+func Unauthenticated(reason string, format string, a ...interface{}) *Error {
+	return newError(codes.Unauthenticated, reason, format, a...)
+}
+
+// Invalid reports that the request has the fields of violations wrong
+func Invalid(violations ...Violation) *Error {
+	msg := "invalid request"
+	if len(violations) > 0 {
+		msg = fmt.Sprintf("invalid %s: %s", violations[0].Field, violations[0].Description)
+	}
+	return &Error{
+		Code:       codes.InvalidArgument,
+		Reason:     "INVALID_ARGUMENT",
+		Msg:        msg,
+		Violations: violations,
+	}
+}
+
+// InvalidField is Invalid for one field
+func InvalidField(field string, format string, a ...interface{}) *Error {
+	return Invalid(Violation{Field: field, Description: fmt.Sprintf(format, a...)})
+}
+
+func reasonOf(resource string, what string) string {
+	return strings.ToUpper(strings.ReplaceAll(resource, " ", "_")) + "_" + what
+}
+
+// From maps the errors of the repositories about resource to the ones the
+// client is told about: sql.ErrNoRows is NotFound, repo.ErrDuplicate is
+// AlreadyExists and the other repo errors are Conflicts. Anything else is
+// returned as is, to end up Internal
+func From(err error, resource string) error {
+	var e *Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &e):
+		return e
+	case errors.Is(err, sql.ErrNoRows):
+		return NotFound(resource)
+	case errors.Is(err, repo.ErrDuplicate):
+		return AlreadyExists(resource)
+	case errors.Is(err, repo.ErrInvalidFollowTransition):
+		return Conflict("INVALID_FOLLOW_TRANSITION", "no such follow or follow request")
+	case errors.Is(err, repo.ErrNotRestorable):
+		return Conflict("NOT_RESTORABLE", "account can't be restored")
+	default:
+		return err
+	}
+}
+
+// Status returns the status the client gets for err. It's the status of
+// an *Error err wraps, or of a status error, and Internal otherwise, with
+// internal set for the caller to log err
+func Status(err error) (st *status.Status, internal bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.GRPCStatus(), false
+	}
+	// the message is the context's alone, the data layer wraps queries the
+	// database cancelled in it with the driver error
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, context.Canceled.Error()), false
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, context.DeadlineExceeded.Error()), false
+	}
+	if st, ok := status.FromError(err); ok {
+		return st, st.Code() == codes.Unknown || st.Code() == codes.Internal
+	}
+	return status.New(codes.Internal, "internal error"), true
+}
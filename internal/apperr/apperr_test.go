@@ -0,0 +1,87 @@
+package apperr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFrom(t *testing.T) {
+	other := errors.New("driver error")
+	for _, c := range []struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{err: fmt.Errorf("query: %w", sql.ErrNoRows), code: codes.NotFound, reason: "POST_NOT_FOUND"},
+		{err: fmt.Errorf("insert: %w", repo.ErrDuplicate), code: codes.AlreadyExists, reason: "POST_ALREADY_EXISTS"},
+		{err: repo.ErrInvalidFollowTransition, code: codes.FailedPrecondition, reason: "INVALID_FOLLOW_TRANSITION"},
+		{err: repo.ErrNotRestorable, code: codes.FailedPrecondition, reason: "NOT_RESTORABLE"},
+		{err: fmt.Errorf("wrapped: %w", NotFound("user")), code: codes.NotFound, reason: "USER_NOT_FOUND"},
+	} {
+		var e *Error
+		if !errors.As(From(c.err, "post"), &e) {
+			t.Errorf("From(%v) isn't an *Error", c.err)
+			continue
+		}
+		if e.Code != c.code || e.Reason != c.reason {
+			t.Errorf("From(%v) is %v %s, want %v %s", c.err, e.Code, e.Reason, c.code, c.reason)
+		}
+	}
+	if err := From(other, "post"); err != other {
+		t.Errorf("From kept %v as %v, want it as is", other, err)
+	}
+	if From(nil, "post") != nil {
+		t.Error("From(nil) isn't nil")
+	}
+}
+
+func TestStatus(t *testing.T) {
+	st, internal := Status(fmt.Errorf("could not update: %w", Invalid(
+		Violation{Field: "post.title", Description: "is required"},
+		Violation{Field: "post.content", Description: "must be at most 10000 characters"},
+	)))
+	if internal || st.Code() != codes.InvalidArgument {
+		t.Fatalf("invalid request is %v, internal %v", st.Code(), internal)
+	}
+	var info *errdetails.ErrorInfo
+	var br *errdetails.BadRequest
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			br = d
+		}
+	}
+	if info == nil || info.Domain != Domain || info.Reason != "INVALID_ARGUMENT" {
+		t.Errorf("ErrorInfo is %v", info)
+	}
+	if br == nil || len(br.FieldViolations) != 2 || br.FieldViolations[0].Field != "post.title" {
+		t.Errorf("BadRequest is %v, want both fields", br)
+	}
+
+	for _, c := range []struct {
+		err      error
+		code     codes.Code
+		msg      string
+		internal bool
+	}{
+		{err: errors.New("pq: relation does not exist"), code: codes.Internal, msg: "internal error", internal: true},
+		{err: fmt.Errorf("%w: pq: canceling statement", context.DeadlineExceeded), code: codes.DeadlineExceeded, msg: context.DeadlineExceeded.Error()},
+		{err: fmt.Errorf("query: %w", context.Canceled), code: codes.Canceled, msg: context.Canceled.Error()},
+		{err: status.Error(codes.OutOfRange, "too big"), code: codes.OutOfRange, msg: "too big"},
+	} {
+		st, internal := Status(c.err)
+		if st.Code() != c.code || st.Message() != c.msg || internal != c.internal {
+			t.Errorf("Status(%v) is %v %q internal %v, want %v %q internal %v", c.err, st.Code(), st.Message(), internal, c.code, c.msg, c.internal)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Package bridge serves the v1 lenic.Lenic service with the v2
+// implementation, so clients built against lenic.proto keep working while
+// they move to lenic_v2.proto.
+//
+// Every v1 method calls its v2 handler, converting the messages field by
+// field on the way in and out, see convert. A v2 method that takes the v1
+// request in a field of its own, with an update mask next to it, gets it
+// in that field and a mask of the fields the v1 request writes. The v2
+// handlers and the unary interceptor they call only ever see v2 messages
+// and method names.
+package bridge
+
+import (
+	"context"
+	"strings"
+
+	pbv1 "github.com/Anacardo89/lenic_api/internal/pb"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Register serves the v1 service on s with srv
+func Register(s grpc.ServiceRegistrar, srv pb.LenicServer) {
+	s.RegisterService(&serviceDesc, srv)
+}
+
+var serviceDesc = legacyDesc()
+
+// legacyDesc is pbv1.Lenic_ServiceDesc with the handlers of
+// pb.Lenic_ServiceDesc
+func legacyDesc() grpc.ServiceDesc {
+	methods := map[string]methodHandler{}
+	for _, m := range pb.Lenic_ServiceDesc.Methods {
+		methods[m.MethodName] = m.Handler
+	}
+	streams := map[string]grpc.StreamHandler{}
+	for _, sd := range pb.Lenic_ServiceDesc.Streams {
+		streams[sd.StreamName] = sd.Handler
+	}
+
+	desc := grpc.ServiceDesc{
+		ServiceName: pbv1.Lenic_ServiceDesc.ServiceName,
+		HandlerType: (*pb.LenicServer)(nil),
+		Metadata:    pbv1.Lenic_ServiceDesc.Metadata,
+	}
+	for _, m := range pbv1.Lenic_ServiceDesc.Methods {
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: m.MethodName,
+			Handler:    unaryHandler(methods[m.MethodName], wrapped[m.MethodName]),
+		})
+	}
+	for _, sd := range pbv1.Lenic_ServiceDesc.Streams {
+		h := streams[sd.StreamName]
+		desc.Streams = append(desc.Streams, grpc.StreamDesc{
+			StreamName: sd.StreamName,
+			Handler: func(srv interface{}, ss grpc.ServerStream) error {
+				return h(srv, Stream(ss))
+			},
+			ServerStreams: sd.ServerStreams,
+			ClientStreams: sd.ClientStreams,
+		})
+	}
+	return desc
+}
+
+// methodHandler is the type of grpc.MethodDesc.Handler
+type methodHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// wrapper is where a v2 request holds the v1 one: the name of its field
+// and the update mask of what the v1 request writes
+type wrapper struct {
+	field protoreflect.Name
+	mask  func(in proto.Message) []string
+}
+
+// wrapped holds the v2 methods whose request holds the v1 one
+var wrapped = map[string]wrapper{
+	"UpdatePost": {field: "post", mask: postMask},
+	"UpdateComment": {field: "comment", mask: func(in proto.Message) []string {
+		return []string{"content"}
+	}},
+}
+
+// postMask writes the title and content of the v1 post in, which v1
+// updates always write, and its visibility and audience only when it sets
+// a visibility, so an update that leaves it out doesn't make the post
+// followers only
+func postMask(in proto.Message) []string {
+	p := in.(*pbv1.Post)
+	if p.Visibility == pbv1.Visibility_VISIBILITY_UNSPECIFIED && !p.IsPublic {
+		return []string{"title", "content"}
+	}
+	return []string{"title", "content", "visibility", "audience_ids"}
+}
+
+// unaryHandler calls h with the v1 request converted, in the field of the
+// v2 one and with the mask of w if it's set
+func unaryHandler(h methodHandler, w wrapper) methodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		out, err := h(srv, ctx, func(in interface{}) error {
+			m := in.(proto.Message).ProtoReflect()
+			if w.field == "" {
+				_, err := recv(dec, m.Interface())
+				return err
+			}
+			old, err := recv(dec, m.Mutable(m.Descriptor().Fields().ByName(w.field)).Message().Interface())
+			if err != nil {
+				return err
+			}
+			setMask(m, w.mask(old))
+			return nil
+		}, interceptor)
+		if err != nil || out == nil {
+			return out, err
+		}
+		return downgrade(out.(proto.Message))
+	}
+}
+
+// setMask sets the update_mask of m to paths
+func setMask(m protoreflect.Message, paths []string) {
+	mask := &fieldmaskpb.FieldMask{Paths: paths}
+	m.Set(m.Descriptor().Fields().ByName("update_mask"), protoreflect.ValueOfMessage(mask.ProtoReflect()))
+}
+
+// Method returns the v2 name of a v1 method, ok is false for other methods
+func Method(fullMethod string) (method string, ok bool) {
+	name, ok := strings.CutPrefix(fullMethod, "/"+pbv1.Lenic_ServiceDesc.ServiceName+"/")
+	if !ok {
+		return fullMethod, false
+	}
+	return "/" + pb.Lenic_ServiceDesc.ServiceName + "/" + name, true
+}
+
+type bridgedKey struct{}
+
+type stream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Stream returns ss reading and writing v2 messages over the v1 wire. The
+// stream interceptor wraps v1 streams itself to read their request, so a
+// stream that's already bridged, or wraps one, is returned as is
+func Stream(ss grpc.ServerStream) grpc.ServerStream {
+	if ss.Context().Value(bridgedKey{}) != nil {
+		return ss
+	}
+	return &stream{
+		ServerStream: ss,
+		ctx:          context.WithValue(ss.Context(), bridgedKey{}, true),
+	}
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stream) RecvMsg(m interface{}) error {
+	_, err := recv(s.ServerStream.RecvMsg, m.(proto.Message))
+	return err
+}
+
+func (s *stream) SendMsg(m interface{}) error {
+	old, err := downgrade(m.(proto.Message))
+	if err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(old)
+}
@@ -0,0 +1,231 @@
+package bridge
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/memory"
+	"github.com/Anacardo89/lenic_api/internal/endpoints"
+	pbv1 "github.com/Anacardo89/lenic_api/internal/pb"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"github.com/Anacardo89/lenic_api/internal/search"
+	"github.com/Anacardo89/lenic_api/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// server records the v2 requests it gets and answers with post
+type server struct {
+	pb.UnimplementedLenicServer
+	post     *pb.Post
+	updated  *pb.UpdatePostRequest
+	searched *pb.SearchPostsRequest
+}
+
+func (s *server) GetPost(ctx context.Context, in *pb.GetPostRequest) (*pb.Post, error) {
+	return s.post, nil
+}
+
+func (s *server) UpdatePost(ctx context.Context, in *pb.UpdatePostRequest) (*pb.UpdatePostResponse, error) {
+	s.updated = in
+	return &pb.UpdatePostResponse{}, nil
+}
+
+func (s *server) SearchPosts(in *pb.SearchPostsRequest, stream pb.Lenic_SearchPostsServer) error {
+	s.searched = in
+	return stream.Send(s.post)
+}
+
+// call runs the v1 method name on srv with the v1 request in
+func call(t *testing.T, srv pb.LenicServer, name string, in proto.Message) (interface{}, error) {
+	t.Helper()
+	return callAs(t, context.Background(), srv, name, in)
+}
+
+// callAs is call with the context ctx
+func callAs(t *testing.T, ctx context.Context, srv pb.LenicServer, name string, in proto.Message) (interface{}, error) {
+	t.Helper()
+	for _, m := range serviceDesc.Methods {
+		if m.MethodName == name {
+			return m.Handler(srv, ctx, func(m interface{}) error {
+				proto.Merge(m.(proto.Message), in)
+				return nil
+			}, nil)
+		}
+	}
+	t.Fatalf("no v1 method %s", name)
+	return nil, nil
+}
+
+// v1Stream sends req to the handler and keeps what it sends back
+type v1Stream struct {
+	grpc.ServerStream
+	req  proto.Message
+	sent []interface{}
+}
+
+func (s *v1Stream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *v1Stream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func (s *v1Stream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestUnaryResponse(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	srv := &server{post: &pb.Post{Id: 7, Title: "title", CreatedAt: timestamppb.New(created)}}
+	out, err := call(t, srv, "GetPost", &pbv1.GetPostRequest{Uuid: "guid"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, ok := out.(*pbv1.Post)
+	if !ok {
+		t.Fatalf("GetPost returned %T, want a v1 post", out)
+	}
+	if p.Id != 7 || p.Title != "title" || p.CreatedAt != "2024-01-02 03:04:05" {
+		t.Errorf("v1 post is %d %q created %q", p.Id, p.Title, p.CreatedAt)
+	}
+
+	srv.post.Id = 1 << 40
+	if _, err := call(t, srv, "GetPost", &pbv1.GetPostRequest{Uuid: "guid"}); status.Code(err) != codes.OutOfRange {
+		t.Errorf("an id past int32 returned %v, want OUT_OF_RANGE", err)
+	}
+}
+
+func TestUpdateMask(t *testing.T) {
+	srv := &server{}
+	out, err := call(t, srv, "UpdatePost", &pbv1.Post{PostGuid: "guid", AuthorId: 3, Title: "title"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, ok := out.(*pbv1.UpdatePostResponse); !ok || res.Response != "OK" {
+		t.Errorf("UpdatePost returned %v, want a v1 response saying OK", out)
+	}
+	if srv.updated.GetPost().GetPostGuid() != "guid" || srv.updated.GetPost().GetAuthorId() != 3 {
+		t.Errorf("v2 request holds %v, want the v1 post", srv.updated.GetPost())
+	}
+
+	every := []string{"title", "content", "visibility", "audience_ids"}
+	for _, c := range []struct {
+		in   *pbv1.Post
+		mask []string
+	}{
+		{in: &pbv1.Post{Title: "title"}, mask: []string{"title", "content"}},
+		{in: &pbv1.Post{Title: "title", IsPublic: true}, mask: every},
+		{in: &pbv1.Post{Title: "title", Visibility: pbv1.Visibility_VISIBILITY_SPECIFIC_USERS, AudienceIds: []int32{2}}, mask: every},
+	} {
+		if _, err := call(t, srv, "UpdatePost", c.in); err != nil {
+			t.Fatal(err)
+		}
+		if paths := srv.updated.GetUpdateMask().GetPaths(); !slices.Equal(paths, c.mask) {
+			t.Errorf("v1 post %v has update mask %q, want %q", c.in, paths, c.mask)
+		}
+	}
+}
+
+// TestTitleUpdateKeepsVisibility updates the title of a public post over
+// v1, the way a client that doesn't know about visibility would
+func TestTitleUpdateKeepsVisibility(t *testing.T) {
+	index := search.New()
+	srv := endpoints.NewApiService(index.Wrap(memory.New().Repositories()), time.Hour, index)
+	ctx := auth.NewContext(context.Background(), &auth.Claims{Username: "ann"})
+	ann, err := srv.CreateUser(context.Background(), &pb.User{Username: "ann", Email: "ann@example.com", Pass: "password"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := srv.CreatePost(ctx, &pb.Post{AuthorId: ann.Id, Title: "title", Content: "content", Visibility: pb.Visibility_VISIBILITY_PUBLIC})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := callAs(t, ctx, srv, "UpdatePost", &pbv1.Post{PostGuid: created.Uuid, AuthorId: int32(ann.Id), Title: "new title", Content: "content"}); err != nil {
+		t.Fatal(err)
+	}
+	p, err := srv.GetPost(ctx, &pb.GetPostRequest{Uuid: created.Uuid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Title != "new title" || p.Visibility != pb.Visibility_VISIBILITY_PUBLIC {
+		t.Errorf("post is %q and %v after the v1 update, want new title and still public", p.Title, p.Visibility)
+	}
+}
+
+func TestIsPublic(t *testing.T) {
+	srv := &server{}
+	for _, c := range []struct {
+		in   *pbv1.Post
+		want pb.Visibility
+	}{
+		{in: &pbv1.Post{IsPublic: true}, want: pb.Visibility_VISIBILITY_PUBLIC},
+		{in: &pbv1.Post{}, want: pb.Visibility_VISIBILITY_FOLLOWERS},
+		{in: &pbv1.Post{IsPublic: true, Visibility: pbv1.Visibility_VISIBILITY_CLOSE_FRIENDS}, want: pb.Visibility_VISIBILITY_CLOSE_FRIENDS},
+	} {
+		if _, err := call(t, srv, "UpdatePost", c.in); err != nil {
+			t.Fatal(err)
+		}
+		if got := srv.updated.GetPost().GetVisibility(); got != c.want {
+			t.Errorf("v1 post %v has visibility %v, want %v", c.in, got, c.want)
+		}
+	}
+
+	for v, public := range map[pb.Visibility]bool{
+		pb.Visibility_VISIBILITY_PUBLIC:    true,
+		pb.Visibility_VISIBILITY_UNLISTED:  false,
+		pb.Visibility_VISIBILITY_FOLLOWERS: false,
+	} {
+		srv.post = &pb.Post{Visibility: v}
+		out, err := call(t, srv, "GetPost", &pbv1.GetPostRequest{Uuid: "guid"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := out.(*pbv1.Post).IsPublic; got != public {
+			t.Errorf("%v post has is_public %v, want %v", v, got, public)
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	srv := &server{post: &pb.Post{Id: 7, Title: "title"}}
+	ss := &v1Stream{req: &pbv1.SearchPostsRequest{Query: "q", Since: "2024-01-02", Until: "2024-01-02"}}
+	for _, sd := range serviceDesc.Streams {
+		if sd.StreamName == "SearchPosts" {
+			if err := sd.Handler(srv, ss); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	if got := srv.searched.GetSince().AsTime(); !got.Equal(day) {
+		t.Errorf("since is %v, want %v", got, day)
+	}
+	if got, want := srv.searched.GetUntil().AsTime(), day.Add(24*time.Hour-time.Second); !got.Equal(want) {
+		t.Errorf("until is %v, want the end of the day %v", got, want)
+	}
+	if len(ss.sent) != 1 {
+		t.Fatalf("stream sent %d messages, want 1", len(ss.sent))
+	}
+	if p, ok := ss.sent[0].(*pbv1.Post); !ok || p.Id != 7 {
+		t.Errorf("stream sent %v, want the v1 post", ss.sent[0])
+	}
+}
+
+func TestMethod(t *testing.T) {
+	if m, ok := Method("/lenic.Lenic/GetPost"); !ok || m != "/lenic.v2.Lenic/GetPost" {
+		t.Errorf("v1 GetPost is %q, %v", m, ok)
+	}
+	if _, ok := Method("/lenic.v2.Lenic/GetPost"); ok {
+		t.Error("a v2 method was taken for a v1 one")
+	}
+}
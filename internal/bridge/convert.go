@@ -0,0 +1,197 @@
+package bridge
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	pbv1 "github.com/Anacardo89/lenic_api/internal/pb"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// layout is how v1 spells times, always in UTC
+const layout = "2006-01-02 15:04:05"
+
+const (
+	v1Package = "lenic."
+	v2Package = "lenic.v2."
+)
+
+// recv decodes a v1 message with dec into the v2 message m, and returns
+// the v1 message
+func recv(dec func(interface{}) error, m proto.Message) (proto.Message, error) {
+	old, err := counterpart(m)
+	if err != nil {
+		return nil, err
+	}
+	if err := dec(old); err != nil {
+		return nil, err
+	}
+	return old, convert(m.ProtoReflect(), old.ProtoReflect())
+}
+
+// downgrade returns the v1 version of the v2 message m
+func downgrade(m proto.Message) (proto.Message, error) {
+	old, err := counterpart(m)
+	if err != nil {
+		return nil, err
+	}
+	if err := convert(old.ProtoReflect(), m.ProtoReflect()); err != nil {
+		return nil, err
+	}
+	setOK(old.ProtoReflect())
+	return old, nil
+}
+
+// setOK fills the OK/NOK response v1 messages have and v2 dropped. It's
+// always OK, a v2 call that fails only sends its status
+func setOK(m protoreflect.Message) {
+	f := m.Descriptor().Fields().ByName("response")
+	if f != nil && f.Kind() == protoreflect.StringKind && !m.Has(f) {
+		m.Set(f, protoreflect.ValueOfString("OK"))
+	}
+}
+
+// counterpart returns an empty v1 message of the same name as the v2
+// message m
+func counterpart(m proto.Message) (proto.Message, error) {
+	name := string(m.ProtoReflect().Descriptor().FullName())
+	name, ok := strings.CutPrefix(name, v2Package)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "%s is not a v2 message", name)
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(v1Package + name))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "no v1 message for %s: %v", name, err)
+	}
+	return mt.New().Interface(), nil
+}
+
+// convert copies the fields of src into the fields of dst with the same
+// name, between int32 and int64 and between v1 time strings and
+// Timestamps. Fields dst doesn't have are dropped, unless fixes maps them
+func convert(dst, src protoreflect.Message) error {
+	var err error
+	fields := dst.Descriptor().Fields()
+	src.Range(func(from protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		to := fields.ByName(from.Name())
+		if to == nil {
+			return true
+		}
+		err = convertField(dst, to, from, v)
+		return err == nil
+	})
+	if fix := fixes[dst.Descriptor().FullName()]; err == nil && fix != nil {
+		fix(dst.Interface(), src.Interface())
+	}
+	return err
+}
+
+// fixes fill the fields of a converted message that have no counterpart of
+// the same name, by the name of the message converted to
+var fixes = map[protoreflect.FullName]func(dst, src proto.Message){
+	"lenic.v2.Post": upgradePost,
+	"lenic.Post":    downgradePost,
+}
+
+// upgradePost reads the visibility of a v1 post from is_public when it has
+// none, true is public and false followers only
+func upgradePost(dst, src proto.Message) {
+	p, old := dst.(*pb.Post), src.(*pbv1.Post)
+	if old.Visibility != pbv1.Visibility_VISIBILITY_UNSPECIFIED {
+		return
+	}
+	p.Visibility = pb.Visibility_VISIBILITY_FOLLOWERS
+	if old.IsPublic {
+		p.Visibility = pb.Visibility_VISIBILITY_PUBLIC
+	}
+}
+
+// downgradePost sets is_public for v1 clients that don't read visibility
+func downgradePost(dst, src proto.Message) {
+	dst.(*pbv1.Post).IsPublic = src.(*pb.Post).Visibility == pb.Visibility_VISIBILITY_PUBLIC
+}
+
+func convertField(dst protoreflect.Message, to, from protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch {
+	case from.IsMap() || to.IsMap():
+		return status.Errorf(codes.Internal, "can't convert map field %s", from.FullName())
+	case from.IsList():
+		list := dst.Mutable(to).List()
+		for i := 0; i < v.List().Len(); i++ {
+			e, err := convertValue(list.NewElement(), to, from, v.List().Get(i))
+			if err != nil {
+				return err
+			}
+			list.Append(e)
+		}
+		return nil
+	default:
+		e, err := convertValue(dst.NewField(to), to, from, v)
+		if err != nil {
+			return err
+		}
+		dst.Set(to, e)
+		return nil
+	}
+}
+
+// convertValue converts v, a value of from, to a value of to, empty is a
+// new value of to for messages to be converted into
+func convertValue(empty protoreflect.Value, to, from protoreflect.FieldDescriptor, v protoreflect.Value) (protoreflect.Value, error) {
+	switch {
+	case isTimestamp(from) && to.Kind() == protoreflect.StringKind:
+		ts := v.Message().Interface().(*timestamppb.Timestamp)
+		return protoreflect.ValueOfString(ts.AsTime().UTC().Format(layout)), nil
+	case from.Kind() == protoreflect.StringKind && isTimestamp(to):
+		t, err := parseTime(v.String(), from.Name() == "until")
+		if err != nil {
+			return v, status.Errorf(codes.InvalidArgument, "invalid %s: %v", from.Name(), err)
+		}
+		return protoreflect.ValueOfMessage(timestamppb.New(t).ProtoReflect()), nil
+	case from.Kind() == protoreflect.MessageKind && to.Kind() == protoreflect.MessageKind:
+		if err := convert(empty.Message(), v.Message()); err != nil {
+			return v, err
+		}
+		return empty, nil
+	case from.Kind() == protoreflect.Int32Kind && to.Kind() == protoreflect.Int64Kind:
+		return protoreflect.ValueOfInt64(v.Int()), nil
+	case from.Kind() == protoreflect.Int64Kind && to.Kind() == protoreflect.Int32Kind:
+		if v.Int() > math.MaxInt32 || v.Int() < math.MinInt32 {
+			return v, status.Errorf(codes.OutOfRange, "%s %d doesn't fit v1, use lenic.v2", from.Name(), v.Int())
+		}
+		return protoreflect.ValueOfInt32(int32(v.Int())), nil
+	case from.Kind() == to.Kind():
+		return v, nil
+	default:
+		return v, status.Error(codes.Internal, fmt.Sprintf("can't convert %s to %s", from.FullName(), to.FullName()))
+	}
+}
+
+func isTimestamp(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind &&
+		fd.Message().FullName() == (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
+}
+
+// parseTime reads a v1 time, which could also be a date for the search
+// ranges, a date as until covers the whole day
+func parseTime(value string, until bool) (time.Time, error) {
+	if t, err := time.Parse(layout, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if until {
+		t = t.Add(24*time.Hour - time.Second)
+	}
+	return t, nil
+}
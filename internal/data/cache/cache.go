@@ -0,0 +1,133 @@
+// Package cache keeps recently read users, posts and comments in process
+// memory across requests.
+//
+// Cache.Wrap puts it in front of repositories. Rows are kept for a fixed
+// time and the least recently used go first once a table is full. Writes
+// through the wrapped repositories evict the rows they change, including
+// the ones changed by triggers such as ratings and follower counts. Inside
+// a transaction reads skip the cache and the evictions run again once the
+// transaction ends, so a read racing the commit can't keep the old row.
+// Misses are read from the primary, as a read replica lagging behind a
+// write would keep its old row until it expires. Writes from other API
+// instances sharing the database show up once the rows expire.
+//
+// Hits, misses, expirations and evictions of every table are published
+// with expvar under "repo_cache".
+package cache
+
+import (
+	"context"
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+var metrics = expvar.NewMap("repo_cache")
+
+func record(table string, event string) {
+	metrics.Add(table+"."+event, 1)
+}
+
+type Cache struct {
+	users    *table[model.User]
+	posts    *table[model.Post]
+	comments *table[model.Comment]
+}
+
+// New returns a cache holding up to size rows of each table for ttl
+func New(size int, ttl time.Duration) *Cache {
+	return &Cache{
+		users: newTable("users", size, ttl,
+			func(u model.User) int { return u.Id },
+			func(u model.User) string { return u.UserName }),
+		posts: newTable("posts", size, ttl,
+			func(p model.Post) int { return p.Id },
+			func(p model.Post) string { return p.GUID }),
+		comments: newTable("comments", size, ttl,
+			func(c model.Comment) int { return c.Id },
+			nil),
+	}
+}
+
+// clear empties every table
+func (c *Cache) clear() {
+	c.users.clear()
+	c.posts.clear()
+	c.comments.clear()
+}
+
+// Wrap returns r with users, posts and comments read through c
+func (c *Cache) Wrap(r repo.Repositories) repo.Repositories {
+	return c.wrap(r, nil)
+}
+
+func (c *Cache) wrap(r repo.Repositories, tx *pending) repo.Repositories {
+	b := base{c: c, tx: tx}
+	w := r
+	w.Tx = unitOfWork{base: b, UnitOfWork: r.Tx}
+	w.Users = users{base: b, Users: r.Users}
+	w.Follows = follows{base: b, Follows: r.Follows}
+	w.Posts = posts{base: b, Posts: r.Posts}
+	w.Comments = comments{base: b, Comments: r.Comments}
+	w.Ratings = ratings{base: b, Ratings: r.Ratings}
+	return w
+}
+
+// base is shared by the wrapped repositories, tx is set inside a
+// transaction
+type base struct {
+	c  *Cache
+	tx *pending
+}
+
+// cached reports whether reads may use the cache
+func (b base) cached() bool {
+	return b.tx == nil
+}
+
+// evict runs f now, and again after the transaction if there is one
+func (b base) evict(f func()) {
+	f()
+	if b.tx != nil {
+		b.tx.add(f)
+	}
+}
+
+// pending holds the evictions made in a transaction
+type pending struct {
+	mu        sync.Mutex
+	evictions []func()
+}
+
+func (p *pending) add(f func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.evictions = append(p.evictions, f)
+}
+
+func (p *pending) run() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, f := range p.evictions {
+		f()
+	}
+}
+
+type unitOfWork struct {
+	base
+	repo.UnitOfWork
+}
+
+func (u unitOfWork) InTx(ctx context.Context, f func(r repo.Repositories) error) error {
+	tx := u.tx
+	if tx == nil {
+		tx = &pending{}
+		defer tx.run()
+	}
+	return u.UnitOfWork.InTx(ctx, func(r repo.Repositories) error {
+		return f(u.c.wrap(r, tx))
+	})
+}
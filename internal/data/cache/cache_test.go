@@ -0,0 +1,214 @@
+package cache_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/cache"
+	"github.com/Anacardo89/lenic_api/internal/data/memory"
+	"github.com/Anacardo89/lenic_api/internal/data/migrations"
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/orm"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"github.com/Anacardo89/lenic_api/pkg/auth"
+	"github.com/Anacardo89/lenic_api/pkg/db"
+)
+
+// sqliteDB opens a migrated database in a file of its own
+func sqliteDB(t *testing.T, name string) (*sql.DB, db.Dialect) {
+	t.Helper()
+	cfg := &db.Config{Driver: "sqlite", Dbase: filepath.Join(t.TempDir(), name)}
+	d, err := cfg.Dialect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := db.LoginDB(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	m, err := migrations.New(conn, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return conn, d
+}
+
+func as(username string) context.Context {
+	return auth.NewContext(context.Background(), &auth.Claims{Username: username})
+}
+
+// TestMissReadsPrimary writes through the cache while the replica lags
+// behind, the replica here never catching up, and checks neither another
+// user's read nor the author's afterwards sees the old row
+func TestMissReadsPrimary(t *testing.T) {
+	primary, d := sqliteDB(t, "primary.db")
+	replica, _ := sqliteDB(t, "replica.db")
+	ann := &model.User{UserName: "ann", Email: "ann@example.com", HashPass: "x", Active: 1}
+	for _, conn := range []*sql.DB{primary, replica} {
+		if _, err := orm.New(conn, d, 0).CreateUser(context.Background(), ann); err != nil {
+			t.Fatal(err)
+		}
+	}
+	da := orm.New(primary, d, 0)
+	da.UseReplicas([]*sql.DB{replica}, time.Minute)
+	repos := cache.New(10, time.Minute).Wrap(da.Repositories())
+
+	if _, err := repos.Users.GetUserByName(as("bob"), "ann"); err != nil {
+		t.Fatal(err)
+	}
+	if err := repos.Users.SetUserEmail(as("ann"), "ann", "new@example.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	stale, err := da.GetUserByName(as("bob"), "ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stale.Email != ann.Email {
+		t.Fatalf("uncached read got %q, want the replica's %q", stale.Email, ann.Email)
+	}
+	for _, reader := range []string{"bob", "ann"} {
+		u, err := repos.Users.GetUserByName(as(reader), "ann")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Email != "new@example.com" {
+			t.Errorf("%s read %q, want %q", reader, u.Email, "new@example.com")
+		}
+	}
+}
+
+// cachedStore is a memory.Store behind a cache of size rows per table, the
+// store is returned too to write around the cache
+func cachedStore(t *testing.T, size int, ttl time.Duration) (*memory.Store, repo.Repositories) {
+	t.Helper()
+	store := memory.New()
+	return store, cache.New(size, ttl).Wrap(store.Repositories())
+}
+
+func createUsers(t *testing.T, store *memory.Store, names ...string) []int {
+	t.Helper()
+	ids := []int{}
+	for _, name := range names {
+		id, err := store.CreateUser(context.Background(), &model.User{UserName: name, Email: name + "@example.com", HashPass: "x", Active: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func email(t *testing.T, r repo.Repositories, id int) string {
+	t.Helper()
+	u, err := r.Users.GetUserByID(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u.Email
+}
+
+func TestWritesEvict(t *testing.T) {
+	ctx := context.Background()
+	store, repos := cachedStore(t, 10, time.Minute)
+	ids := createUsers(t, store, "ann", "bob")
+	guid := "guid"
+	postId, err := store.CreatePost(ctx, &model.Post{GUID: guid, AuthorId: ids[0], Title: "old", Active: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	email(t, repos, ids[0])
+	if _, err := repos.Posts.GetPostByGUID(ctx, guid); err != nil {
+		t.Fatal(err)
+	}
+
+	// around the cache the old rows stay
+	if err := store.SetUserEmail(ctx, "ann", "around@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if got := email(t, repos, ids[0]); got != "ann@example.com" {
+		t.Fatalf("read %q, want the cached row", got)
+	}
+
+	if err := repos.Users.SetUserEmail(ctx, "ann", "new@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if got := email(t, repos, ids[0]); got != "new@example.com" {
+		t.Errorf("read %q after the write, want new@example.com", got)
+	}
+
+	if _, err := repos.Ratings.SetPostRating(ctx, postId, ids[1], 1); err != nil {
+		t.Fatal(err)
+	}
+	p, err := repos.Posts.GetPostByID(ctx, postId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Rating != 1 {
+		t.Errorf("rating is %d after the vote, want 1", p.Rating)
+	}
+
+	err = repos.InTx(ctx, func(tx repo.Repositories) error {
+		return tx.Posts.UpdatePost(ctx, model.Post{GUID: guid, Title: "new", Active: 1})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err = repos.Posts.GetPostByGUID(ctx, guid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Title != "new" {
+		t.Errorf("title is %q after the transaction, want new", p.Title)
+	}
+
+	if err := repos.Follows.FollowUser(ctx, ids[1], ids[0], model.FollowAccepted); err != nil {
+		t.Fatal(err)
+	}
+	ann, err := repos.Users.GetUserByName(ctx, "ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ann.Followers != 1 {
+		t.Errorf("ann has %d followers after the follow, want 1", ann.Followers)
+	}
+}
+
+func TestLeastRecentlyUsedGoFirst(t *testing.T) {
+	store, repos := cachedStore(t, 2, time.Minute)
+	ids := createUsers(t, store, "ann", "bob", "cat")
+	for _, id := range ids {
+		email(t, repos, id)
+	}
+	for _, name := range []string{"ann", "cat"} {
+		if err := store.SetUserEmail(context.Background(), name, name+"@changed.com"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := email(t, repos, ids[0]); got != "ann@changed.com" {
+		t.Errorf("ann read %q, want her row evicted by cat's", got)
+	}
+	if got := email(t, repos, ids[2]); got != "cat@example.com" {
+		t.Errorf("cat read %q, want his row still cached", got)
+	}
+}
+
+func TestRowsExpire(t *testing.T) {
+	store, repos := cachedStore(t, 10, 10*time.Millisecond)
+	ids := createUsers(t, store, "ann")
+	email(t, repos, ids[0])
+	if err := store.SetUserEmail(context.Background(), "ann", "new@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if got := email(t, repos, ids[0]); got != "new@example.com" {
+		t.Errorf("read %q past the ttl, want new@example.com", got)
+	}
+}
@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+type posts struct {
+	base
+	repo.Posts
+}
+
+func (p posts) GetPostByID(ctx context.Context, id int) (*model.Post, error) {
+	if !p.cached() {
+		return p.Posts.GetPostByID(ctx, id)
+	}
+	if post, ok := p.c.posts.get(id); ok {
+		return &post, nil
+	}
+	post, err := p.Posts.GetPostByID(repo.ReadPrimary(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+	p.c.posts.add(*post)
+	return post, nil
+}
+
+func (p posts) GetPostByGUID(ctx context.Context, guid string) (*model.Post, error) {
+	if !p.cached() {
+		return p.Posts.GetPostByGUID(ctx, guid)
+	}
+	if post, ok := p.c.posts.getByKey(guid); ok {
+		return &post, nil
+	}
+	post, err := p.Posts.GetPostByGUID(repo.ReadPrimary(ctx), guid)
+	if err != nil {
+		return nil, err
+	}
+	p.c.posts.add(*post)
+	return post, nil
+}
+
+func (p posts) UpdatePost(ctx context.Context, post model.Post) error {
+	defer p.evict(func() { p.c.posts.removeByKey(post.GUID) })
+	return p.Posts.UpdatePost(ctx, post)
+}
+
+func (p posts) DisablePost(ctx context.Context, guid string) error {
+	defer p.evict(func() { p.c.posts.removeByKey(guid) })
+	return p.Posts.DisablePost(ctx, guid)
+}
+
+type comments struct {
+	base
+	repo.Comments
+}
+
+func (cm comments) GetCommentById(ctx context.Context, id int) (*model.Comment, error) {
+	if !cm.cached() {
+		return cm.Comments.GetCommentById(ctx, id)
+	}
+	if comment, ok := cm.c.comments.get(id); ok {
+		return &comment, nil
+	}
+	comment, err := cm.Comments.GetCommentById(repo.ReadPrimary(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+	cm.c.comments.add(*comment)
+	return comment, nil
+}
+
+func (cm comments) UpdateCommentText(ctx context.Context, id int, text string) error {
+	defer cm.evict(func() { cm.c.comments.remove(id) })
+	return cm.Comments.UpdateCommentText(ctx, id, text)
+}
+
+func (cm comments) DisableComment(ctx context.Context, id int) error {
+	defer cm.evict(func() { cm.c.comments.remove(id) })
+	return cm.Comments.DisableComment(ctx, id)
+}
+
+// ratings evicts the rated post or comment, the triggers update its rating
+type ratings struct {
+	base
+	repo.Ratings
+}
+
+func (r ratings) RatePostUp(ctx context.Context, post_id int, user_id int) error {
+	defer r.evict(func() { r.c.posts.remove(post_id) })
+	return r.Ratings.RatePostUp(ctx, post_id, user_id)
+}
+
+func (r ratings) RatePostDown(ctx context.Context, post_id int, user_id int) error {
+	defer r.evict(func() { r.c.posts.remove(post_id) })
+	return r.Ratings.RatePostDown(ctx, post_id, user_id)
+}
+
+func (r ratings) SetPostRating(ctx context.Context, post_id int, user_id int, value int) (int, error) {
+	defer r.evict(func() { r.c.posts.remove(post_id) })
+	return r.Ratings.SetPostRating(ctx, post_id, user_id, value)
+}
+
+func (r ratings) RateCommentUp(ctx context.Context, comment_id int, user_id int) error {
+	defer r.evict(func() { r.c.comments.remove(comment_id) })
+	return r.Ratings.RateCommentUp(ctx, comment_id, user_id)
+}
+
+func (r ratings) RateCommentDown(ctx context.Context, comment_id int, user_id int) error {
+	defer r.evict(func() { r.c.comments.remove(comment_id) })
+	return r.Ratings.RateCommentDown(ctx, comment_id, user_id)
+}
+
+func (r ratings) SetCommentRating(ctx context.Context, comment_id int, user_id int, value int) (int, error) {
+	defer r.evict(func() { r.c.comments.remove(comment_id) })
+	return r.Ratings.SetCommentRating(ctx, comment_id, user_id, value)
+}
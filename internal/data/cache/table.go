@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// table is an LRU of rows of one table, looked up by id and, when key is
+// set, by a unique string column too. Rows older than ttl count as misses.
+type table[V any] struct {
+	name string
+	size int
+	ttl  time.Duration
+	id   func(v V) int
+	key  func(v V) string
+
+	mu    sync.Mutex
+	order *list.List
+	byId  map[int]*list.Element
+	byKey map[string]*list.Element
+}
+
+type entry[V any] struct {
+	id      int
+	key     string
+	value   V
+	expires time.Time
+}
+
+func newTable[V any](name string, size int, ttl time.Duration, id func(v V) int, key func(v V) string) *table[V] {
+	return &table[V]{
+		name:  name,
+		size:  size,
+		ttl:   ttl,
+		id:    id,
+		key:   key,
+		order: list.New(),
+		byId:  map[int]*list.Element{},
+		byKey: map[string]*list.Element{},
+	}
+}
+
+func (t *table[V]) get(id int) (V, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.hit(t.byId[id])
+}
+
+func (t *table[V]) getByKey(key string) (V, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.hit(t.byKey[key])
+}
+
+// hit returns the value of el if it's still fresh, callers must hold the
+// lock
+func (t *table[V]) hit(el *list.Element) (V, bool) {
+	if el == nil {
+		record(t.name, "misses")
+		var zero V
+		return zero, false
+	}
+	e := el.Value.(*entry[V])
+	if t.ttl > 0 && time.Now().After(e.expires) {
+		t.drop(el)
+		record(t.name, "expired")
+		record(t.name, "misses")
+		var zero V
+		return zero, false
+	}
+	t.order.MoveToFront(el)
+	record(t.name, "hits")
+	return e.value, true
+}
+
+func (t *table[V]) add(v V) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	e := &entry[V]{id: t.id(v), value: v, expires: time.Now().Add(t.ttl)}
+	if t.key != nil {
+		e.key = t.key(v)
+	}
+	if el, ok := t.byId[e.id]; ok {
+		t.drop(el)
+	}
+	if el, ok := t.byKey[e.key]; ok && t.key != nil {
+		t.drop(el)
+	}
+	el := t.order.PushFront(e)
+	t.byId[e.id] = el
+	if t.key != nil {
+		t.byKey[e.key] = el
+	}
+	for t.order.Len() > t.size {
+		t.drop(t.order.Back())
+		record(t.name, "evictions")
+	}
+}
+
+func (t *table[V]) remove(id int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if el, ok := t.byId[id]; ok {
+		t.drop(el)
+	}
+}
+
+func (t *table[V]) removeByKey(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if el, ok := t.byKey[key]; ok {
+		t.drop(el)
+	}
+}
+
+// drop unlinks el, callers must hold the lock
+func (t *table[V]) drop(el *list.Element) {
+	e := t.order.Remove(el).(*entry[V])
+	delete(t.byId, e.id)
+	if t.key != nil {
+		delete(t.byKey, e.key)
+	}
+}
+
+func (t *table[V]) clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.order.Init()
+	t.byId = map[int]*list.Element{}
+	t.byKey = map[string]*list.Element{}
+}
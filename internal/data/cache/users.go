@@ -0,0 +1,165 @@
+package cache
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+type users struct {
+	base
+	repo.Users
+}
+
+func (u users) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	if !u.cached() {
+		return u.Users.GetUserByID(ctx, id)
+	}
+	if user, ok := u.c.users.get(id); ok {
+		return &user, nil
+	}
+	user, err := u.Users.GetUserByID(repo.ReadPrimary(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+	u.c.users.add(*user)
+	return user, nil
+}
+
+func (u users) GetUserByName(ctx context.Context, name string) (*model.User, error) {
+	if !u.cached() {
+		return u.Users.GetUserByName(ctx, name)
+	}
+	if user, ok := u.c.users.getByKey(name); ok {
+		return &user, nil
+	}
+	user, err := u.Users.GetUserByName(repo.ReadPrimary(ctx), name)
+	if err != nil {
+		return nil, err
+	}
+	u.c.users.add(*user)
+	return user, nil
+}
+
+// GetUsersByIDs only reads the users missing from the cache
+func (u users) GetUsersByIDs(ctx context.Context, ids []int) (*[]model.User, error) {
+	if !u.cached() {
+		return u.Users.GetUsersByIDs(ctx, ids)
+	}
+	byId := map[int]model.User{}
+	missing := []int{}
+	for _, id := range ids {
+		if user, ok := u.c.users.get(id); ok {
+			byId[id] = user
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		found, err := u.Users.GetUsersByIDs(repo.ReadPrimary(ctx), missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range *found {
+			u.c.users.add(user)
+			byId[user.Id] = user
+		}
+	}
+	result := make([]model.User, 0, len(byId))
+	for _, user := range byId {
+		result = append(result, user)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Id < result[j].Id
+	})
+	return &result, nil
+}
+
+func (u users) SetUserAsActive(ctx context.Context, name string) error {
+	defer u.evictUserNamed(name)
+	return u.Users.SetUserAsActive(ctx, name)
+}
+
+func (u users) SetNewPassword(ctx context.Context, user string, pass string) error {
+	defer u.evictUserNamed(user)
+	return u.Users.SetNewPassword(ctx, user, pass)
+}
+
+func (u users) SetUserPrivacy(ctx context.Context, user string, is_private bool) error {
+	defer u.evictUserNamed(user)
+	return u.Users.SetUserPrivacy(ctx, user, is_private)
+}
+
+func (u users) SetUserEmail(ctx context.Context, user string, email string) error {
+	defer u.evictUserNamed(user)
+	return u.Users.SetUserEmail(ctx, user, email)
+}
+
+// DeleteUser, RestoreUser and PurgeDeletedUsers empty the cache, the
+// posts, comments and follows of the users go with them
+func (u users) DeleteUser(ctx context.Context, user string) error {
+	defer u.evict(u.c.clear)
+	return u.Users.DeleteUser(ctx, user)
+}
+
+func (u users) RestoreUser(ctx context.Context, user string, since time.Time) error {
+	defer u.evict(u.c.clear)
+	return u.Users.RestoreUser(ctx, user, since)
+}
+
+func (u users) PurgeDeletedUsers(ctx context.Context, before time.Time) (int, error) {
+	defer u.evict(u.c.clear)
+	return u.Users.PurgeDeletedUsers(ctx, before)
+}
+
+func (b base) evictUserNamed(name string) {
+	b.evict(func() { b.c.users.removeByKey(name) })
+}
+
+func (b base) evictUsers(ids ...int) {
+	b.evict(func() {
+		for _, id := range ids {
+			b.c.users.remove(id)
+		}
+	})
+}
+
+// follows evicts both users on every change to the graph, the triggers
+// update their follower counts
+type follows struct {
+	base
+	repo.Follows
+}
+
+func (f follows) FollowUser(ctx context.Context, follower_id int, followed_id int, status int) error {
+	defer f.evictUsers(follower_id, followed_id)
+	return f.Follows.FollowUser(ctx, follower_id, followed_id, status)
+}
+
+func (f follows) AcceptFollow(ctx context.Context, follower_id int, followed_id int) error {
+	defer f.evictUsers(follower_id, followed_id)
+	return f.Follows.AcceptFollow(ctx, follower_id, followed_id)
+}
+
+func (f follows) RejectFollow(ctx context.Context, follower_id int, followed_id int) error {
+	defer f.evictUsers(follower_id, followed_id)
+	return f.Follows.RejectFollow(ctx, follower_id, followed_id)
+}
+
+func (f follows) CancelFollowRequest(ctx context.Context, follower_id int, followed_id int) error {
+	defer f.evictUsers(follower_id, followed_id)
+	return f.Follows.CancelFollowRequest(ctx, follower_id, followed_id)
+}
+
+func (f follows) UnfollowUser(ctx context.Context, follower_id int, followed_id int) error {
+	defer f.evictUsers(follower_id, followed_id)
+	return f.Follows.UnfollowUser(ctx, follower_id, followed_id)
+}
+
+func (f follows) BlockUser(ctx context.Context, blocker_id int, blocked_id int) error {
+	defer f.evictUsers(blocker_id, blocked_id)
+	return f.Follows.BlockUser(ctx, blocker_id, blocked_id)
+}
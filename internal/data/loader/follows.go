@@ -0,0 +1,43 @@
+package loader
+
+import (
+	"context"
+
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+// follows drops the cache on every change to the graph, the triggers
+// update the follower counts of both users
+type follows struct {
+	repo.Follows
+}
+
+func (f follows) FollowUser(ctx context.Context, follower_id int, followed_id int, status int) error {
+	defer forget(ctx)
+	return f.Follows.FollowUser(ctx, follower_id, followed_id, status)
+}
+
+func (f follows) AcceptFollow(ctx context.Context, follower_id int, followed_id int) error {
+	defer forget(ctx)
+	return f.Follows.AcceptFollow(ctx, follower_id, followed_id)
+}
+
+func (f follows) RejectFollow(ctx context.Context, follower_id int, followed_id int) error {
+	defer forget(ctx)
+	return f.Follows.RejectFollow(ctx, follower_id, followed_id)
+}
+
+func (f follows) CancelFollowRequest(ctx context.Context, follower_id int, followed_id int) error {
+	defer forget(ctx)
+	return f.Follows.CancelFollowRequest(ctx, follower_id, followed_id)
+}
+
+func (f follows) UnfollowUser(ctx context.Context, follower_id int, followed_id int) error {
+	defer forget(ctx)
+	return f.Follows.UnfollowUser(ctx, follower_id, followed_id)
+}
+
+func (f follows) BlockUser(ctx context.Context, blocker_id int, blocked_id int) error {
+	defer forget(ctx)
+	return f.Follows.BlockUser(ctx, blocker_id, blocked_id)
+}
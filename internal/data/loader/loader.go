@@ -0,0 +1,83 @@
+// Package loader memoizes user, post and comment lookups for the length of
+// one request, so the interceptors and the handler behind them load each
+// row once.
+//
+// The interceptors start a request with NewContext, and Wrap returns
+// repositories that read through the cache carried by the context. Without
+// one, or inside a transaction, every call goes to the wrapped
+// repositories. Writes through the wrapped repositories, and every
+// transaction, drop what the cache holds since triggers may have changed
+// counters and ratings of rows other than the ones written.
+package loader
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+type key struct{}
+
+type cache struct {
+	mu       sync.Mutex
+	users    map[int]model.User
+	userIds  map[string]int
+	posts    map[int]model.Post
+	postIds  map[string]int
+	comments map[int]model.Comment
+}
+
+// NewContext returns ctx carrying an empty cache
+func NewContext(ctx context.Context) context.Context {
+	c := &cache{}
+	c.reset()
+	return context.WithValue(ctx, key{}, c)
+}
+
+func fromContext(ctx context.Context) (*cache, bool) {
+	c, ok := ctx.Value(key{}).(*cache)
+	return c, ok
+}
+
+// forget drops what the cache of ctx holds, if it has one
+func forget(ctx context.Context) {
+	if c, ok := fromContext(ctx); ok {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.reset()
+	}
+}
+
+// reset empties c, callers must hold the lock unless c is new
+func (c *cache) reset() {
+	c.users = map[int]model.User{}
+	c.userIds = map[string]int{}
+	c.posts = map[int]model.Post{}
+	c.postIds = map[string]int{}
+	c.comments = map[int]model.Comment{}
+}
+
+// Wrap returns r with users, posts and comments memoized per request
+func Wrap(r repo.Repositories) repo.Repositories {
+	w := r
+	w.Tx = unitOfWork{r.Tx}
+	w.Users = users{r.Users}
+	w.Follows = follows{r.Follows}
+	w.Posts = posts{r.Posts}
+	w.Comments = comments{r.Comments}
+	w.Ratings = ratings{r.Ratings}
+	return w
+}
+
+// unitOfWork hands f the repositories unwrapped, reads inside a
+// transaction may see writes that end up rolled back
+type unitOfWork struct {
+	repo.UnitOfWork
+}
+
+func (u unitOfWork) InTx(ctx context.Context, f func(r repo.Repositories) error) error {
+	defer forget(ctx)
+	return u.UnitOfWork.InTx(ctx, f)
+}
@@ -0,0 +1,158 @@
+package loader
+
+import (
+	"context"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+type posts struct {
+	repo.Posts
+}
+
+func (p posts) GetPostByID(ctx context.Context, id int) (*model.Post, error) {
+	c, ok := fromContext(ctx)
+	if !ok {
+		return p.Posts.GetPostByID(ctx, id)
+	}
+	if post, ok := c.post(id); ok {
+		return &post, nil
+	}
+	post, err := p.Posts.GetPostByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.addPost(*post)
+	return post, nil
+}
+
+func (p posts) GetPostByGUID(ctx context.Context, guid string) (*model.Post, error) {
+	c, ok := fromContext(ctx)
+	if !ok {
+		return p.Posts.GetPostByGUID(ctx, guid)
+	}
+	if post, ok := c.postByGUID(guid); ok {
+		return &post, nil
+	}
+	post, err := p.Posts.GetPostByGUID(ctx, guid)
+	if err != nil {
+		return nil, err
+	}
+	c.addPost(*post)
+	return post, nil
+}
+
+func (p posts) UpdatePost(ctx context.Context, post model.Post) error {
+	defer forget(ctx)
+	return p.Posts.UpdatePost(ctx, post)
+}
+
+func (p posts) DisablePost(ctx context.Context, guid string) error {
+	defer forget(ctx)
+	return p.Posts.DisablePost(ctx, guid)
+}
+
+type comments struct {
+	repo.Comments
+}
+
+func (cm comments) GetCommentById(ctx context.Context, id int) (*model.Comment, error) {
+	c, ok := fromContext(ctx)
+	if !ok {
+		return cm.Comments.GetCommentById(ctx, id)
+	}
+	if comment, ok := c.comment(id); ok {
+		return &comment, nil
+	}
+	comment, err := cm.Comments.GetCommentById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.addComment(*comment)
+	return comment, nil
+}
+
+func (cm comments) UpdateCommentText(ctx context.Context, id int, text string) error {
+	defer forget(ctx)
+	return cm.Comments.UpdateCommentText(ctx, id, text)
+}
+
+func (cm comments) DisableComment(ctx context.Context, id int) error {
+	defer forget(ctx)
+	return cm.Comments.DisableComment(ctx, id)
+}
+
+// ratings drops the cache on every vote, the triggers update the rating of
+// the post or comment
+type ratings struct {
+	repo.Ratings
+}
+
+func (r ratings) RatePostUp(ctx context.Context, post_id int, user_id int) error {
+	defer forget(ctx)
+	return r.Ratings.RatePostUp(ctx, post_id, user_id)
+}
+
+func (r ratings) RatePostDown(ctx context.Context, post_id int, user_id int) error {
+	defer forget(ctx)
+	return r.Ratings.RatePostDown(ctx, post_id, user_id)
+}
+
+func (r ratings) SetPostRating(ctx context.Context, post_id int, user_id int, value int) (int, error) {
+	defer forget(ctx)
+	return r.Ratings.SetPostRating(ctx, post_id, user_id, value)
+}
+
+func (r ratings) RateCommentUp(ctx context.Context, comment_id int, user_id int) error {
+	defer forget(ctx)
+	return r.Ratings.RateCommentUp(ctx, comment_id, user_id)
+}
+
+func (r ratings) RateCommentDown(ctx context.Context, comment_id int, user_id int) error {
+	defer forget(ctx)
+	return r.Ratings.RateCommentDown(ctx, comment_id, user_id)
+}
+
+func (r ratings) SetCommentRating(ctx context.Context, comment_id int, user_id int, value int) (int, error) {
+	defer forget(ctx)
+	return r.Ratings.SetCommentRating(ctx, comment_id, user_id, value)
+}
+
+func (c *cache) post(id int) (model.Post, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	post, ok := c.posts[id]
+	return post, ok
+}
+
+func (c *cache) postByGUID(guid string) (model.Post, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id, ok := c.postIds[guid]
+	if !ok {
+		return model.Post{}, false
+	}
+	post, ok := c.posts[id]
+	return post, ok
+}
+
+func (c *cache) addPost(post model.Post) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.posts[post.Id] = post
+	c.postIds[post.GUID] = post.Id
+}
+
+func (c *cache) comment(id int) (model.Comment, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	comment, ok := c.comments[id]
+	return comment, ok
+}
+
+func (c *cache) addComment(comment model.Comment) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.comments[comment.Id] = comment
+}
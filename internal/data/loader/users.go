@@ -0,0 +1,146 @@
+package loader
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+type users struct {
+	repo.Users
+}
+
+func (u users) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	c, ok := fromContext(ctx)
+	if !ok {
+		return u.Users.GetUserByID(ctx, id)
+	}
+	if user, ok := c.user(id); ok {
+		return &user, nil
+	}
+	user, err := u.Users.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.addUser(*user)
+	return user, nil
+}
+
+func (u users) GetUserByName(ctx context.Context, name string) (*model.User, error) {
+	c, ok := fromContext(ctx)
+	if !ok {
+		return u.Users.GetUserByName(ctx, name)
+	}
+	if user, ok := c.userByName(name); ok {
+		return &user, nil
+	}
+	user, err := u.Users.GetUserByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	c.addUser(*user)
+	return user, nil
+}
+
+// GetUsersByIDs only asks the wrapped repository for the users missing
+// from the cache
+func (u users) GetUsersByIDs(ctx context.Context, ids []int) (*[]model.User, error) {
+	c, ok := fromContext(ctx)
+	if !ok {
+		return u.Users.GetUsersByIDs(ctx, ids)
+	}
+	byId := map[int]model.User{}
+	missing := []int{}
+	for _, id := range ids {
+		if user, ok := c.user(id); ok {
+			byId[id] = user
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		found, err := u.Users.GetUsersByIDs(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range *found {
+			c.addUser(user)
+			byId[user.Id] = user
+		}
+	}
+	result := make([]model.User, 0, len(byId))
+	for _, user := range byId {
+		result = append(result, user)
+	}
+	sortUsers(result)
+	return &result, nil
+}
+
+func (u users) SetUserAsActive(ctx context.Context, name string) error {
+	defer forget(ctx)
+	return u.Users.SetUserAsActive(ctx, name)
+}
+
+func (u users) SetNewPassword(ctx context.Context, user string, pass string) error {
+	defer forget(ctx)
+	return u.Users.SetNewPassword(ctx, user, pass)
+}
+
+func (u users) SetUserPrivacy(ctx context.Context, user string, is_private bool) error {
+	defer forget(ctx)
+	return u.Users.SetUserPrivacy(ctx, user, is_private)
+}
+
+func (u users) SetUserEmail(ctx context.Context, user string, email string) error {
+	defer forget(ctx)
+	return u.Users.SetUserEmail(ctx, user, email)
+}
+
+func (u users) DeleteUser(ctx context.Context, user string) error {
+	defer forget(ctx)
+	return u.Users.DeleteUser(ctx, user)
+}
+
+func (u users) RestoreUser(ctx context.Context, user string, since time.Time) error {
+	defer forget(ctx)
+	return u.Users.RestoreUser(ctx, user, since)
+}
+
+func (u users) PurgeDeletedUsers(ctx context.Context, before time.Time) (int, error) {
+	defer forget(ctx)
+	return u.Users.PurgeDeletedUsers(ctx, before)
+}
+
+func (c *cache) user(id int) (model.User, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	user, ok := c.users[id]
+	return user, ok
+}
+
+func (c *cache) userByName(name string) (model.User, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id, ok := c.userIds[name]
+	if !ok {
+		return model.User{}, false
+	}
+	user, ok := c.users[id]
+	return user, ok
+}
+
+func (c *cache) addUser(user model.User) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.users[user.Id] = user
+	c.userIds[user.UserName] = user.Id
+}
+
+func sortUsers(users []model.User) {
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Id < users[j].Id
+	})
+}
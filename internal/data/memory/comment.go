@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+func (s *Store) CreateComment(ctx context.Context, c *model.Comment) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nc := *c
+	nc.Id = s.nextId("comments")
+	nc.CreatedAt = now()
+	nc.UpdatedAt = nc.CreatedAt
+	s.comments[nc.Id] = &nc
+	return nc.Id, nil
+}
+
+func (s *Store) GetCommentById(ctx context.Context, id int) (*model.Comment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.comments[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	cc := *c
+	return &cc, nil
+}
+
+func (s *Store) GetCommentsByPost(ctx context.Context, guid string, page repo.Page) (*[]model.Comment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	comments := []model.Comment{}
+	for _, c := range s.comments {
+		if c.PostGUID == guid && c.Active == 1 {
+			comments = append(comments, *c)
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		return byId(repo.CommentKey(comments[i]), repo.CommentKey(comments[j]))
+	})
+	comments = paginate(comments, page, repo.CommentKey, byId)
+	return &comments, nil
+}
+
+func (s *Store) GetUserThreadComments(ctx context.Context, user_id int) (*[]model.Comment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ownPosts := map[string]bool{}
+	for _, p := range s.posts {
+		if p.AuthorId == user_id && p.Active == 1 {
+			ownPosts[p.GUID] = true
+		}
+	}
+	comments := []model.Comment{}
+	for _, c := range s.comments {
+		if c.Active == 1 && (c.AuthorId == user_id || ownPosts[c.PostGUID]) {
+			comments = append(comments, *c)
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		return comments[i].Id < comments[j].Id
+	})
+	return &comments, nil
+}
+
+// UpdateCommentText keeps the version it replaces as a revision, an
+// update that changes nothing is skipped
+func (s *Store) UpdateCommentText(ctx context.Context, id int, text string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.comments[id]
+	if !ok {
+		return sql.ErrNoRows
+	}
+	if c.Content == text {
+		return nil
+	}
+	s.commentRevisions[pair{c.Id, c.EditCount + 1}] = &model.CommentRevision{
+		CommentId: c.Id,
+		Revision:  c.EditCount + 1,
+		Content:   c.Content,
+		CreatedAt: c.VersionTime(),
+	}
+	c.Content = text
+	c.EditCount++
+	c.EditedAt = now()
+	c.UpdatedAt = c.EditedAt
+	return nil
+}
+
+func (s *Store) GetCommentRevisions(ctx context.Context, comment_id int) (*[]model.CommentRevision, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	revisions := []model.CommentRevision{}
+	for k, r := range s.commentRevisions {
+		if k.a == comment_id {
+			revisions = append(revisions, *r)
+		}
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+	return &revisions, nil
+}
+
+func (s *Store) DisableComment(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c, ok := s.comments[id]; ok {
+		c.Active = 0
+		c.UpdatedAt = now()
+	}
+	return nil
+}
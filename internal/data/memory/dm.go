@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+func (s *Store) CreateConversation(ctx context.Context, c *model.Conversation) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nc := *c
+	nc.Id = s.nextId("conversations")
+	nc.CreatedAt = now()
+	nc.UpdatedAt = nc.CreatedAt
+	s.conversations[nc.Id] = &nc
+	return nc.Id, nil
+}
+
+func (s *Store) GetConversationById(ctx context.Context, id int) (*model.Conversation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.conversations[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	cc := *c
+	return &cc, nil
+}
+
+func (s *Store) GetConversationByUserIds(ctx context.Context, user1_id int, user2_id int) (*model.Conversation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, c := range s.conversations {
+		if c.User1Id == user1_id && c.User2Id == user2_id {
+			cc := *c
+			return &cc, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (s *Store) GetConversationsByUserId(ctx context.Context, user_id int, page repo.Page) ([]*model.Conversation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	convos := []*model.Conversation{}
+	for _, c := range s.conversations {
+		if (c.User1Id == user_id || c.User2Id == user_id) && !s.isDeleted(c.User1Id) && !s.isDeleted(c.User2Id) {
+			cc := *c
+			convos = append(convos, &cc)
+		}
+	}
+	key := func(c *model.Conversation) repo.Key {
+		return repo.ConversationKey(*c)
+	}
+	sort.Slice(convos, func(i, j int) bool {
+		return newestFirst(key(convos[i]), key(convos[j]))
+	})
+	return paginate(convos, page, key, newestFirst), nil
+}
+
+func (s *Store) UpdateConversationById(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c, ok := s.conversations[id]; ok {
+		c.UpdatedAt = now()
+	}
+	return nil
+}
+
+func (s *Store) CreateDMessage(ctx context.Context, d *model.DMessage) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nd := *d
+	nd.Id = s.nextId("dmessages")
+	nd.IsRead = false
+	nd.CreatedAt = now()
+	s.dms[nd.Id] = &nd
+	return nd.Id, nil
+}
+
+func (s *Store) GetDMById(ctx context.Context, id int) (*model.DMessage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	d, ok := s.dms[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	dd := *d
+	return &dd, nil
+}
+
+func (s *Store) GetLastDMBySenderInConversation(ctx context.Context, conversation_id int, sender_id int) (*model.DMessage, error) {
+	dms, _ := s.getDMs(func(d *model.DMessage) bool {
+		return d.ConversationId == conversation_id && d.SenderId == sender_id
+	})
+	if len(dms) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return dms[len(dms)-1], nil
+}
+
+func (s *Store) GetDMsByConversationId(ctx context.Context, conversation_id int, page repo.Page) ([]*model.DMessage, error) {
+	dms, err := s.getDMs(func(d *model.DMessage) bool {
+		return d.ConversationId == conversation_id
+	})
+	if err != nil {
+		return nil, err
+	}
+	return paginate(dms, page, func(d *model.DMessage) repo.Key {
+		return repo.DMKey(*d)
+	}, oldestFirst), nil
+}
+
+func (s *Store) UpdateDMReadById(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if d, ok := s.dms[id]; ok {
+		d.IsRead = true
+	}
+	return nil
+}
+
+// getDMs returns the messages matching filter, oldest first
+func (s *Store) getDMs(filter func(d *model.DMessage) bool) ([]*model.DMessage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	dms := []*model.DMessage{}
+	for _, d := range s.dms {
+		if filter(d) {
+			dd := *d
+			dms = append(dms, &dd)
+		}
+	}
+	sort.Slice(dms, func(i, j int) bool {
+		if !dms[i].CreatedAt.Equal(dms[j].CreatedAt) {
+			return dms[i].CreatedAt.Before(dms[j].CreatedAt)
+		}
+		return dms[i].Id < dms[j].Id
+	})
+	return dms, nil
+}
@@ -0,0 +1,335 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+func (s *Store) GetUserFollows(ctx context.Context, follower_id int, followed_id int) (*model.Follows, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	f, ok := s.follows[pair{follower_id, followed_id}]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	c := *f
+	return &c, nil
+}
+
+func (s *Store) GetFollowers(ctx context.Context, followed_id int) (*[]model.Follows, error) {
+	return s.getFollows(func(f *model.Follows) bool {
+		return f.FollowedId == followed_id && f.Status == model.FollowAccepted
+	})
+}
+
+func (s *Store) GetFollowing(ctx context.Context, follower_id int) (*[]model.Follows, error) {
+	return s.getFollows(func(f *model.Follows) bool {
+		return f.FollowerId == follower_id && f.Status == model.FollowAccepted
+	})
+}
+
+func (s *Store) GetIncomingFollowRequests(ctx context.Context, followed_id int, page repo.Page) (*[]model.Follows, error) {
+	follows, err := s.getFollows(func(f *model.Follows) bool {
+		return f.FollowedId == followed_id && f.Status == model.FollowPending
+	})
+	if err != nil {
+		return nil, err
+	}
+	*follows = paginate(*follows, page, repo.FollowerKey, byId)
+	return follows, nil
+}
+
+func (s *Store) GetOutgoingFollowRequests(ctx context.Context, follower_id int, page repo.Page) (*[]model.Follows, error) {
+	follows, err := s.getFollows(func(f *model.Follows) bool {
+		return f.FollowerId == follower_id && f.Status == model.FollowPending
+	})
+	if err != nil {
+		return nil, err
+	}
+	*follows = paginate(*follows, page, repo.FollowedKey, byId)
+	return follows, nil
+}
+
+func (s *Store) getFollows(match func(f *model.Follows) bool) (*[]model.Follows, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	follows := []model.Follows{}
+	for _, f := range s.follows {
+		if match(f) {
+			follows = append(follows, *f)
+		}
+	}
+	sort.Slice(follows, func(i, j int) bool {
+		if follows[i].FollowerId != follows[j].FollowerId {
+			return follows[i].FollowerId < follows[j].FollowerId
+		}
+		return follows[i].FollowedId < follows[j].FollowedId
+	})
+	return &follows, nil
+}
+
+func (s *Store) GetFollowerUsers(ctx context.Context, followed_id int, page repo.Page) (*[]model.User, error) {
+	return s.followUsers(func(u *model.User) *model.Follows {
+		return s.follows[pair{u.Id, followed_id}]
+	}, model.FollowAccepted, page)
+}
+
+func (s *Store) GetFollowingUsers(ctx context.Context, follower_id int, page repo.Page) (*[]model.User, error) {
+	return s.followUsers(func(u *model.User) *model.Follows {
+		return s.follows[pair{follower_id, u.Id}]
+	}, model.FollowAccepted, page)
+}
+
+func (s *Store) GetIncomingRequestUsers(ctx context.Context, followed_id int, page repo.Page) (*[]model.User, error) {
+	return s.followUsers(func(u *model.User) *model.Follows {
+		return s.follows[pair{u.Id, followed_id}]
+	}, model.FollowPending, page)
+}
+
+func (s *Store) GetOutgoingRequestUsers(ctx context.Context, follower_id int, page repo.Page) (*[]model.User, error) {
+	return s.followUsers(func(u *model.User) *model.Follows {
+		return s.follows[pair{follower_id, u.Id}]
+	}, model.FollowPending, page)
+}
+
+// followUsers returns the page of users whose follow, as picked by
+// follow, has the given status
+func (s *Store) followUsers(follow func(u *model.User) *model.Follows, status int, page repo.Page) (*[]model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	users := []model.User{}
+	for _, u := range s.sortedUsers() {
+		if f := follow(u); f != nil && f.Status == status {
+			users = append(users, *s.copyUser(u))
+		}
+	}
+	users = paginate(users, page, repo.UserKey, byId)
+	return &users, nil
+}
+
+func (s *Store) GetFollowSuggestions(ctx context.Context, user_id int, limit int) ([]*model.FollowSuggestion, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	mutuals := map[int]int{}
+	for k, f := range s.follows {
+		if k.a != user_id || f.Status != model.FollowAccepted {
+			continue
+		}
+		for k2, f2 := range s.follows {
+			if k2.a != k.b || f2.Status != model.FollowAccepted {
+				continue
+			}
+			candidate := k2.b
+			if candidate == user_id {
+				continue
+			}
+			if _, ok := s.follows[pair{user_id, candidate}]; ok {
+				continue
+			}
+			if s.blocks[pair{user_id, candidate}] || s.blocks[pair{candidate, user_id}] {
+				continue
+			}
+			if u, ok := s.users[candidate]; !ok || u.Active != 1 || deleted(u) {
+				continue
+			}
+			mutuals[candidate]++
+		}
+	}
+	suggestions := []*model.FollowSuggestion{}
+	for id, n := range mutuals {
+		suggestions = append(suggestions, &model.FollowSuggestion{
+			UserId:      id,
+			MutualCount: n,
+			LastPostAt:  s.lastPostAt(id),
+		})
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].MutualCount != suggestions[j].MutualCount {
+			return suggestions[i].MutualCount > suggestions[j].MutualCount
+		}
+		return suggestions[i].UserId < suggestions[j].UserId
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+func (s *Store) lastPostAt(user_id int) time.Time {
+	var last time.Time
+	for _, p := range s.posts {
+		if p.AuthorId == user_id && p.Active == 1 && p.CreatedAt.After(last) {
+			last = p.CreatedAt
+		}
+	}
+	return last
+}
+
+func (s *Store) GetMutualCounts(ctx context.Context, user_id int, ids []int) (map[int]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	counts := map[int]int{}
+	for _, id := range ids {
+		for k, f := range s.follows {
+			if k.a != user_id || f.Status != model.FollowAccepted {
+				continue
+			}
+			if f2, ok := s.follows[pair{k.b, id}]; ok && f2.Status == model.FollowAccepted {
+				counts[id]++
+			}
+		}
+	}
+	return counts, nil
+}
+
+func (s *Store) FollowUser(ctx context.Context, follower_id int, followed_id int, status int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if status != model.FollowPending && status != model.FollowAccepted {
+		return repo.ErrInvalidFollowTransition
+	}
+	k := pair{follower_id, followed_id}
+	if _, ok := s.follows[k]; ok {
+		return repo.ErrInvalidFollowTransition
+	}
+	s.follows[k] = &model.Follows{
+		FollowerId: follower_id,
+		FollowedId: followed_id,
+		Status:     status,
+	}
+	return nil
+}
+
+func (s *Store) AcceptFollow(ctx context.Context, follower_id int, followed_id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.follows[pair{follower_id, followed_id}]
+	if !ok || f.Status != model.FollowPending {
+		return repo.ErrInvalidFollowTransition
+	}
+	f.Status = model.FollowAccepted
+	return nil
+}
+
+func (s *Store) RejectFollow(ctx context.Context, follower_id int, followed_id int) error {
+	return s.removeFollow(follower_id, followed_id, model.FollowPending)
+}
+
+func (s *Store) CancelFollowRequest(ctx context.Context, follower_id int, followed_id int) error {
+	return s.removeFollow(follower_id, followed_id, model.FollowPending)
+}
+
+func (s *Store) UnfollowUser(ctx context.Context, follower_id int, followed_id int) error {
+	return s.removeFollow(follower_id, followed_id, model.FollowAccepted)
+}
+
+func (s *Store) removeFollow(follower_id int, followed_id int, status int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := pair{follower_id, followed_id}
+	f, ok := s.follows[k]
+	if !ok || f.Status != status {
+		return repo.ErrInvalidFollowTransition
+	}
+	delete(s.follows, k)
+	return nil
+}
+
+func (s *Store) AddCloseFriend(ctx context.Context, user_id int, friend_id int) error {
+	return s.setPair(s.closeFriends, pair{user_id, friend_id}, true)
+}
+
+func (s *Store) RemoveCloseFriend(ctx context.Context, user_id int, friend_id int) error {
+	return s.setPair(s.closeFriends, pair{user_id, friend_id}, false)
+}
+
+func (s *Store) IsCloseFriend(ctx context.Context, user_id int, friend_id int) (bool, error) {
+	return s.hasPair(s.closeFriends, pair{user_id, friend_id}), nil
+}
+
+func (s *Store) GetCloseFriends(ctx context.Context, user_id int) (*[]model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	users := []model.User{}
+	for _, u := range s.sortedUsers() {
+		if s.closeFriends[pair{user_id, u.Id}] {
+			users = append(users, *s.copyUser(u))
+		}
+	}
+	return &users, nil
+}
+
+func (s *Store) BlockUser(ctx context.Context, blocker_id int, blocked_id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blocks[pair{blocker_id, blocked_id}] = true
+	delete(s.follows, pair{blocker_id, blocked_id})
+	delete(s.follows, pair{blocked_id, blocker_id})
+	return nil
+}
+
+func (s *Store) UnblockUser(ctx context.Context, blocker_id int, blocked_id int) error {
+	return s.setPair(s.blocks, pair{blocker_id, blocked_id}, false)
+}
+
+func (s *Store) IsBlocked(ctx context.Context, blocker_id int, blocked_id int) (bool, error) {
+	return s.hasPair(s.blocks, pair{blocker_id, blocked_id}), nil
+}
+
+func (s *Store) MuteUser(ctx context.Context, muter_id int, muted_id int) error {
+	return s.setPair(s.mutes, pair{muter_id, muted_id}, true)
+}
+
+func (s *Store) UnmuteUser(ctx context.Context, muter_id int, muted_id int) error {
+	return s.setPair(s.mutes, pair{muter_id, muted_id}, false)
+}
+
+func (s *Store) IsMuted(ctx context.Context, muter_id int, muted_id int) (bool, error) {
+	return s.hasPair(s.mutes, pair{muter_id, muted_id}), nil
+}
+
+func (s *Store) setPair(m map[pair]bool, k pair, set bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if set {
+		m[k] = true
+	} else {
+		delete(m, k)
+	}
+	return nil
+}
+
+func (s *Store) hasPair(m map[pair]bool, k pair) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return m[k]
+}
+
+func (s *Store) GetRelations(ctx context.Context, user_id int, other_ids []int) (map[int]model.Relation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	relations := map[int]model.Relation{}
+	for _, id := range other_ids {
+		r := model.Relation{
+			OtherId:   id,
+			Blocking:  s.blocks[pair{user_id, id}],
+			BlockedBy: s.blocks[pair{id, user_id}],
+			Muting:    s.mutes[pair{user_id, id}],
+		}
+		if f, ok := s.follows[pair{user_id, id}]; ok {
+			c := *f
+			r.Out = &c
+		}
+		if f, ok := s.follows[pair{id, user_id}]; ok {
+			c := *f
+			r.In = &c
+		}
+		relations[id] = r
+	}
+	return relations, nil
+}
@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+)
+
+func (s *Store) CreateNotification(ctx context.Context, n *model.Notification) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nn := *n
+	nn.Id = s.nextId("notifications")
+	nn.IsRead = false
+	nn.CreatedAt = now()
+	nn.UpdatedAt = nn.CreatedAt
+	s.notifications[nn.Id] = &nn
+	return nn.Id, nil
+}
+
+func (s *Store) GetFollowNotification(ctx context.Context, user_id int, from_user_id int) (*model.Notification, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, n := range s.notifications {
+		if n.UserID == user_id && n.FromUserId == from_user_id && n.NotifType == "follow_request" {
+			nn := *n
+			return &nn, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (s *Store) GetNotificationById(ctx context.Context, id int) (*model.Notification, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n, ok := s.notifications[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	nn := *n
+	return &nn, nil
+}
+
+func (s *Store) GetNotificationsByUser(ctx context.Context, user_id int, limit int, offset int) ([]*model.Notification, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	notifs := []*model.Notification{}
+	for _, n := range s.notifications {
+		if n.UserID == user_id && !s.isDeleted(n.FromUserId) {
+			nn := *n
+			notifs = append(notifs, &nn)
+		}
+	}
+	sort.Slice(notifs, func(i, j int) bool {
+		if !notifs[i].CreatedAt.Equal(notifs[j].CreatedAt) {
+			return notifs[i].CreatedAt.After(notifs[j].CreatedAt)
+		}
+		return notifs[i].Id > notifs[j].Id
+	})
+	if offset >= len(notifs) {
+		return []*model.Notification{}, nil
+	}
+	notifs = notifs[offset:]
+	if len(notifs) > limit {
+		notifs = notifs[:limit]
+	}
+	return notifs, nil
+}
+
+func (s *Store) UpdateNotificationRead(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n, ok := s.notifications[id]; ok {
+		n.IsRead = true
+		n.UpdatedAt = now()
+	}
+	return nil
+}
+
+func (s *Store) DeleteFollowNotification(ctx context.Context, user_id int, from_user_id int, notif_type string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, n := range s.notifications {
+		if n.UserID == user_id && n.FromUserId == from_user_id && n.NotifType == notif_type {
+			delete(s.notifications, id)
+		}
+	}
+	return nil
+}
+
+func (s *Store) DeleteNotificationByID(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.notifications, id)
+	return nil
+}
@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+// paginate returns the rows after page.After, up to page.Size, out of
+// rows sorted by less on their keys
+func paginate[T any](rows []T, page repo.Page, key func(T) repo.Key, less func(a, b repo.Key) bool) []T {
+	if page.After != nil {
+		i := sort.Search(len(rows), func(i int) bool {
+			return less(*page.After, key(rows[i]))
+		})
+		rows = rows[i:]
+	}
+	if len(rows) > page.Limit() {
+		rows = rows[:page.Limit()]
+	}
+	return rows
+}
+
+// newestFirst orders repo.PostKey and repo.ConversationKey
+func newestFirst(a, b repo.Key) bool {
+	if !a.Time.Equal(b.Time) {
+		return a.Time.After(b.Time)
+	}
+	return a.Id > b.Id
+}
+
+// oldestFirst orders repo.DMKey
+func oldestFirst(a, b repo.Key) bool {
+	if !a.Time.Equal(b.Time) {
+		return a.Time.Before(b.Time)
+	}
+	return a.Id < b.Id
+}
+
+// byId orders repo.CommentKey, repo.UserKey, repo.FollowerKey and
+// repo.FollowedKey
+func byId(a, b repo.Key) bool {
+	return a.Id < b.Id
+}
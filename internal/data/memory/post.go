@@ -0,0 +1,221 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+func (s *Store) CreatePost(ctx context.Context, p *model.Post) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	np := *p
+	np.Id = s.nextId("posts")
+	np.CreatedAt = now()
+	np.UpdatedAt = np.CreatedAt
+	s.posts[np.Id] = &np
+	return np.Id, nil
+}
+
+// GetFeed follows query.SelectFeed: everything user_id can see except
+// unlisted posts and posts by users they muted or are blocked with,
+// newest first
+func (s *Store) GetFeed(ctx context.Context, user_id int, page repo.Page) (*[]model.Post, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	posts := []model.Post{}
+	for _, p := range s.posts {
+		if p.Active != 1 {
+			continue
+		}
+		if p.AuthorId != user_id && !s.listedFor(p, user_id) {
+			continue
+		}
+		if s.blocks[pair{user_id, p.AuthorId}] || s.blocks[pair{p.AuthorId, user_id}] || s.mutes[pair{user_id, p.AuthorId}] {
+			continue
+		}
+		posts = append(posts, *p)
+	}
+	sort.Slice(posts, func(i, j int) bool {
+		return newestFirst(repo.PostKey(posts[i]), repo.PostKey(posts[j]))
+	})
+	posts = paginate(posts, page, repo.PostKey, newestFirst)
+	return &posts, nil
+}
+
+func (s *Store) GetPosts(ctx context.Context) (*[]model.Post, error) {
+	return s.getPosts(func(p *model.Post) bool {
+		return p.Active == 1
+	}, repo.Page{})
+}
+
+func (s *Store) GetUserPosts(ctx context.Context, user_id int, page repo.Page) (*[]model.Post, error) {
+	return s.getPosts(func(p *model.Post) bool {
+		return p.AuthorId == user_id && p.Active == 1
+	}, page)
+}
+
+func (s *Store) GetUserPublicPosts(ctx context.Context, user_id int, page repo.Page) (*[]model.Post, error) {
+	return s.getPosts(func(p *model.Post) bool {
+		return p.AuthorId == user_id && p.Active == 1 && p.Visibility == model.VisibilityPublic
+	}, page)
+}
+
+func (s *Store) GetUserVisiblePosts(ctx context.Context, author_id int, viewer_id int, page repo.Page) (*[]model.Post, error) {
+	return s.getPosts(func(p *model.Post) bool {
+		return p.AuthorId == author_id && p.Active == 1 && s.listedFor(p, viewer_id)
+	}, page)
+}
+
+// listedFor reports whether p shows up in lists for viewer_id, callers
+// must hold the lock
+func (s *Store) listedFor(p *model.Post, viewer_id int) bool {
+	switch p.Visibility {
+	case model.VisibilityPublic:
+		return true
+	case model.VisibilityFollowers:
+		f, ok := s.follows[pair{viewer_id, p.AuthorId}]
+		return ok && f.Status == model.FollowAccepted
+	case model.VisibilityCloseFriends:
+		return s.closeFriends[pair{p.AuthorId, viewer_id}]
+	case model.VisibilitySpecificUsers:
+		return s.postAudience[pair{p.Id, viewer_id}]
+	default:
+		return false
+	}
+}
+
+// getPosts returns the page of posts matching filter, newest first
+func (s *Store) getPosts(filter func(p *model.Post) bool, page repo.Page) (*[]model.Post, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	posts := []model.Post{}
+	for _, p := range s.posts {
+		if filter(p) {
+			posts = append(posts, *p)
+		}
+	}
+	sort.Slice(posts, func(i, j int) bool {
+		return newestFirst(repo.PostKey(posts[i]), repo.PostKey(posts[j]))
+	})
+	posts = paginate(posts, page, repo.PostKey, newestFirst)
+	return &posts, nil
+}
+
+func (s *Store) GetPostByGUID(ctx context.Context, guid string) (*model.Post, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	p := s.postByGUID(guid)
+	if p == nil {
+		return nil, sql.ErrNoRows
+	}
+	c := *p
+	return &c, nil
+}
+
+func (s *Store) GetPostByID(ctx context.Context, id int) (*model.Post, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	p, ok := s.posts[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	c := *p
+	return &c, nil
+}
+
+// UpdatePost keeps the version it replaces as a revision, an update that
+// changes nothing is skipped
+func (s *Store) UpdatePost(ctx context.Context, post model.Post) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.postByGUID(post.GUID)
+	if p == nil {
+		return sql.ErrNoRows
+	}
+	if p.Title == post.Title && p.Content == post.Content && p.Visibility == post.Visibility {
+		return nil
+	}
+	s.postRevisions[pair{p.Id, p.EditCount + 1}] = &model.PostRevision{
+		PostId:     p.Id,
+		Revision:   p.EditCount + 1,
+		Title:      p.Title,
+		Content:    p.Content,
+		Visibility: p.Visibility,
+		CreatedAt:  p.VersionTime(),
+	}
+	p.Title = post.Title
+	p.Content = post.Content
+	p.Visibility = post.Visibility
+	p.EditCount++
+	p.EditedAt = now()
+	p.UpdatedAt = p.EditedAt
+	return nil
+}
+
+func (s *Store) GetPostRevisions(ctx context.Context, post_id int) (*[]model.PostRevision, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	revisions := []model.PostRevision{}
+	for k, r := range s.postRevisions {
+		if k.a == post_id {
+			revisions = append(revisions, *r)
+		}
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+	return &revisions, nil
+}
+
+func (s *Store) GetPostRevision(ctx context.Context, post_id int, revision int) (*model.PostRevision, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	r, ok := s.postRevisions[pair{post_id, revision}]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	c := *r
+	return &c, nil
+}
+
+func (s *Store) DisablePost(ctx context.Context, guid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p := s.postByGUID(guid); p != nil {
+		p.Active = 0
+		p.UpdatedAt = now()
+	}
+	return nil
+}
+
+func (s *Store) SetPostAudience(ctx context.Context, post_id int, user_ids []int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k := range s.postAudience {
+		if k.a == post_id {
+			delete(s.postAudience, k)
+		}
+	}
+	for _, user_id := range user_ids {
+		s.postAudience[pair{post_id, user_id}] = true
+	}
+	return nil
+}
+
+func (s *Store) IsInPostAudience(ctx context.Context, post_id int, user_id int) (bool, error) {
+	return s.hasPair(s.postAudience, pair{post_id, user_id}), nil
+}
+
+// postByGUID returns the stored post, callers must hold the lock
+func (s *Store) postByGUID(guid string) *model.Post {
+	for _, p := range s.posts {
+		if p.GUID == guid {
+			return p
+		}
+	}
+	return nil
+}
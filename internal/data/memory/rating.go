@@ -0,0 +1,177 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+)
+
+func (s *Store) RatePostUp(ctx context.Context, post_id int, user_id int) error {
+	return s.ratePost(post_id, user_id, 1)
+}
+
+func (s *Store) RatePostDown(ctx context.Context, post_id int, user_id int) error {
+	return s.ratePost(post_id, user_id, -1)
+}
+
+func (s *Store) GetPostUserRating(ctx context.Context, post_id int, user_id int) (*model.PostRatings, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	pr := model.PostRatings{}
+	v, ok := s.postRatings[pair{post_id, user_id}]
+	if !ok {
+		return &pr, sql.ErrNoRows
+	}
+	pr.PostId = post_id
+	pr.UserId = user_id
+	pr.RatingValue = v
+	return &pr, nil
+}
+
+func (s *Store) RateCommentUp(ctx context.Context, comment_id int, user_id int) error {
+	return s.rateComment(comment_id, user_id, 1)
+}
+
+func (s *Store) RateCommentDown(ctx context.Context, comment_id int, user_id int) error {
+	return s.rateComment(comment_id, user_id, -1)
+}
+
+func (s *Store) GetCommentUserRating(ctx context.Context, comment_id int, user_id int) (*model.CommentRatings, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cr := model.CommentRatings{}
+	v, ok := s.commentRatings[pair{comment_id, user_id}]
+	if !ok {
+		return &cr, sql.ErrNoRows
+	}
+	cr.CommentId = comment_id
+	cr.UserId = user_id
+	cr.RatingValue = v
+	return &cr, nil
+}
+
+func (s *Store) GetPostVotes(ctx context.Context, user_id int, post_ids []int) (map[int]model.Votes, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return countVotes(s.postRatings, user_id, post_ids), nil
+}
+
+func (s *Store) GetCommentVotes(ctx context.Context, user_id int, comment_ids []int) (map[int]model.Votes, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return countVotes(s.commentRatings, user_id, comment_ids), nil
+}
+
+func (s *Store) GetPostRatings(ctx context.Context, post_id int) (*[]model.PostRatings, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ratings := []model.PostRatings{}
+	for k, v := range s.postRatings {
+		if k.a != post_id || v == 0 || s.isDeleted(k.b) {
+			continue
+		}
+		ratings = append(ratings, model.PostRatings{PostId: k.a, UserId: k.b, RatingValue: v})
+	}
+	sort.Slice(ratings, func(i, j int) bool {
+		return ratings[i].UserId < ratings[j].UserId
+	})
+	return &ratings, nil
+}
+
+func (s *Store) SetPostRating(ctx context.Context, post_id int, user_id int, value int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.posts[post_id]
+	if !ok {
+		return 0, sql.ErrNoRows
+	}
+	k := pair{post_id, user_id}
+	previous := s.postRatings[k]
+	s.postRatings[k] = value
+	p.Rating = sumRatings(s.postRatings, post_id)
+	return previous, nil
+}
+
+func (s *Store) SetCommentRating(ctx context.Context, comment_id int, user_id int, value int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.comments[comment_id]
+	if !ok {
+		return 0, sql.ErrNoRows
+	}
+	k := pair{comment_id, user_id}
+	previous := s.commentRatings[k]
+	s.commentRatings[k] = value
+	c.Rating = sumRatings(s.commentRatings, comment_id)
+	return previous, nil
+}
+
+// ratePost toggles the vote and keeps posts.rating as the sum of the votes,
+// like the database triggers do
+func (s *Store) ratePost(post_id int, user_id int, value int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := pair{post_id, user_id}
+	s.postRatings[k] = toggle(s.postRatings[k], value)
+	if p, ok := s.posts[post_id]; ok {
+		p.Rating = sumRatings(s.postRatings, post_id)
+	}
+	return nil
+}
+
+func (s *Store) rateComment(comment_id int, user_id int, value int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := pair{comment_id, user_id}
+	s.commentRatings[k] = toggle(s.commentRatings[k], value)
+	if c, ok := s.comments[comment_id]; ok {
+		c.Rating = sumRatings(s.commentRatings, comment_id)
+	}
+	return nil
+}
+
+func toggle(current int, value int) int {
+	if current == value {
+		return 0
+	}
+	return value
+}
+
+func sumRatings(ratings map[pair]int, id int) int {
+	sum := 0
+	for k, v := range ratings {
+		if k.a == id {
+			sum += v
+		}
+	}
+	return sum
+}
+
+// countVotes is GetPostVotes over either ratings map, ids without a row
+// are left out like the GROUP BY does
+func countVotes(ratings map[pair]int, user_id int, ids []int) map[int]model.Votes {
+	wanted := map[int]bool{}
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	votes := map[int]model.Votes{}
+	for k, value := range ratings {
+		if !wanted[k.a] {
+			continue
+		}
+		v := votes[k.a]
+		switch value {
+		case 1:
+			v.Up++
+		case -1:
+			v.Down++
+		}
+		if k.b == user_id {
+			v.Mine = value
+		}
+		votes[k.a] = v
+	}
+	return votes
+}
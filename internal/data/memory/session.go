@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+)
+
+// CreateSession reassigns an existing session_id to the new user
+func (s *Store) CreateSession(ctx context.Context, se *model.Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.sessions {
+		if existing.SessionId == se.SessionId {
+			existing.UserId = se.UserId
+			existing.UpdatedAt = now()
+			return nil
+		}
+	}
+	ns := *se
+	ns.Id = s.nextId("sessions")
+	ns.CreatedAt = now()
+	ns.UpdatedAt = ns.CreatedAt
+	s.sessions[ns.Id] = &ns
+	return nil
+}
+
+func (s *Store) GetSessionById(ctx context.Context, id int) (*model.Session, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	se, ok := s.sessions[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	c := *se
+	return &c, nil
+}
+
+func (s *Store) GetSessionBySessionId(ctx context.Context, session_id string) (*model.Session, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, se := range s.sessions {
+		if se.SessionId == session_id {
+			c := *se
+			return &c, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
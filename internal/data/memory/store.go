@@ -0,0 +1,106 @@
+// Package memory implements every repository in process memory.
+//
+// It mirrors the behaviour of the MySQL implementation in orm, including
+// the ordering of lists, so it can stand in for the database in tests and
+// local demos. Nothing is persisted, and since no call blocks on I/O the
+// contexts taken by the repository methods are not consulted.
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+type pair struct {
+	a int
+	b int
+}
+
+type Store struct {
+	mu sync.RWMutex
+	// txMu is held by the running transaction, see InTx
+	txMu sync.Mutex
+
+	users        map[int]*model.User
+	follows      map[pair]*model.Follows
+	closeFriends map[pair]bool
+	blocks       map[pair]bool
+	mutes        map[pair]bool
+	posts        map[int]*model.Post
+	postAudience map[pair]bool
+	postRatings  map[pair]int
+	// postRevisions is keyed by post id and revision, revisions are never
+	// changed once stored
+	postRevisions  map[pair]*model.PostRevision
+	comments       map[int]*model.Comment
+	commentRatings map[pair]int
+	// commentRevisions is keyed like postRevisions
+	commentRevisions map[pair]*model.CommentRevision
+	conversations    map[int]*model.Conversation
+	dms              map[int]*model.DMessage
+	notifications    map[int]*model.Notification
+	tags             map[int]*model.Tag
+	userTags         map[int]*model.UserTag
+	referenceTags    map[int]*model.ReferenceTag
+	tokens           map[int]*model.Token
+	sessions         map[int]*model.Session
+
+	lastId map[string]int
+}
+
+func New() *Store {
+	return &Store{
+		users:            map[int]*model.User{},
+		follows:          map[pair]*model.Follows{},
+		closeFriends:     map[pair]bool{},
+		blocks:           map[pair]bool{},
+		mutes:            map[pair]bool{},
+		posts:            map[int]*model.Post{},
+		postAudience:     map[pair]bool{},
+		postRatings:      map[pair]int{},
+		postRevisions:    map[pair]*model.PostRevision{},
+		comments:         map[int]*model.Comment{},
+		commentRatings:   map[pair]int{},
+		commentRevisions: map[pair]*model.CommentRevision{},
+		conversations:    map[int]*model.Conversation{},
+		dms:              map[int]*model.DMessage{},
+		notifications:    map[int]*model.Notification{},
+		tags:             map[int]*model.Tag{},
+		userTags:         map[int]*model.UserTag{},
+		referenceTags:    map[int]*model.ReferenceTag{},
+		tokens:           map[int]*model.Token{},
+		sessions:         map[int]*model.Session{},
+		lastId:           map[string]int{},
+	}
+}
+
+// Repositories returns s as every repository
+func (s *Store) Repositories() repo.Repositories {
+	return repo.Repositories{
+		Tx:            s,
+		Users:         s,
+		Follows:       s,
+		Posts:         s,
+		Comments:      s,
+		Ratings:       s,
+		Conversations: s,
+		Notifications: s,
+		Tags:          s,
+		Tokens:        s,
+		Sessions:      s,
+	}
+}
+
+// nextId works like an AUTO_INCREMENT column, callers must hold the lock
+func (s *Store) nextId(table string) int {
+	s.lastId[table]++
+	return s.lastId[table]
+}
+
+// now matches the precision of the DATETIME columns
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
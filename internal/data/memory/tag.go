@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+)
+
+func (s *Store) CreateTag(ctx context.Context, t *model.Tag) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nt := *t
+	nt.Id = s.nextId("tags")
+	s.tags[nt.Id] = &nt
+	return nil
+}
+
+func (s *Store) GetTagByName(ctx context.Context, tag_name string) (*model.Tag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, t := range s.tags {
+		if t.TagName == tag_name {
+			tt := *t
+			return &tt, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (s *Store) CreateUserTag(ctx context.Context, t *model.UserTag) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nt := *t
+	nt.Id = s.nextId("user_tags")
+	s.userTags[nt.Id] = &nt
+	return nil
+}
+
+func (s *Store) GetUserTagById(ctx context.Context, tag_id int) (*model.UserTag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, t := range s.userTags {
+		if t.TagId == tag_id {
+			tt := *t
+			return &tt, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (s *Store) DeleteUserTagByID(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.userTags, id)
+	return nil
+}
+
+func (s *Store) CreateReferenceTag(ctx context.Context, t *model.ReferenceTag) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nt := *t
+	nt.Id = s.nextId("reference_tags")
+	s.referenceTags[nt.Id] = &nt
+	return nil
+}
+
+func (s *Store) GetReferenceTagById(ctx context.Context, tag_id int) (*model.ReferenceTag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, t := range s.referenceTags {
+		if t.TagId == tag_id {
+			tt := *t
+			return &tt, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (s *Store) DeleteReferenceTagByID(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.referenceTags, id)
+	return nil
+}
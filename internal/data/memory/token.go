@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+)
+
+// CreateToken replaces the user's token, tokens.user_id is unique
+func (s *Store) CreateToken(ctx context.Context, t *model.Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.tokens {
+		if existing.UserId == t.UserId {
+			existing.Token = t.Token
+			existing.UpdatedAt = now()
+			return nil
+		}
+	}
+	nt := *t
+	nt.Id = s.nextId("tokens")
+	nt.CreatedAt = now()
+	nt.UpdatedAt = nt.CreatedAt
+	s.tokens[nt.Id] = &nt
+	return nil
+}
+
+func (s *Store) GetTokenByUserId(ctx context.Context, id int) (*model.Token, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, t := range s.tokens {
+		if t.UserId == id {
+			tt := *t
+			return &tt, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (s *Store) DeleteTokenByUserId(ctx context.Context, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, t := range s.tokens {
+		if t.UserId == id {
+			delete(s.tokens, k)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"maps"
+
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+// InTx implements repo.UnitOfWork. Transactions run one at a time and a
+// failed one puts back a copy of the store taken when it began, so writes
+// made outside a transaction while it runs are rolled back with it.
+func (s *Store) InTx(ctx context.Context, f func(r repo.Repositories) error) error {
+	s.txMu.Lock()
+	defer s.txMu.Unlock()
+	saved := s.snapshot()
+	if err := f(txStore{s}.Repositories()); err != nil {
+		s.restore(saved)
+		return err
+	}
+	return nil
+}
+
+// txStore is the store as handed to a transaction, InTx on it joins the
+// running transaction instead of waiting for it
+type txStore struct {
+	*Store
+}
+
+func (t txStore) InTx(ctx context.Context, f func(r repo.Repositories) error) error {
+	return f(t.Repositories())
+}
+
+func (t txStore) Repositories() repo.Repositories {
+	r := t.Store.Repositories()
+	r.Tx = t
+	return r
+}
+
+func (s *Store) snapshot() *Store {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &Store{
+		users:            cloneRows(s.users),
+		follows:          cloneRows(s.follows),
+		closeFriends:     maps.Clone(s.closeFriends),
+		blocks:           maps.Clone(s.blocks),
+		mutes:            maps.Clone(s.mutes),
+		posts:            cloneRows(s.posts),
+		postAudience:     maps.Clone(s.postAudience),
+		postRatings:      maps.Clone(s.postRatings),
+		postRevisions:    maps.Clone(s.postRevisions),
+		comments:         cloneRows(s.comments),
+		commentRatings:   maps.Clone(s.commentRatings),
+		commentRevisions: maps.Clone(s.commentRevisions),
+		conversations:    cloneRows(s.conversations),
+		dms:              cloneRows(s.dms),
+		notifications:    cloneRows(s.notifications),
+		tags:             cloneRows(s.tags),
+		userTags:         cloneRows(s.userTags),
+		referenceTags:    cloneRows(s.referenceTags),
+		tokens:           cloneRows(s.tokens),
+		sessions:         cloneRows(s.sessions),
+		lastId:           maps.Clone(s.lastId),
+	}
+}
+
+func (s *Store) restore(saved *Store) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users = saved.users
+	s.follows = saved.follows
+	s.closeFriends = saved.closeFriends
+	s.blocks = saved.blocks
+	s.mutes = saved.mutes
+	s.posts = saved.posts
+	s.postAudience = saved.postAudience
+	s.postRatings = saved.postRatings
+	s.postRevisions = saved.postRevisions
+	s.comments = saved.comments
+	s.commentRatings = saved.commentRatings
+	s.commentRevisions = saved.commentRevisions
+	s.conversations = saved.conversations
+	s.dms = saved.dms
+	s.notifications = saved.notifications
+	s.tags = saved.tags
+	s.userTags = saved.userTags
+	s.referenceTags = saved.referenceTags
+	s.tokens = saved.tokens
+	s.sessions = saved.sessions
+	s.lastId = saved.lastId
+}
+
+// cloneRows copies the rows too, the store updates them in place
+func cloneRows[K comparable, V any](m map[K]*V) map[K]*V {
+	c := make(map[K]*V, len(m))
+	for k, v := range m {
+		row := *v
+		c[k] = &row
+	}
+	return c
+}
@@ -0,0 +1,420 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+var (
+	errDuplicateUser = fmt.Errorf("%w: duplicate username or email", repo.ErrDuplicate)
+)
+
+func (s *Store) CreateUser(ctx context.Context, u *model.User) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.users {
+		if existing.UserName == u.UserName || existing.Email == u.Email {
+			return 0, errDuplicateUser
+		}
+	}
+	nu := *u
+	nu.Id = s.nextId("users")
+	nu.CreatedAt = now()
+	nu.UpdatedAt = nu.CreatedAt
+	s.users[nu.Id] = &nu
+	return nu.Id, nil
+}
+
+func (s *Store) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u, ok := s.users[id]
+	if !ok || deleted(u) {
+		return nil, sql.ErrNoRows
+	}
+	return s.copyUser(u), nil
+}
+
+func (s *Store) GetUserByName(ctx context.Context, name string) (*model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u := s.userByName(name)
+	if u == nil {
+		return nil, sql.ErrNoRows
+	}
+	return s.copyUser(u), nil
+}
+
+func (s *Store) GetDeletedUserByName(ctx context.Context, name string) (*model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, u := range s.users {
+		if u.UserName == name && deleted(u) {
+			return s.copyUser(u), nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (s *Store) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, u := range s.users {
+		if u.Email == email && !deleted(u) {
+			return s.copyUser(u), nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (s *Store) SearchUsers(ctx context.Context, term string, viewer_id int, limit int) (*[]model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	users := []model.User{}
+	letters := []rune(strings.ToLower(term))
+	if len(letters) == 0 {
+		return &users, nil
+	}
+	first, last := string(letters[0]), string(letters[len(letters)-1])
+	for _, u := range s.sortedUsers() {
+		name := strings.ToLower(u.UserName)
+		if !strings.Contains(name, string(letters)) && !strings.HasPrefix(name, first) && !strings.HasSuffix(name, last) {
+			continue
+		}
+		if u.Active != 1 || s.blocks[pair{u.Id, viewer_id}] || s.blocks[pair{viewer_id, u.Id}] {
+			continue
+		}
+		users = append(users, *s.copyUser(u))
+	}
+	// like the SQL: exact, prefix and substring matches first
+	tier := func(u model.User) int {
+		name := strings.ToLower(u.UserName)
+		switch {
+		case name == string(letters):
+			return 0
+		case strings.HasPrefix(name, string(letters)):
+			return 1
+		case strings.Contains(name, string(letters)):
+			return 2
+		}
+		return 3
+	}
+	sort.SliceStable(users, func(i, j int) bool {
+		if ti, tj := tier(users[i]), tier(users[j]); ti != tj {
+			return ti < tj
+		}
+		return users[i].Followers > users[j].Followers
+	})
+	if len(users) > limit {
+		users = users[:limit]
+	}
+	return &users, nil
+}
+
+func (s *Store) GetUsersByIDs(ctx context.Context, ids []int) (*[]model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	wanted := map[int]bool{}
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	users := []model.User{}
+	for _, u := range s.sortedUsers() {
+		if wanted[u.Id] {
+			users = append(users, *s.copyUser(u))
+		}
+	}
+	return &users, nil
+}
+
+func (s *Store) GetUsersByNames(ctx context.Context, names []string) (*[]model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+	users := []model.User{}
+	for _, u := range s.sortedUsers() {
+		if wanted[u.UserName] {
+			users = append(users, *s.copyUser(u))
+		}
+	}
+	return &users, nil
+}
+
+func (s *Store) SetUserAsActive(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if u := s.userByName(name); u != nil {
+		u.Active = 1
+		u.UpdatedAt = now()
+	}
+	return nil
+}
+
+func (s *Store) SetNewPassword(ctx context.Context, user string, pass string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if u := s.userByName(user); u != nil {
+		u.HashPass = pass
+		u.UpdatedAt = now()
+	}
+	return nil
+}
+
+func (s *Store) SetUserPrivacy(ctx context.Context, user string, is_private bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if u := s.userByName(user); u != nil {
+		u.IsPrivate = is_private
+		u.UpdatedAt = now()
+	}
+	return nil
+}
+
+func (s *Store) SetUserEmail(ctx context.Context, user string, email string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u := s.userByName(user)
+	if u == nil {
+		return nil
+	}
+	for _, existing := range s.users {
+		if existing.Id != u.Id && existing.Email == email {
+			return errDuplicateUser
+		}
+	}
+	u.Email = email
+	u.UpdatedAt = now()
+	return nil
+}
+
+// DeleteUser hides the user and their posts and comments until they're
+// restored or purged
+func (s *Store) DeleteUser(ctx context.Context, user string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u := s.userByName(user)
+	if u == nil {
+		return nil
+	}
+	u.DeletedAt = now()
+	s.setContentActive(u.Id, model.ContentActive, model.ContentAuthorDeleted)
+	return nil
+}
+
+func (s *Store) RestoreUser(ctx context.Context, user string, since time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, u := range s.users {
+		if u.UserName == user && deleted(u) && !u.DeletedAt.Before(since) {
+			u.DeletedAt = time.Time{}
+			s.setContentActive(u.Id, model.ContentAuthorDeleted, model.ContentActive)
+			return nil
+		}
+	}
+	return repo.ErrNotRestorable
+}
+
+func (s *Store) PurgeDeletedUsers(ctx context.Context, before time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	purged := 0
+	for _, u := range s.users {
+		if deleted(u) && u.DeletedAt.Before(before) {
+			s.purgeUser(u.Id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// setContentActive moves the posts and comments of a user from one active
+// value to another, callers must hold the lock
+func (s *Store) setContentActive(user_id int, from int, to int) {
+	for _, p := range s.posts {
+		if p.AuthorId == user_id && p.Active == from {
+			p.Active = to
+		}
+	}
+	for _, c := range s.comments {
+		if c.AuthorId == user_id && c.Active == from {
+			c.Active = to
+		}
+	}
+}
+
+// purgeUser deletes a user with everything the foreign keys cascade to,
+// callers must hold the lock
+func (s *Store) purgeUser(id int) {
+	delete(s.users, id)
+	for _, m := range []map[pair]bool{s.closeFriends, s.blocks, s.mutes} {
+		for k := range m {
+			if k.a == id || k.b == id {
+				delete(m, k)
+			}
+		}
+	}
+	for k := range s.follows {
+		if k.a == id || k.b == id {
+			delete(s.follows, k)
+		}
+	}
+	for pid, p := range s.posts {
+		if p.AuthorId == id {
+			s.purgePost(p)
+			delete(s.posts, pid)
+		}
+	}
+	for cid, c := range s.comments {
+		if c.AuthorId == id {
+			s.purgeComment(c)
+			delete(s.comments, cid)
+		}
+	}
+	for k := range s.postRatings {
+		if k.b == id {
+			delete(s.postRatings, k)
+		}
+	}
+	for k := range s.commentRatings {
+		if k.b == id {
+			delete(s.commentRatings, k)
+		}
+	}
+	for k := range s.postAudience {
+		if k.b == id {
+			delete(s.postAudience, k)
+		}
+	}
+	for cid, c := range s.conversations {
+		if c.User1Id == id || c.User2Id == id {
+			delete(s.conversations, cid)
+			for did, d := range s.dms {
+				if d.ConversationId == cid {
+					delete(s.dms, did)
+				}
+			}
+		}
+	}
+	for nid, n := range s.notifications {
+		if n.UserID == id || n.FromUserId == id {
+			delete(s.notifications, nid)
+		}
+	}
+	for tid, t := range s.tokens {
+		if t.UserId == id {
+			delete(s.tokens, tid)
+		}
+	}
+	for sid, se := range s.sessions {
+		if se.UserId == id {
+			delete(s.sessions, sid)
+		}
+	}
+}
+
+// purgePost deletes the comments, ratings, audience and revisions of p,
+// callers must hold the lock
+func (s *Store) purgePost(p *model.Post) {
+	for cid, c := range s.comments {
+		if c.PostGUID == p.GUID {
+			s.purgeComment(c)
+			delete(s.comments, cid)
+		}
+	}
+	for k := range s.postRatings {
+		if k.a == p.Id {
+			delete(s.postRatings, k)
+		}
+	}
+	for k := range s.postAudience {
+		if k.a == p.Id {
+			delete(s.postAudience, k)
+		}
+	}
+	for k := range s.postRevisions {
+		if k.a == p.Id {
+			delete(s.postRevisions, k)
+		}
+	}
+}
+
+// purgeComment deletes the ratings and revisions of c, callers must hold
+// the lock
+func (s *Store) purgeComment(c *model.Comment) {
+	for k := range s.commentRatings {
+		if k.a == c.Id {
+			delete(s.commentRatings, k)
+		}
+	}
+	for k := range s.commentRevisions {
+		if k.a == c.Id {
+			delete(s.commentRevisions, k)
+		}
+	}
+}
+
+// deleted reports whether the account of u is waiting to be purged
+func deleted(u *model.User) bool {
+	return !u.DeletedAt.IsZero()
+}
+
+// isDeleted is deleted by id, callers must hold the lock
+func (s *Store) isDeleted(id int) bool {
+	u, ok := s.users[id]
+	return ok && deleted(u)
+}
+
+// userByName returns the stored user unless it's deleted, callers must
+// hold the lock
+func (s *Store) userByName(name string) *model.User {
+	for _, u := range s.users {
+		if u.UserName == name && !deleted(u) {
+			return u
+		}
+	}
+	return nil
+}
+
+// sortedUsers returns the users that aren't deleted, ordered by id
+func (s *Store) sortedUsers() []*model.User {
+	users := make([]*model.User, 0, len(s.users))
+	for _, u := range s.users {
+		if !deleted(u) {
+			users = append(users, u)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Id < users[j].Id
+	})
+	return users
+}
+
+// copyUser fills in the follow counters, which the database keeps in the
+// users table, leaving out deleted users like query.SelectFollowCounts
+func (s *Store) copyUser(u *model.User) *model.User {
+	c := *u
+	c.Followers = 0
+	c.Following = 0
+	for k, f := range s.follows {
+		if f.Status != model.FollowAccepted {
+			continue
+		}
+		if k.b == u.Id && !s.isDeleted(k.a) {
+			c.Followers++
+		}
+		if k.a == u.Id && !s.isDeleted(k.b) {
+			c.Following++
+		}
+	}
+	return &c
+}
@@ -0,0 +1,386 @@
+// Package migrations versions the database schema of the API.
+//
+// Migrations live in sql/<dialect>/ as NNNN_name.up.sql and
+// NNNN_name.down.sql and are embedded in the binary, every dialect has the
+// same versions. Each statement ends with a semicolon at the end of a line,
+// statements with semicolons of their own, such as trigger bodies, go
+// between "-- +statement begin" and "-- +statement end" lines. Applied
+// versions are recorded in the schema_migrations table.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/pkg/db"
+)
+
+//go:embed sql
+var files embed.FS
+
+const (
+	createTable = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INT PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)
+	;`
+
+	selectApplied = `
+	SELECT version, applied_at FROM schema_migrations
+	;`
+
+	insertApplied = `
+	INSERT INTO schema_migrations (version, name)
+		VALUES (?, ?)
+	;`
+
+	deleteApplied = `
+	DELETE FROM schema_migrations
+		WHERE version=?
+	;`
+
+	// lockName serializes migrators across API instances sharing a
+	// database, postgres advisory locks take a number instead
+	lockName    = "lenic_api_migrations"
+	lockKey     = 7343218
+	lockTimeout = 30
+
+	beginStatement = "-- +statement begin"
+	endStatement   = "-- +statement end"
+)
+
+var (
+	fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+	ErrUnknownVersion = errors.New("unknown migration version")
+	ErrLocked         = errors.New("another migration is running")
+)
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is a migration and when it was applied, AppliedAt is zero for
+// pending migrations
+type Status struct {
+	Migration
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	db         *sql.DB
+	dialect    db.Dialect
+	migrations []Migration
+}
+
+func New(database *sql.DB, d db.Dialect) (*Migrator, error) {
+	migrations, err := load(path.Join("sql", d.Name()))
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: database, dialect: d, migrations: migrations}, nil
+}
+
+// Up applies every pending migration in order and returns them
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	applied := []Migration{}
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if _, ok := done[mig.Version]; ok {
+				continue
+			}
+			err := m.run(ctx, conn, mig.Up, m.dialect.Rebind(insertApplied), mig.Version, mig.Name)
+			if err != nil {
+				return fmt.Errorf("migration %d_%s up: %w", mig.Version, mig.Name, err)
+			}
+			applied = append(applied, mig)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts the last steps applied migrations, newest first, and
+// returns them
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	reverted := []Migration{}
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := done[mig.Version]; !ok {
+				continue
+			}
+			err := m.run(ctx, conn, mig.Down, m.dialect.Rebind(deleteApplied), mig.Version)
+			if err != nil {
+				return fmt.Errorf("migration %d_%s down: %w", mig.Version, mig.Name, err)
+			}
+			reverted = append(reverted, mig)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Baseline records every migration up to version as applied without
+// running it, for databases whose schema was created by other means such
+// as the lenic web app
+func (m *Migrator) Baseline(ctx context.Context, version int) error {
+	if _, ok := m.find(version); !ok {
+		return ErrUnknownVersion
+	}
+	return m.locked(ctx, func(conn *sql.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if mig.Version > version {
+				break
+			}
+			if _, ok := done[mig.Version]; ok {
+				continue
+			}
+			if _, err := conn.ExecContext(ctx, m.dialect.Rebind(insertApplied), mig.Version, mig.Name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	done, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		statuses = append(statuses, Status{Migration: mig, AppliedAt: done[mig.Version]})
+	}
+	return statuses, nil
+}
+
+// Pending returns the migrations not applied yet
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := []Migration{}
+	for _, s := range statuses {
+		if s.AppliedAt.IsZero() {
+			pending = append(pending, s.Migration)
+		}
+	}
+	return pending, nil
+}
+
+func (m *Migrator) find(version int) (Migration, bool) {
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			return mig, true
+		}
+	}
+	return Migration{}, false
+}
+
+// locked runs f on a single connection holding the migrations lock,
+// sqlite has a single writer to begin with and takes no lock
+func (m *Migrator) locked(ctx context.Context, f func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	switch m.dialect.Name() {
+	case "mysql":
+		var got sql.NullInt64
+		err = conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockName, lockTimeout).Scan(&got)
+		if err != nil {
+			return err
+		}
+		if !got.Valid || got.Int64 != 1 {
+			return ErrLocked
+		}
+		defer conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockName)
+	case "postgres":
+		var got bool
+		err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", lockKey).Scan(&got)
+		if err != nil {
+			return err
+		}
+		if !got {
+			return ErrLocked
+		}
+		defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey)
+	}
+
+	return f(conn)
+}
+
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int]time.Time, error) {
+	if _, err := conn.ExecContext(ctx, createTable); err != nil {
+		return nil, err
+	}
+	rows, err := conn.QueryContext(ctx, selectApplied)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	done := map[int]time.Time{}
+	for rows.Next() {
+		var (
+			version   int
+			appliedAt interface{}
+		)
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		t, err := db.ParseTime(appliedAt)
+		if err != nil {
+			return nil, err
+		}
+		done[version] = t
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return done, nil
+}
+
+// run executes script one statement at a time and then record with args.
+// Postgres and sqlite run it all in a transaction, MySQL commits DDL
+// implicitly so a failed migration has to be fixed by hand
+func (m *Migrator) run(ctx context.Context, conn *sql.Conn, script string, record string, args ...interface{}) error {
+	if m.dialect.Name() == "mysql" {
+		for _, stmt := range statements(script) {
+			if _, err := conn.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		_, err := conn.ExecContext(ctx, record, args...)
+		return err
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, stmt := range statements(script) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// statements splits script on semicolons ending a line, dropping
+// comment lines, lines between statement markers are kept together
+func statements(script string) []string {
+	stmts := []string{}
+	var b strings.Builder
+	block := false
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == beginStatement:
+			block = true
+			continue
+		case trimmed == endStatement:
+			block = false
+			if rest := strings.TrimSpace(b.String()); rest != "" {
+				stmts = append(stmts, rest)
+			}
+			b.Reset()
+			continue
+		case trimmed == "" || strings.HasPrefix(trimmed, "--"):
+			continue
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+		if !block && strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSpace(b.String()))
+			b.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(b.String()); rest != "" {
+		stmts = append(stmts, rest)
+	}
+	return stmts
+}
+
+// load reads the migrations in dir
+func load(dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(files, dir)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[int]*Migration{}
+	for _, e := range entries {
+		match := fileName.FindStringSubmatch(e.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected migration file %s", e.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		content, err := files.ReadFile(path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = mig
+		} else if mig.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, mig.Name, match[2])
+		}
+		if match[3] == "up" {
+			mig.Up = string(content)
+		} else {
+			mig.Down = string(content)
+		}
+	}
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" || mig.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both up and down files", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
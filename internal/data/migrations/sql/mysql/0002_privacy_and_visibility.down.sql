@@ -0,0 +1,4 @@
+DROP TABLE post_audience;
+DROP TABLE close_friends;
+ALTER TABLE posts DROP COLUMN visibility;
+ALTER TABLE users DROP COLUMN is_private;
@@ -0,0 +1,3 @@
+DELETE FROM users WHERE deleted_at IS NOT NULL;
+DROP INDEX users_deleted_at ON users;
+ALTER TABLE users DROP COLUMN deleted_at;
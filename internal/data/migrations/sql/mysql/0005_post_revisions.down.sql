@@ -0,0 +1,3 @@
+DROP TABLE post_revisions;
+ALTER TABLE posts DROP COLUMN edited_at;
+ALTER TABLE posts DROP COLUMN edit_count;
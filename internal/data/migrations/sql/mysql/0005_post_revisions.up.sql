@@ -0,0 +1,16 @@
+-- post_revisions keeps every version of a post an edit replaced, revision
+-- 1 is the original and created_at is when the version was written
+
+ALTER TABLE posts ADD COLUMN edit_count INT NOT NULL DEFAULT 0;
+ALTER TABLE posts ADD COLUMN edited_at DATETIME NULL;
+
+CREATE TABLE post_revisions (
+    post_id INT NOT NULL,
+    revision INT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    content TEXT NOT NULL,
+    visibility TINYINT NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (post_id, revision),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE
+) CHARACTER SET utf8mb4;
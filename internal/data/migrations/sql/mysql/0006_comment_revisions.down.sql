@@ -0,0 +1,3 @@
+DROP TABLE comment_revisions;
+ALTER TABLE comments DROP COLUMN edited_at;
+ALTER TABLE comments DROP COLUMN edit_count;
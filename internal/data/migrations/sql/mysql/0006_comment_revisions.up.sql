@@ -0,0 +1,14 @@
+-- comment_revisions keeps every version of a comment an edit replaced,
+-- revision 1 is the original and created_at is when it was written
+
+ALTER TABLE comments ADD COLUMN edit_count INT NOT NULL DEFAULT 0;
+ALTER TABLE comments ADD COLUMN edited_at DATETIME NULL;
+
+CREATE TABLE comment_revisions (
+    comment_id INT NOT NULL,
+    revision INT NOT NULL,
+    content TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (comment_id, revision),
+    FOREIGN KEY (comment_id) REFERENCES comments(id) ON DELETE CASCADE
+) CHARACTER SET utf8mb4;
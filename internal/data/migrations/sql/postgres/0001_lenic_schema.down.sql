@@ -0,0 +1,17 @@
+DROP TABLE sessions;
+DROP TABLE tokens;
+DROP TABLE reference_tags;
+DROP TABLE user_tags;
+DROP TABLE tags;
+DROP TABLE notifications;
+DROP TABLE dmessages;
+DROP TABLE conversations;
+DROP TABLE comment_ratings;
+DROP TABLE comments;
+DROP TABLE post_ratings;
+DROP TABLE posts;
+DROP TABLE follows;
+DROP TABLE users;
+DROP FUNCTION follows_count;
+DROP FUNCTION comment_ratings_sum;
+DROP FUNCTION post_ratings_sum;
@@ -0,0 +1,21 @@
+ALTER TABLE users ADD COLUMN is_private BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- 1 public, 2 unlisted, 3 followers, 4 close friends, 5 specific users
+ALTER TABLE posts ADD COLUMN visibility SMALLINT NOT NULL DEFAULT 3;
+UPDATE posts SET visibility = CASE WHEN is_public THEN 1 ELSE 3 END;
+
+CREATE TABLE close_friends (
+    user_id INT NOT NULL,
+    friend_id INT NOT NULL,
+    PRIMARY KEY (user_id, friend_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (friend_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE post_audience (
+    post_id INT NOT NULL,
+    user_id INT NOT NULL,
+    PRIMARY KEY (post_id, user_id),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
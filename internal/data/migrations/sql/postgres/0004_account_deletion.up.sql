@@ -0,0 +1,5 @@
+-- A deleted account keeps its row until it's purged, its posts and
+-- comments have active = 2 meanwhile so they come back on restore
+
+ALTER TABLE users ADD COLUMN deleted_at TIMESTAMP NULL;
+CREATE INDEX users_deleted_at ON users (deleted_at);
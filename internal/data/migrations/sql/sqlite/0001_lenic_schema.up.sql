@@ -0,0 +1,221 @@
+-- The schema created by the lenic web app, which the API started out on
+
+CREATE TABLE users (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username VARCHAR(50) NOT NULL UNIQUE,
+    email VARCHAR(255) NOT NULL UNIQUE,
+    hashpass VARCHAR(255) NOT NULL,
+    profile_pic VARCHAR(255) NOT NULL DEFAULT '',
+    profile_pic_ext VARCHAR(10) NOT NULL DEFAULT '',
+    followers INT NOT NULL DEFAULT 0,
+    following INT NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    active TINYINT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE follows (
+    follower_id INT NOT NULL,
+    followed_id INT NOT NULL,
+    follow_status TINYINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (follower_id, followed_id),
+    FOREIGN KEY (follower_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (followed_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE posts (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    post_guid VARCHAR(64) NOT NULL UNIQUE,
+    author_id INT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    content TEXT NOT NULL,
+    post_image VARCHAR(255) NOT NULL DEFAULT '',
+    image_ext VARCHAR(10) NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    is_public BOOLEAN NOT NULL DEFAULT TRUE,
+    rating INT NOT NULL DEFAULT 0,
+    active TINYINT NOT NULL DEFAULT 1,
+    FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE post_ratings (
+    post_id INT NOT NULL,
+    user_id INT NOT NULL,
+    rating_value TINYINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (post_id, user_id),
+    FOREIGN KEY (post_id) REFERENCES posts(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE comments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    post_guid VARCHAR(64) NOT NULL,
+    author_id INT NOT NULL,
+    content TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    rating INT NOT NULL DEFAULT 0,
+    active TINYINT NOT NULL DEFAULT 1,
+    FOREIGN KEY (post_guid) REFERENCES posts(post_guid) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE comment_ratings (
+    comment_id INT NOT NULL,
+    user_id INT NOT NULL,
+    rating_value TINYINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (comment_id, user_id),
+    FOREIGN KEY (comment_id) REFERENCES comments(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE conversations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user1_id INT NOT NULL,
+    user2_id INT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user1_id, user2_id),
+    FOREIGN KEY (user1_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (user2_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE dmessages (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    conversation_id INT NOT NULL,
+    sender_id INT NOT NULL,
+    content TEXT NOT NULL,
+    is_read BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (conversation_id) REFERENCES conversations(id) ON DELETE CASCADE,
+    FOREIGN KEY (sender_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE notifications (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INT NOT NULL,
+    from_user_id INT NOT NULL,
+    notif_type VARCHAR(50) NOT NULL,
+    notif_message VARCHAR(255) NOT NULL,
+    resource_id VARCHAR(64) NOT NULL DEFAULT '',
+    parent_id VARCHAR(64) NOT NULL DEFAULT '',
+    is_read BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (from_user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE tags (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tag_name VARCHAR(255) NOT NULL UNIQUE,
+    tag_type VARCHAR(10) NOT NULL
+);
+
+CREATE TABLE user_tags (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tag_id INT NOT NULL,
+    post_id INT NOT NULL DEFAULT 0,
+    comment_id INT NOT NULL DEFAULT 0,
+    tag_place VARCHAR(10) NOT NULL,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE TABLE reference_tags (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    tag_id INT NOT NULL,
+    post_id INT NOT NULL DEFAULT 0,
+    comment_id INT NOT NULL DEFAULT 0,
+    tag_place VARCHAR(10) NOT NULL,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE TABLE tokens (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    token VARCHAR(255) NOT NULL,
+    user_id INT NOT NULL UNIQUE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE sessions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    session_id VARCHAR(255) NOT NULL UNIQUE,
+    user_id INT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    active TINYINT NOT NULL DEFAULT 1,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- posts.rating and comments.rating are the sum of their votes
+
+-- +statement begin
+CREATE TRIGGER post_ratings_after_insert AFTER INSERT ON post_ratings FOR EACH ROW
+BEGIN
+    UPDATE posts SET rating = rating + NEW.rating_value WHERE id = NEW.post_id;
+END;
+-- +statement end
+
+-- +statement begin
+CREATE TRIGGER post_ratings_after_update AFTER UPDATE ON post_ratings FOR EACH ROW
+BEGIN
+    UPDATE posts SET rating = rating - OLD.rating_value + NEW.rating_value WHERE id = NEW.post_id;
+END;
+-- +statement end
+
+-- +statement begin
+CREATE TRIGGER post_ratings_after_delete AFTER DELETE ON post_ratings FOR EACH ROW
+BEGIN
+    UPDATE posts SET rating = rating - OLD.rating_value WHERE id = OLD.post_id;
+END;
+-- +statement end
+
+-- +statement begin
+CREATE TRIGGER comment_ratings_after_insert AFTER INSERT ON comment_ratings FOR EACH ROW
+BEGIN
+    UPDATE comments SET rating = rating + NEW.rating_value WHERE id = NEW.comment_id;
+END;
+-- +statement end
+
+-- +statement begin
+CREATE TRIGGER comment_ratings_after_update AFTER UPDATE ON comment_ratings FOR EACH ROW
+BEGIN
+    UPDATE comments SET rating = rating - OLD.rating_value + NEW.rating_value WHERE id = NEW.comment_id;
+END;
+-- +statement end
+
+-- +statement begin
+CREATE TRIGGER comment_ratings_after_delete AFTER DELETE ON comment_ratings FOR EACH ROW
+BEGIN
+    UPDATE comments SET rating = rating - OLD.rating_value WHERE id = OLD.comment_id;
+END;
+-- +statement end
+
+-- users.followers and users.following count accepted follows only
+
+-- +statement begin
+CREATE TRIGGER follows_after_insert AFTER INSERT ON follows FOR EACH ROW
+BEGIN
+    UPDATE users SET followers = followers + 1 WHERE id = NEW.followed_id AND NEW.follow_status = 1;
+    UPDATE users SET following = following + 1 WHERE id = NEW.follower_id AND NEW.follow_status = 1;
+END;
+-- +statement end
+
+-- +statement begin
+CREATE TRIGGER follows_after_update AFTER UPDATE ON follows FOR EACH ROW
+BEGIN
+    UPDATE users SET followers = followers + (NEW.follow_status = 1) - (OLD.follow_status = 1) WHERE id = NEW.followed_id;
+    UPDATE users SET following = following + (NEW.follow_status = 1) - (OLD.follow_status = 1) WHERE id = NEW.follower_id;
+END;
+-- +statement end
+
+-- +statement begin
+CREATE TRIGGER follows_after_delete AFTER DELETE ON follows FOR EACH ROW
+BEGIN
+    UPDATE users SET followers = followers - 1 WHERE id = OLD.followed_id AND OLD.follow_status = 1;
+    UPDATE users SET following = following - 1 WHERE id = OLD.follower_id AND OLD.follow_status = 1;
+END;
+-- +statement end
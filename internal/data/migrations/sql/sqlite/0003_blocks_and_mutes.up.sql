@@ -0,0 +1,15 @@
+CREATE TABLE user_blocks (
+    blocker_id INT NOT NULL,
+    blocked_id INT NOT NULL,
+    PRIMARY KEY (blocker_id, blocked_id),
+    FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE user_mutes (
+    muter_id INT NOT NULL,
+    muted_id INT NOT NULL,
+    PRIMARY KEY (muter_id, muted_id),
+    FOREIGN KEY (muter_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (muted_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,23 @@
+package model
+
+type Block struct {
+	BlockerId int
+	BlockedId int
+}
+
+type Mute struct {
+	MuterId int
+	MutedId int
+}
+
+// Relation is how a user stands with another one
+type Relation struct {
+	OtherId int
+	// Out is the follow of the user to the other one and In the one back,
+	// nil when there's none
+	Out       *Follows
+	In        *Follows
+	Blocking  bool
+	BlockedBy bool
+	Muting    bool
+}
@@ -11,6 +11,26 @@ type Comment struct {
 	UpdatedAt time.Time
 	Rating    int
 	Active    int
+	EditCount int
+	// EditedAt is zero until the comment is edited
+	EditedAt time.Time
+}
+
+// VersionTime is when the current version of c was written
+func (c Comment) VersionTime() time.Time {
+	if c.EditedAt.IsZero() {
+		return c.CreatedAt
+	}
+	return c.EditedAt
+}
+
+// CommentRevision is a version of a comment an edit replaced, Revision 1
+// is the original and CreatedAt is when the version was written
+type CommentRevision struct {
+	CommentId int
+	Revision  int
+	Content   string
+	CreatedAt time.Time
 }
 
 type CommentRatings struct {
@@ -2,19 +2,69 @@ package model
 
 import "time"
 
+// PostVisibility matches the values of the pb.Visibility enum
+type PostVisibility int
+
+const (
+	VisibilityPublic        PostVisibility = 1
+	VisibilityUnlisted      PostVisibility = 2
+	VisibilityFollowers     PostVisibility = 3
+	VisibilityCloseFriends  PostVisibility = 4
+	VisibilitySpecificUsers PostVisibility = 5
+)
+
+// Post.Active and Comment.Active values
+const (
+	ContentDisabled = 0
+	ContentActive   = 1
+	// ContentAuthorDeleted hides the content of a deleted account until
+	// it's restored or purged
+	ContentAuthorDeleted = 2
+)
+
 type Post struct {
-	Id        int
-	GUID      string
-	AuthorId  int
-	Title     string
-	Content   string
-	Image     string
-	ImageExt  string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	IsPublic  bool
-	Rating    int
-	Active    int
+	Id         int
+	GUID       string
+	AuthorId   int
+	Title      string
+	Content    string
+	Image      string
+	ImageExt   string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Rating     int
+	Active     int
+	Visibility PostVisibility
+	EditCount  int
+	// EditedAt is zero until the post is edited
+	EditedAt time.Time
+}
+
+// VersionTime is when the current version of p was written
+func (p Post) VersionTime() time.Time {
+	if p.EditedAt.IsZero() {
+		return p.CreatedAt
+	}
+	return p.EditedAt
+}
+
+// PostRevision is a version of a post an edit replaced, Revision 1 is the
+// original and CreatedAt is when the version was written
+type PostRevision struct {
+	PostId     int
+	Revision   int
+	Title      string
+	Content    string
+	Visibility PostVisibility
+	CreatedAt  time.Time
+}
+
+// Votes counts the up and down votes on a post or comment, Mine is the
+// vote of the user asking for them: 1, -1 or 0
+type Votes struct {
+	Up   int
+	Down int
+	Mine int
 }
 
 type PostRatings struct {
@@ -22,3 +72,8 @@ type PostRatings struct {
 	UserId      int
 	RatingValue int
 }
+
+type CloseFriend struct {
+	UserId   int
+	FriendId int
+}
@@ -16,10 +16,27 @@ type User struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	Active        int
+	IsPrivate     bool
+	// DeletedAt is set while a deleted account waits to be purged
+	DeletedAt time.Time
 }
 
+// Follows.Status values, see orm/follow_orm.go for the transitions
+const (
+	FollowPending  = 0
+	FollowAccepted = 1
+)
+
 type Follows struct {
 	FollowerId int
 	FollowedId int
 	Status     int
 }
+
+// FollowSuggestion is a user followed by MutualCount of the people
+// someone follows
+type FollowSuggestion struct {
+	UserId      int
+	MutualCount int
+	LastPostAt  time.Time
+}
@@ -1,11 +1,182 @@
 package orm
 
-import "database/sql"
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
 
-var (
-	Da DataAccess
+	"github.com/Anacardo89/lenic_api/internal/data/query"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"github.com/Anacardo89/lenic_api/pkg/db"
 )
 
+// DataAccess implements every repository on top of a SQL database, the
+// differences between databases are handled by Dialect
 type DataAccess struct {
-	Db *sql.DB
+	Db      *sql.DB
+	Dialect db.Dialect
+	// Timeout bounds every query on top of the caller's deadline,
+	// zero means only the caller's deadline applies
+	Timeout time.Duration
+
+	// rebound caches the queries rewritten for Dialect
+	rebound *sync.Map
+	// tx is set on the DataAccess handed out by InTx
+	tx *sql.Tx
+	// replicas serve reads outside transactions when set by UseReplicas
+	replicas *replicaSet
+}
+
+// querier is what *sql.DB and *sql.Tx have in common
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func New(database *sql.DB, dialect db.Dialect, timeout time.Duration) *DataAccess {
+	return &DataAccess{Db: database, Dialect: dialect, Timeout: timeout, rebound: &sync.Map{}}
+}
+
+// Repositories returns da as every repository
+func (da *DataAccess) Repositories() repo.Repositories {
+	return repo.Repositories{
+		Tx:            da,
+		Users:         da,
+		Follows:       da,
+		Posts:         da,
+		Comments:      da,
+		Ratings:       da,
+		Conversations: da,
+		Notifications: da,
+		Tags:          da,
+		Tokens:        da,
+		Sessions:      da,
+	}
+}
+
+func (da *DataAccess) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if da.Timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, da.Timeout)
+}
+
+// rebind returns q with its placeholders in the syntax of the dialect
+func (da *DataAccess) rebind(q string) string {
+	if r, ok := da.rebound.Load(q); ok {
+		return r.(string)
+	}
+	r := da.Dialect.Rebind(q)
+	da.rebound.Store(q, r)
+	return r
+}
+
+// conn is the transaction da is bound to, or the pool
+func (da *DataAccess) conn() querier {
+	if da.tx != nil {
+		return da.tx
+	}
+	return da.Db
+}
+
+// query runs a read, on a replica when da has them, or on the primary if
+// the replica can't be reached
+func (da *DataAccess) query(ctx context.Context, q string, args ...interface{}) (*sql.Rows, error) {
+	conn, r := da.reader(ctx)
+	rows, err := conn.QueryContext(ctx, da.rebind(q), args...)
+	if r.failed(err) {
+		rows, err = da.Db.QueryContext(ctx, da.rebind(q), args...)
+	}
+	return rows, da.ctxError(ctx, err)
+}
+
+// row is a *sql.Row whose Scan reports cancelled queries like ctxError
+type row struct {
+	*sql.Row
+	ctx context.Context
+	da  *DataAccess
+}
+
+func (r row) Scan(dest ...interface{}) error {
+	return r.da.ctxError(r.ctx, r.Row.Scan(dest...))
+}
+
+// queryRow is query for a single row
+func (da *DataAccess) queryRow(ctx context.Context, q string, args ...interface{}) row {
+	conn, r := da.reader(ctx)
+	sqlRow := conn.QueryRowContext(ctx, da.rebind(q), args...)
+	if r.failed(sqlRow.Err()) {
+		sqlRow = da.Db.QueryRowContext(ctx, da.rebind(q), args...)
+	}
+	return row{Row: sqlRow, ctx: ctx, da: da}
+}
+
+// exec runs a write on the primary
+func (da *DataAccess) exec(ctx context.Context, q string, args ...interface{}) (sql.Result, error) {
+	defer da.wrote(ctx)
+	res, err := da.conn().ExecContext(ctx, da.rebind(q), args...)
+	return res, da.writeError(da.ctxError(ctx, err))
+}
+
+// ctxError marks err with the error of ctx if the database cancelled the
+// query because ctx ended, or with context.DeadlineExceeded if it timed
+// out on its own, so they're told apart from failed queries
+func (da *DataAccess) ctxError(ctx context.Context, err error) error {
+	if err == nil || !da.Dialect.Canceled(err) {
+		return err
+	}
+	if ctx.Err() != nil {
+		return fmt.Errorf("%w: %w", ctx.Err(), err)
+	}
+	return fmt.Errorf("%w: %w", context.DeadlineExceeded, err)
+}
+
+// writeError marks err with repo.ErrDuplicate if it broke a unique key,
+// keeping the driver error for Retryable
+func (da *DataAccess) writeError(err error) error {
+	if err != nil && da.Dialect.Duplicate(err) {
+		return fmt.Errorf("%w: %w", repo.ErrDuplicate, err)
+	}
+	return err
+}
+
+// insert runs an INSERT and returns the id of the new row
+func (da *DataAccess) insert(ctx context.Context, q string, args ...interface{}) (int, error) {
+	if da.Dialect.ReturningId() {
+		var id int
+		q = strings.TrimRight(q, "; \n\t") + " RETURNING id"
+		defer da.wrote(ctx)
+		err := da.conn().QueryRowContext(ctx, da.rebind(q), args...).Scan(&id)
+		return id, da.writeError(da.ctxError(ctx, err))
+	}
+	res, err := da.exec(ctx, q, args...)
+	if err != nil {
+		return 0, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(id), nil
+}
+
+// forUpdate returns q, a SELECT, locking the rows it reads until the
+// transaction ends
+func (da *DataAccess) forUpdate(q string) string {
+	if !da.Dialect.ForUpdate() {
+		return q
+	}
+	return strings.TrimRight(q, "; \n\t") + " FOR UPDATE"
+}
+
+// upsert picks the spelling of u for the dialect
+func (da *DataAccess) upsert(u query.Upsert) string {
+	if da.Dialect.OnConflict() {
+		return u.OnConflict
+	}
+	return u.OnDuplicateKey
 }
@@ -0,0 +1,180 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/query"
+)
+
+// BlockUser also drops any follow or follow request between the two users
+func (da *DataAccess) BlockUser(ctx context.Context, blocker_id int, blocked_id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.inTx(ctx, func(tx *DataAccess) error {
+		_, err := tx.exec(ctx, query.InsertBlock, blocker_id, blocked_id)
+		if err != nil {
+			return err
+		}
+		_, err = tx.exec(ctx, query.DeleteFollowsBetween, blocker_id, blocked_id, blocked_id, blocker_id)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func (da *DataAccess) UnblockUser(ctx context.Context, blocker_id int, blocked_id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.DeleteBlock, blocker_id, blocked_id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (da *DataAccess) IsBlocked(ctx context.Context, blocker_id int, blocked_id int) (bool, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	b := model.Block{}
+	row := da.queryRow(ctx, query.SelectBlock, blocker_id, blocked_id)
+	err := row.Scan(
+		&b.BlockerId,
+		&b.BlockedId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (da *DataAccess) MuteUser(ctx context.Context, muter_id int, muted_id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.InsertMute, muter_id, muted_id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (da *DataAccess) UnmuteUser(ctx context.Context, muter_id int, muted_id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.DeleteMute, muter_id, muted_id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (da *DataAccess) IsMuted(ctx context.Context, muter_id int, muted_id int) (bool, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	m := model.Mute{}
+	row := da.queryRow(ctx, query.SelectMute, muter_id, muted_id)
+	err := row.Scan(
+		&m.MuterId,
+		&m.MutedId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (da *DataAccess) GetRelations(ctx context.Context, user_id int, other_ids []int) (map[int]model.Relation, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	relations := map[int]model.Relation{}
+	if len(other_ids) == 0 {
+		return relations, nil
+	}
+	for _, id := range other_ids {
+		relations[id] = model.Relation{OtherId: id}
+	}
+	in := query.Placeholders(len(other_ids))
+	args := []interface{}{user_id}
+	for _, id := range other_ids {
+		args = append(args, id)
+	}
+	both := append(append([]interface{}{}, args...), args...)
+
+	err := da.scanPairs(ctx, fmt.Sprintf(query.SelectFollowsBetween, in), both, func(rows *sql.Rows) error {
+		f := model.Follows{}
+		if err := rows.Scan(&f.FollowerId, &f.FollowedId, &f.Status); err != nil {
+			return err
+		}
+		if f.FollowerId == user_id {
+			r := relations[f.FollowedId]
+			r.Out = &f
+			relations[f.FollowedId] = r
+		} else {
+			r := relations[f.FollowerId]
+			r.In = &f
+			relations[f.FollowerId] = r
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = da.scanPairs(ctx, fmt.Sprintf(query.SelectBlocksBetween, in), both, func(rows *sql.Rows) error {
+		b := model.Block{}
+		if err := rows.Scan(&b.BlockerId, &b.BlockedId); err != nil {
+			return err
+		}
+		if b.BlockerId == user_id {
+			r := relations[b.BlockedId]
+			r.Blocking = true
+			relations[b.BlockedId] = r
+		} else {
+			r := relations[b.BlockerId]
+			r.BlockedBy = true
+			relations[b.BlockerId] = r
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = da.scanPairs(ctx, fmt.Sprintf(query.SelectMutesOf, in), args, func(rows *sql.Rows) error {
+		m := model.Mute{}
+		if err := rows.Scan(&m.MuterId, &m.MutedId); err != nil {
+			return err
+		}
+		r := relations[m.MutedId]
+		r.Muting = true
+		relations[m.MutedId] = r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return relations, nil
+}
+
+// scanPairs runs q and calls scan on each row
+func (da *DataAccess) scanPairs(ctx context.Context, q string, args []interface{}, scan func(rows *sql.Rows) error) error {
+	rows, err := da.query(ctx, q, args...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
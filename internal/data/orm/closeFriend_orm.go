@@ -0,0 +1,52 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/query"
+)
+
+func (da *DataAccess) AddCloseFriend(ctx context.Context, user_id int, friend_id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.InsertCloseFriend, user_id, friend_id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (da *DataAccess) RemoveCloseFriend(ctx context.Context, user_id int, friend_id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.DeleteCloseFriend, user_id, friend_id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (da *DataAccess) IsCloseFriend(ctx context.Context, user_id int, friend_id int) (bool, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	cf := model.CloseFriend{}
+	row := da.queryRow(ctx, query.SelectCloseFriend, user_id, friend_id)
+	err := row.Scan(
+		&cf.UserId,
+		&cf.FriendId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (da *DataAccess) GetCloseFriends(ctx context.Context, user_id int) (*[]model.User, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.getUsers(ctx, query.SelectCloseFriends, user_id)
+}
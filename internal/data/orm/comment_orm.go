@@ -1,31 +1,43 @@
 package orm
 
 import (
+	"context"
 	"database/sql"
-	"time"
+	"errors"
+	"fmt"
 
 	"github.com/Anacardo89/lenic_api/internal/data/model"
 	"github.com/Anacardo89/lenic_api/internal/data/query"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
 	"github.com/Anacardo89/lenic_api/pkg/db"
 )
 
-func (da *DataAccess) CreateComment(c *model.Comment) (sql.Result, error) {
-	result, err := da.Db.Exec(query.InsertComment,
+func (da *DataAccess) CreateComment(ctx context.Context, c *model.Comment) (int, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.insert(ctx, query.InsertComment,
 		c.PostGUID,
 		c.AuthorId,
 		c.Content,
 		c.Rating,
 		c.Active)
-	return result, err
 }
 
-func (da *DataAccess) GetCommentById(id int) (*model.Comment, error) {
+func (da *DataAccess) GetCommentById(ctx context.Context, id int) (*model.Comment, error) {
+	return da.getComment(ctx, query.SelectCommentById, id)
+}
+
+// getComment reads the comment q, a select of commentColumns, finds with id
+func (da *DataAccess) getComment(ctx context.Context, q string, id int) (*model.Comment, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	var (
-		createdAt []byte
-		updatedAt []byte
+		createdAt interface{}
+		updatedAt interface{}
+		editedAt  interface{}
 	)
 	c := model.Comment{}
-	row := da.Db.QueryRow(query.SelectCommentById, id)
+	row := da.queryRow(ctx, q, id)
 	err := row.Scan(
 		&c.Id,
 		&c.PostGUID,
@@ -34,15 +46,21 @@ func (da *DataAccess) GetCommentById(id int) (*model.Comment, error) {
 		&createdAt,
 		&updatedAt,
 		&c.Rating,
-		&c.Active)
+		&c.Active,
+		&c.EditCount,
+		&editedAt)
+	if err != nil {
+		return nil, err
+	}
+	c.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
-	c.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	c.UpdatedAt, err = db.ParseTime(updatedAt)
 	if err != nil {
 		return nil, err
 	}
-	c.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+	c.EditedAt, err = db.ParseTime(editedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -50,9 +68,23 @@ func (da *DataAccess) GetCommentById(id int) (*model.Comment, error) {
 	return &c, nil
 }
 
-func (da *DataAccess) GetCommentsByPost(guid string) (*[]model.Comment, error) {
+func (da *DataAccess) GetCommentsByPost(ctx context.Context, guid string, page repo.Page) (*[]model.Comment, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	after, key, _ := da.keyset(page)
+	return da.getComments(ctx, query.SelectActiveCommentsByPost, guid, after, key.Id, page.Limit())
+}
+
+func (da *DataAccess) GetUserThreadComments(ctx context.Context, user_id int) (*[]model.Comment, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.getComments(ctx, query.SelectUserThreadComments, user_id, user_id)
+}
+
+// getComments runs q and scans the comment rows it returns
+func (da *DataAccess) getComments(ctx context.Context, q string, args ...interface{}) (*[]model.Comment, error) {
 	comments := []model.Comment{}
-	rows, err := da.Db.Query(query.SelectActiveCommentsByPost, guid)
+	rows, err := da.query(ctx, q, args...)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return &comments, nil
@@ -62,8 +94,9 @@ func (da *DataAccess) GetCommentsByPost(guid string) (*[]model.Comment, error) {
 	defer rows.Close()
 	for rows.Next() {
 		var (
-			createdAt []byte
-			updatedAt []byte
+			createdAt interface{}
+			updatedAt interface{}
+			editedAt  interface{}
 		)
 		c := model.Comment{}
 		err = rows.Scan(
@@ -74,51 +107,146 @@ func (da *DataAccess) GetCommentsByPost(guid string) (*[]model.Comment, error) {
 			&createdAt,
 			&updatedAt,
 			&c.Rating,
-			&c.Active)
+			&c.Active,
+			&c.EditCount,
+			&editedAt)
+		if err != nil {
+			return nil, err
+		}
+		c.CreatedAt, err = db.ParseTime(createdAt)
 		if err != nil {
 			return nil, err
 		}
-		c.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+		c.UpdatedAt, err = db.ParseTime(updatedAt)
 		if err != nil {
 			return nil, err
 		}
-		c.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+		c.EditedAt, err = db.ParseTime(editedAt)
 		if err != nil {
 			return nil, err
 		}
 		comments = append(comments, c)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 	return &comments, nil
 }
 
-func (da *DataAccess) UpdateCommentText(id int, text string) error {
-	_, err := da.Db.Exec(query.UpdateCommentText, text, id)
-	if err != nil {
+// UpdateCommentText keeps the version it replaces as a revision, an
+// update that changes nothing is skipped
+func (da *DataAccess) UpdateCommentText(ctx context.Context, id int, text string) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.inTx(ctx, func(tx *DataAccess) error {
+		current, err := tx.getComment(ctx, tx.forUpdate(query.SelectCommentById), id)
+		if err != nil {
+			return err
+		}
+		if current.Content == text {
+			return nil
+		}
+		_, err = tx.exec(ctx, query.InsertCommentRevision,
+			current.Id,
+			current.EditCount+1,
+			current.Content,
+			current.VersionTime().UTC().Format(db.DateLayout))
+		if errors.Is(err, repo.ErrDuplicate) {
+			return fmt.Errorf("%w: %w", errLostRace, err)
+		}
+		if err != nil {
+			return err
+		}
+		_, err = tx.exec(ctx, query.UpdateCommentText, text, id)
 		return err
+	})
+}
+
+func (da *DataAccess) GetCommentRevisions(ctx context.Context, comment_id int) (*[]model.CommentRevision, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	revisions := []model.CommentRevision{}
+	rows, err := da.query(ctx, query.SelectCommentRevisions, comment_id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &revisions, nil
+		}
+		return nil, err
 	}
-	return nil
+	defer rows.Close()
+	for rows.Next() {
+		var createdAt interface{}
+		r := model.CommentRevision{}
+		err = rows.Scan(
+			&r.CommentId,
+			&r.Revision,
+			&r.Content,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		r.CreatedAt, err = db.ParseTime(createdAt)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &revisions, nil
 }
 
-func (da *DataAccess) DisableComment(id int) error {
-	_, err := da.Db.Exec(query.SetCommentAsInactive, id)
+func (da *DataAccess) DisableComment(ctx context.Context, id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.SetCommentAsInactive, id)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (da *DataAccess) RateCommentUp(comment_id int, user_id int) error {
-	_, err := da.Db.Exec(query.RateCommentUp, comment_id, user_id)
+func (da *DataAccess) RateCommentUp(ctx context.Context, comment_id int, user_id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, da.upsert(query.RateCommentUp), comment_id, user_id)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (da *DataAccess) RateCommentDown(comment_id int, user_id int) error {
-	_, err := da.Db.Exec(query.RateCommentDown, comment_id, user_id)
+func (da *DataAccess) RateCommentDown(ctx context.Context, comment_id int, user_id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, da.upsert(query.RateCommentDown), comment_id, user_id)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// SetCommentRating locks the comment like SetPostRating locks the post
+func (da *DataAccess) SetCommentRating(ctx context.Context, comment_id int, user_id int, value int) (int, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	previous := 0
+	err := da.inTx(ctx, func(tx *DataAccess) error {
+		previous = 0
+		_, err := tx.getComment(ctx, tx.forUpdate(query.SelectCommentById), comment_id)
+		if err != nil {
+			return err
+		}
+		cr, err := tx.GetCommentUserRating(ctx, comment_id, user_id)
+		if err == nil {
+			previous = cr.RatingValue
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+		_, err = tx.exec(ctx, tx.upsert(query.SetCommentRating), comment_id, user_id, value)
+		return err
+	})
+	return previous, err
+}
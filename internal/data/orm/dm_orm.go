@@ -1,38 +1,43 @@
 package orm
 
 import (
+	"context"
 	"database/sql"
-	"time"
 
 	"github.com/Anacardo89/lenic_api/internal/data/model"
 	"github.com/Anacardo89/lenic_api/internal/data/query"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
 	"github.com/Anacardo89/lenic_api/pkg/db"
 )
 
-func (da *DataAccess) CreateConversation(c *model.Conversation) (sql.Result, error) {
-	result, err := da.Db.Exec(query.InsertConversation,
+func (da *DataAccess) CreateConversation(ctx context.Context, c *model.Conversation) (int, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.insert(ctx, query.InsertConversation,
 		c.User1Id,
 		c.User2Id,
 	)
-	return result, err
 }
 
-func (da *DataAccess) CreateDMessage(d *model.DMessage) (sql.Result, error) {
-	result, err := da.Db.Exec(query.InsertDMessage,
+func (da *DataAccess) CreateDMessage(ctx context.Context, d *model.DMessage) (int, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.insert(ctx, query.InsertDMessage,
 		d.ConversationId,
 		d.SenderId,
 		d.Content,
 	)
-	return result, err
 }
 
-func (da *DataAccess) GetConversationById(id int) (*model.Conversation, error) {
+func (da *DataAccess) GetConversationById(ctx context.Context, id int) (*model.Conversation, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	var (
-		createdAt []byte
-		updatedAt []byte
+		createdAt interface{}
+		updatedAt interface{}
 	)
 	c := model.Conversation{}
-	row := da.Db.QueryRow(query.SelectConversationById, id)
+	row := da.queryRow(ctx, query.SelectConversationById, id)
 	err := row.Scan(
 		&c.Id,
 		&c.User1Id,
@@ -43,11 +48,11 @@ func (da *DataAccess) GetConversationById(id int) (*model.Conversation, error) {
 	if err != nil {
 		return nil, err
 	}
-	c.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	c.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
-	c.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+	c.UpdatedAt, err = db.ParseTime(updatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +60,9 @@ func (da *DataAccess) GetConversationById(id int) (*model.Conversation, error) {
 	return &c, nil
 }
 
-func (da *DataAccess) GetConversationByUserIds(user1_id int, user2_id int) (*model.Conversation, error) {
+func (da *DataAccess) GetConversationByUserIds(ctx context.Context, user1_id int, user2_id int) (*model.Conversation, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	min := user1_id
 	max := user2_id
 	if user1_id > user2_id {
@@ -63,11 +70,11 @@ func (da *DataAccess) GetConversationByUserIds(user1_id int, user2_id int) (*mod
 		max = user1_id
 	}
 	var (
-		createdAt []byte
-		updatedAt []byte
+		createdAt interface{}
+		updatedAt interface{}
 	)
 	c := model.Conversation{}
-	row := da.Db.QueryRow(query.SelectConversationByUserIds, min, max)
+	row := da.queryRow(ctx, query.SelectConversationByUserIds, min, max)
 	err := row.Scan(
 		&c.Id,
 		&c.User1Id,
@@ -78,11 +85,11 @@ func (da *DataAccess) GetConversationByUserIds(user1_id int, user2_id int) (*mod
 	if err != nil {
 		return nil, err
 	}
-	c.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	c.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
-	c.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+	c.UpdatedAt, err = db.ParseTime(updatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -90,9 +97,12 @@ func (da *DataAccess) GetConversationByUserIds(user1_id int, user2_id int) (*mod
 	return &c, nil
 }
 
-func (da *DataAccess) GetConversationsByUserId(user_id int) ([]*model.Conversation, error) {
+func (da *DataAccess) GetConversationsByUserId(ctx context.Context, user_id int, page repo.Page) ([]*model.Conversation, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	conversations := []*model.Conversation{}
-	rows, err := da.Db.Query(query.SelectConversationsByUserId, user_id, user_id)
+	after, key, t := da.keyset(page)
+	rows, err := da.query(ctx, query.SelectConversationsByUserId, user_id, user_id, after, t, t, key.Id, page.Limit())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return conversations, nil
@@ -102,8 +112,8 @@ func (da *DataAccess) GetConversationsByUserId(user_id int) ([]*model.Conversati
 	defer rows.Close()
 	for rows.Next() {
 		var (
-			createdAt []byte
-			updatedAt []byte
+			createdAt interface{}
+			updatedAt interface{}
 		)
 		c := model.Conversation{}
 		err = rows.Scan(
@@ -116,23 +126,28 @@ func (da *DataAccess) GetConversationsByUserId(user_id int) ([]*model.Conversati
 		if err != nil {
 			return nil, err
 		}
-		c.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+		c.CreatedAt, err = db.ParseTime(createdAt)
 		if err != nil {
 			return nil, err
 		}
-		c.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+		c.UpdatedAt, err = db.ParseTime(updatedAt)
 		if err != nil {
 			return nil, err
 		}
 		conversations = append(conversations, &c)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 	return conversations, nil
 }
 
-func (da *DataAccess) GetDMById(id int) (*model.DMessage, error) {
-	var createdAt []byte
+func (da *DataAccess) GetDMById(ctx context.Context, id int) (*model.DMessage, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	var createdAt interface{}
 	m := model.DMessage{}
-	row := da.Db.QueryRow(query.SelectDMById, id)
+	row := da.queryRow(ctx, query.SelectDMById, id)
 	err := row.Scan(
 		&m.Id,
 		&m.ConversationId,
@@ -144,7 +159,7 @@ func (da *DataAccess) GetDMById(id int) (*model.DMessage, error) {
 	if err != nil {
 		return nil, err
 	}
-	m.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	m.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
@@ -152,10 +167,12 @@ func (da *DataAccess) GetDMById(id int) (*model.DMessage, error) {
 	return &m, nil
 }
 
-func (da *DataAccess) GetLastDMBySenderInConversation(converrsation_id int, sender_id int) (*model.DMessage, error) {
-	var createdAt []byte
+func (da *DataAccess) GetLastDMBySenderInConversation(ctx context.Context, conversation_id int, sender_id int) (*model.DMessage, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	var createdAt interface{}
 	m := model.DMessage{}
-	row := da.Db.QueryRow(query.SelectLastDMBySenderInConversation, converrsation_id, sender_id)
+	row := da.queryRow(ctx, query.SelectLastDMBySenderInConversation, conversation_id, sender_id)
 	err := row.Scan(
 		&m.Id,
 		&m.ConversationId,
@@ -167,7 +184,7 @@ func (da *DataAccess) GetLastDMBySenderInConversation(converrsation_id int, send
 	if err != nil {
 		return nil, err
 	}
-	m.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	m.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
@@ -175,9 +192,12 @@ func (da *DataAccess) GetLastDMBySenderInConversation(converrsation_id int, send
 	return &m, nil
 }
 
-func (da *DataAccess) GetDMsByConversationId(conversation_id int) ([]*model.DMessage, error) {
+func (da *DataAccess) GetDMsByConversationId(ctx context.Context, conversation_id int, page repo.Page) ([]*model.DMessage, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	dms := []*model.DMessage{}
-	rows, err := da.Db.Query(query.SelectDMsByConversationId, conversation_id)
+	after, key, t := da.keyset(page)
+	rows, err := da.query(ctx, query.SelectDMsByConversationId, conversation_id, after, t, t, key.Id, page.Limit())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return dms, nil
@@ -186,7 +206,7 @@ func (da *DataAccess) GetDMsByConversationId(conversation_id int) ([]*model.DMes
 	}
 	defer rows.Close()
 	for rows.Next() {
-		var createdAt []byte
+		var createdAt interface{}
 		m := model.DMessage{}
 		err = rows.Scan(
 			&m.Id,
@@ -199,25 +219,32 @@ func (da *DataAccess) GetDMsByConversationId(conversation_id int) ([]*model.DMes
 		if err != nil {
 			return nil, err
 		}
-		m.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+		m.CreatedAt, err = db.ParseTime(createdAt)
 		if err != nil {
 			return nil, err
 		}
 		dms = append(dms, &m)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 	return dms, nil
 }
 
-func (da *DataAccess) UpdateConversationById(id int) error {
-	_, err := da.Db.Exec(query.UpdateConversationById, id)
+func (da *DataAccess) UpdateConversationById(ctx context.Context, id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.UpdateConversationById, id)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (da *DataAccess) UpdateDMReadById(id int) error {
-	_, err := da.Db.Exec(query.UpdateDMReadById, id)
+func (da *DataAccess) UpdateDMReadById(ctx context.Context, id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.UpdateDMReadById, id)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,234 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/query"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"github.com/Anacardo89/lenic_api/pkg/db"
+)
+
+// Follow transitions are documented on repo.Follows, they're enforced here
+// by only updating or deleting rows in the expected follow_status.
+
+func (da *DataAccess) GetUserFollows(ctx context.Context, follower_id int, followed_id int) (*model.Follows, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	f := model.Follows{}
+	row := da.queryRow(ctx, query.SelectUserFollows, follower_id, followed_id)
+	err := row.Scan(
+		&f.FollowerId,
+		&f.FollowedId,
+		&f.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func (da *DataAccess) GetFollowers(ctx context.Context, followed_id int) (*[]model.Follows, error) {
+	return da.getFollows(ctx, query.SelectUserFollowers, model.FollowAccepted, followed_id)
+}
+
+func (da *DataAccess) GetFollowing(ctx context.Context, follower_id int) (*[]model.Follows, error) {
+	return da.getFollows(ctx, query.SelectUserFollowing, model.FollowAccepted, follower_id)
+}
+
+func (da *DataAccess) GetIncomingFollowRequests(ctx context.Context, followed_id int, page repo.Page) (*[]model.Follows, error) {
+	_, key, _ := da.keyset(page)
+	return da.getFollows(ctx, query.SelectIncomingFollowRequests, model.FollowPending, followed_id, key.Id, page.Limit())
+}
+
+func (da *DataAccess) GetOutgoingFollowRequests(ctx context.Context, follower_id int, page repo.Page) (*[]model.Follows, error) {
+	_, key, _ := da.keyset(page)
+	return da.getFollows(ctx, query.SelectOutgoingFollowRequests, model.FollowPending, follower_id, key.Id, page.Limit())
+}
+
+// getFollows returns the follows q selects with args that have status
+func (da *DataAccess) getFollows(ctx context.Context, q string, status int, args ...interface{}) (*[]model.Follows, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	follows := []model.Follows{}
+	rows, err := da.query(ctx, q, args...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &follows, nil
+		}
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		f := model.Follows{}
+		err = rows.Scan(
+			&f.FollowerId,
+			&f.FollowedId,
+			&f.Status,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if f.Status == status {
+			follows = append(follows, f)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &follows, nil
+}
+
+// GetFollowerUsers returns the accepted followers of followed_id by id
+func (da *DataAccess) GetFollowerUsers(ctx context.Context, followed_id int, page repo.Page) (*[]model.User, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, key, _ := da.keyset(page)
+	return da.getUsers(ctx, query.SelectFollowerUsers, followed_id, model.FollowAccepted, key.Id, page.Limit())
+}
+
+func (da *DataAccess) GetFollowingUsers(ctx context.Context, follower_id int, page repo.Page) (*[]model.User, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, key, _ := da.keyset(page)
+	return da.getUsers(ctx, query.SelectFollowedUsers, follower_id, model.FollowAccepted, key.Id, page.Limit())
+}
+
+func (da *DataAccess) GetIncomingRequestUsers(ctx context.Context, followed_id int, page repo.Page) (*[]model.User, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, key, _ := da.keyset(page)
+	return da.getUsers(ctx, query.SelectFollowerUsers, followed_id, model.FollowPending, key.Id, page.Limit())
+}
+
+func (da *DataAccess) GetOutgoingRequestUsers(ctx context.Context, follower_id int, page repo.Page) (*[]model.User, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, key, _ := da.keyset(page)
+	return da.getUsers(ctx, query.SelectFollowedUsers, follower_id, model.FollowPending, key.Id, page.Limit())
+}
+
+// GetFollowSuggestions returns friends-of-friends of user_id, leaving out
+// anyone they already follow, requested to follow or are blocked with
+func (da *DataAccess) GetFollowSuggestions(ctx context.Context, user_id int, limit int) ([]*model.FollowSuggestion, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	suggestions := []*model.FollowSuggestion{}
+	rows, err := da.query(ctx, query.SelectFollowSuggestions, user_id, user_id, user_id, user_id, user_id, limit)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return suggestions, nil
+		}
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var lastPostAt interface{}
+		fs := model.FollowSuggestion{}
+		err = rows.Scan(
+			&fs.UserId,
+			&fs.MutualCount,
+			&lastPostAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if lastPostAt != nil {
+			fs.LastPostAt, err = db.ParseTime(lastPostAt)
+			if err != nil {
+				return nil, err
+			}
+		}
+		suggestions = append(suggestions, &fs)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
+
+func (da *DataAccess) GetMutualCounts(ctx context.Context, user_id int, ids []int) (map[int]int, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	counts := map[int]int{}
+	if len(ids) == 0 {
+		return counts, nil
+	}
+	args := []interface{}{user_id}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	rows, err := da.query(ctx, fmt.Sprintf(query.SelectMutualCounts, query.Placeholders(len(ids))), args...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return counts, nil
+		}
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, n int
+		if err = rows.Scan(&id, &n); err != nil {
+			return nil, err
+		}
+		counts[id] = n
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (da *DataAccess) FollowUser(ctx context.Context, follower_id int, followed_id int, status int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	if status != model.FollowPending && status != model.FollowAccepted {
+		return repo.ErrInvalidFollowTransition
+	}
+	_, err := da.GetUserFollows(ctx, follower_id, followed_id)
+	if err == nil {
+		return repo.ErrInvalidFollowTransition
+	}
+	if err != sql.ErrNoRows {
+		return err
+	}
+	_, err = da.exec(ctx, query.FollowUser, follower_id, followed_id, status)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (da *DataAccess) AcceptFollow(ctx context.Context, follower_id int, followed_id int) error {
+	return da.transitionFollow(ctx, query.AcceptFollow, follower_id, followed_id)
+}
+
+func (da *DataAccess) RejectFollow(ctx context.Context, follower_id int, followed_id int) error {
+	return da.transitionFollow(ctx, query.DeleteFollowRequest, follower_id, followed_id)
+}
+
+func (da *DataAccess) CancelFollowRequest(ctx context.Context, follower_id int, followed_id int) error {
+	return da.transitionFollow(ctx, query.DeleteFollowRequest, follower_id, followed_id)
+}
+
+func (da *DataAccess) UnfollowUser(ctx context.Context, follower_id int, followed_id int) error {
+	return da.transitionFollow(ctx, query.UnfollowUser, follower_id, followed_id)
+}
+
+func (da *DataAccess) transitionFollow(ctx context.Context, q string, follower_id int, followed_id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	res, err := da.exec(ctx, q, follower_id, followed_id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repo.ErrInvalidFollowTransition
+	}
+	return nil
+}
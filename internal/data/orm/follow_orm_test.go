@@ -0,0 +1,51 @@
+package orm_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+func TestFollowRequestPages(t *testing.T) {
+	ctx := context.Background()
+	da, _ := newDA(t)
+	ids := users(t, da, "ann", "bob", "cat", "dan", "eve")
+	ann := ids[0]
+	for _, id := range ids[1:] {
+		if err := da.FollowUser(ctx, id, ann, model.FollowPending); err != nil {
+			t.Fatal(err)
+		}
+		if err := da.FollowUser(ctx, ann, id, model.FollowPending); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// accepted follows aren't requests
+	if err := da.AcceptFollow(ctx, ids[2], ann); err != nil {
+		t.Fatal(err)
+	}
+
+	incoming := readPages(t, 2, func(page repo.Page) ([]model.Follows, error) {
+		fs, err := da.GetIncomingFollowRequests(ctx, ann, page)
+		if err != nil {
+			return nil, err
+		}
+		return *fs, nil
+	}, repo.FollowerKey, func() {})
+	if want := []int{ids[1], ids[3], ids[4]}; !slices.Equal(incoming, want) {
+		t.Errorf("incoming request pages read %v, want %v", incoming, want)
+	}
+
+	outgoing := readPages(t, 2, func(page repo.Page) ([]model.Follows, error) {
+		fs, err := da.GetOutgoingFollowRequests(ctx, ann, page)
+		if err != nil {
+			return nil, err
+		}
+		return *fs, nil
+	}, repo.FollowedKey, func() {})
+	if !slices.Equal(outgoing, ids[1:]) {
+		t.Errorf("outgoing request pages read %v, want %v", outgoing, ids[1:])
+	}
+}
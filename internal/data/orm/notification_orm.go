@@ -1,32 +1,35 @@
 package orm
 
 import (
+	"context"
 	"database/sql"
-	"time"
 
 	"github.com/Anacardo89/lenic_api/internal/data/model"
 	"github.com/Anacardo89/lenic_api/internal/data/query"
 	"github.com/Anacardo89/lenic_api/pkg/db"
 )
 
-func (da *DataAccess) CreateNotification(n *model.Notification) (sql.Result, error) {
-	result, err := da.Db.Exec(query.InsertNotification,
+func (da *DataAccess) CreateNotification(ctx context.Context, n *model.Notification) (int, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.insert(ctx, query.InsertNotification,
 		n.UserID,
 		n.FromUserId,
 		n.NotifType,
 		n.NotifMsg,
 		n.ResourceId,
 		n.ParentId)
-	return result, err
 }
 
-func (da *DataAccess) GetFollowNotification(user_id int, from_user_id int) (*model.Notification, error) {
+func (da *DataAccess) GetFollowNotification(ctx context.Context, user_id int, from_user_id int) (*model.Notification, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	var (
-		createdAt []byte
-		updatedAt []byte
+		createdAt interface{}
+		updatedAt interface{}
 	)
 	n := model.Notification{}
-	row := da.Db.QueryRow(query.SelectFollowNotification, user_id, from_user_id)
+	row := da.queryRow(ctx, query.SelectFollowNotification, user_id, from_user_id)
 	err := row.Scan(
 		&n.Id,
 		&n.UserID,
@@ -41,11 +44,11 @@ func (da *DataAccess) GetFollowNotification(user_id int, from_user_id int) (*mod
 	if err != nil {
 		return nil, err
 	}
-	n.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	n.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
-	n.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+	n.UpdatedAt, err = db.ParseTime(updatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -53,13 +56,15 @@ func (da *DataAccess) GetFollowNotification(user_id int, from_user_id int) (*mod
 	return &n, nil
 }
 
-func (da *DataAccess) GetNotificationById(id int) (*model.Notification, error) {
+func (da *DataAccess) GetNotificationById(ctx context.Context, id int) (*model.Notification, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	var (
-		createdAt []byte
-		updatedAt []byte
+		createdAt interface{}
+		updatedAt interface{}
 	)
 	n := model.Notification{}
-	row := da.Db.QueryRow(query.SelectNotificationById, id)
+	row := da.queryRow(ctx, query.SelectNotificationById, id)
 	err := row.Scan(
 		&n.Id,
 		&n.UserID,
@@ -74,11 +79,11 @@ func (da *DataAccess) GetNotificationById(id int) (*model.Notification, error) {
 	if err != nil {
 		return nil, err
 	}
-	n.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	n.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
-	n.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+	n.UpdatedAt, err = db.ParseTime(updatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -86,9 +91,11 @@ func (da *DataAccess) GetNotificationById(id int) (*model.Notification, error) {
 	return &n, nil
 }
 
-func (da *DataAccess) GetNotificationsByUser(user_id int, limit int, offset int) ([]*model.Notification, error) {
+func (da *DataAccess) GetNotificationsByUser(ctx context.Context, user_id int, limit int, offset int) ([]*model.Notification, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	notifs := []*model.Notification{}
-	rows, err := da.Db.Query(query.SelectNotificationsByUser, user_id, limit, offset)
+	rows, err := da.query(ctx, query.SelectNotificationsByUser, user_id, limit, offset)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return notifs, nil
@@ -98,8 +105,8 @@ func (da *DataAccess) GetNotificationsByUser(user_id int, limit int, offset int)
 	defer rows.Close()
 	for rows.Next() {
 		var (
-			createdAt []byte
-			updatedAt []byte
+			createdAt interface{}
+			updatedAt interface{}
 		)
 		n := model.Notification{}
 		err = rows.Scan(
@@ -117,29 +124,46 @@ func (da *DataAccess) GetNotificationsByUser(user_id int, limit int, offset int)
 		if err != nil {
 			return nil, err
 		}
-		n.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+		n.CreatedAt, err = db.ParseTime(createdAt)
 		if err != nil {
 			return nil, err
 		}
-		n.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+		n.UpdatedAt, err = db.ParseTime(updatedAt)
 		if err != nil {
 			return nil, err
 		}
 		notifs = append(notifs, &n)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 	return notifs, nil
 }
 
-func (da *DataAccess) UpdateNotificationRead(id int) error {
-	_, err := da.Db.Exec(query.UpdateNotificationRead, id)
+func (da *DataAccess) UpdateNotificationRead(ctx context.Context, id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.UpdateNotificationRead, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (da *DataAccess) DeleteFollowNotification(ctx context.Context, user_id int, from_user_id int, notif_type string) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.DeleteFollowNotification, user_id, from_user_id, notif_type)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (da *DataAccess) DeleteNotificationByID(id int) error {
-	_, err := da.Db.Exec(query.DeleteNotificationByID, id)
+func (da *DataAccess) DeleteNotificationByID(ctx context.Context, id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.DeleteNotificationByID, id)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,134 @@
+package orm_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/Anacardo89/lenic_api/internal/data/migrations"
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/orm"
+	"github.com/Anacardo89/lenic_api/pkg/db"
+)
+
+// sqliteDB opens an empty database in a file of its own
+func sqliteDB(t *testing.T) (*sql.DB, db.Dialect) {
+	t.Helper()
+	cfg := &db.Config{Driver: "sqlite", Dbase: filepath.Join(t.TempDir(), "lenic.db")}
+	d, err := cfg.Dialect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := db.LoginDB(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn, d
+}
+
+// newDA returns a DataAccess on a migrated database, and the database to
+// set up rows the repositories can't
+func newDA(t *testing.T) (*orm.DataAccess, *sql.DB) {
+	t.Helper()
+	conn, d := sqliteDB(t)
+	m, err := migrations.New(conn, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return orm.New(conn, d, 0), conn
+}
+
+// users creates users with the given names and returns their ids
+func users(t *testing.T, da *orm.DataAccess, names ...string) []int {
+	t.Helper()
+	ids := []int{}
+	for _, name := range names {
+		id, err := da.CreateUser(context.Background(), &model.User{UserName: name, Email: name + "@example.com", HashPass: "x", Active: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// tables lists the tables of conn, leaving out sqlite's own
+func tables(t *testing.T, conn *sql.DB) []string {
+	t.Helper()
+	rows, err := conn.Query(`SELECT name FROM sqlite_master WHERE type='table' AND name NOT LIKE 'sqlite_%' ORDER BY name`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	names := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return names
+}
+
+// count returns the number of rows in table
+func count(t *testing.T, conn *sql.DB, table string) int {
+	t.Helper()
+	var n int
+	if err := conn.QueryRow(`SELECT COUNT(*) FROM ` + table).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestMigrations(t *testing.T) {
+	ctx := context.Background()
+	conn, d := sqliteDB(t)
+	m, err := migrations.New(conn, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	applied, err := m.Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) < 6 {
+		t.Fatalf("Up applied %d migrations, want at least 6", len(applied))
+	}
+	schema := tables(t, conn)
+	for _, table := range []string{"users", "follows", "posts", "post_revisions", "comment_revisions", "user_blocks", "close_friends"} {
+		if !slices.Contains(schema, table) {
+			t.Errorf("table %s is missing after Up, have %q", table, schema)
+		}
+	}
+	if again, err := m.Up(ctx); err != nil || len(again) != 0 {
+		t.Errorf("Up again applied %d migrations, %v, want none", len(again), err)
+	}
+
+	reverted, err := m.Down(ctx, len(applied))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reverted) != len(applied) || reverted[0].Version != applied[len(applied)-1].Version {
+		t.Errorf("Down reverted %d migrations from %d, want all newest first", len(reverted), reverted[0].Version)
+	}
+	if left := tables(t, conn); !slices.Equal(left, []string{"schema_migrations"}) {
+		t.Errorf("tables left after Down are %q, want only schema_migrations", left)
+	}
+
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("Up after Down: %v", err)
+	}
+	if again := tables(t, conn); !slices.Equal(again, schema) {
+		t.Errorf("tables after Down and Up are %q, want %q", again, schema)
+	}
+}
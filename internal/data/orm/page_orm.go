@@ -0,0 +1,13 @@
+package orm
+
+import "github.com/Anacardo89/lenic_api/internal/data/repo"
+
+// keyset returns the arguments the paged queries take for page after
+// their filters: 1 and the key of the last row read, or 0 and a zero key
+// for the first page. The time is formatted for the dialect
+func (da *DataAccess) keyset(page repo.Page) (after int, key repo.Key, t string) {
+	if page.After != nil {
+		after, key = 1, *page.After
+	}
+	return after, key, da.Dialect.FormatTime(key.Time)
+}
@@ -0,0 +1,134 @@
+package orm_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+// readPages reads a list page by page until a short page, calling between
+// after each page, and returns the ids read
+func readPages[T any](t *testing.T, size int, read func(repo.Page) ([]T, error), key func(T) repo.Key, between func()) []int {
+	t.Helper()
+	ids := []int{}
+	page := repo.Page{Size: size}
+	for {
+		rows, err := read(page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range rows {
+			ids = append(ids, key(r).Id)
+		}
+		if len(rows) < size {
+			return ids
+		}
+		last := key(rows[len(rows)-1])
+		page.After = &last
+		between()
+	}
+}
+
+func TestFeedPages(t *testing.T) {
+	ctx := context.Background()
+	da, _ := newDA(t)
+	ids := users(t, da, "ann", "bob")
+	post := func(i int) int {
+		id, err := da.CreatePost(ctx, &model.Post{GUID: fmt.Sprint("guid", i), AuthorId: ids[0], Title: "title", Active: 1, Visibility: model.VisibilityPublic})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	want := []int{}
+	for i := range 5 {
+		want = append(want, post(i))
+	}
+	slices.Reverse(want)
+
+	// posts written while paging come before the pages already read
+	n := 5
+	got := readPages(t, 2, func(page repo.Page) ([]model.Post, error) {
+		posts, err := da.GetFeed(ctx, ids[1], page)
+		if err != nil {
+			return nil, err
+		}
+		return *posts, nil
+	}, repo.PostKey, func() {
+		post(n)
+		n++
+	})
+	if !slices.Equal(got, want) {
+		t.Errorf("feed pages read %v, want %v", got, want)
+	}
+}
+
+func TestConversationPages(t *testing.T) {
+	ctx := context.Background()
+	da, conn := newDA(t)
+	ids := users(t, da, "ann", "bob", "cat", "dan", "eve")
+	want := []int{}
+	for i, other := range ids[1:] {
+		id, err := da.CreateConversation(ctx, &model.Conversation{User1Id: ids[0], User2Id: other})
+		if err != nil {
+			t.Fatal(err)
+		}
+		at := fmt.Sprintf("2020-01-0%d 00:00:00", i+1)
+		if _, err := conn.Exec(`UPDATE conversations SET created_at=?, updated_at=? WHERE id=?`, at, at, id); err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, id)
+	}
+	slices.Reverse(want)
+
+	// a message in the oldest conversation doesn't move it past the page read
+	got := readPages(t, 2, func(page repo.Page) ([]*model.Conversation, error) {
+		return da.GetConversationsByUserId(ctx, ids[0], page)
+	}, func(c *model.Conversation) repo.Key {
+		return repo.ConversationKey(*c)
+	}, func() {
+		if err := da.UpdateConversationById(ctx, want[len(want)-1]); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !slices.Equal(got, want) {
+		t.Errorf("conversation pages read %v, want %v", got, want)
+	}
+}
+
+func TestDMPages(t *testing.T) {
+	ctx := context.Background()
+	da, _ := newDA(t)
+	ids := users(t, da, "ann", "bob")
+	conversation, err := da.CreateConversation(ctx, &model.Conversation{User1Id: ids[0], User2Id: ids[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := func(content string) int {
+		id, err := da.CreateDMessage(ctx, &model.DMessage{ConversationId: conversation, SenderId: ids[0], Content: content})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	want := []int{}
+	for i := range 5 {
+		want = append(want, dm(fmt.Sprint("dm ", i)))
+	}
+
+	// messages sent while paging come after the ones read, oldest first
+	got := readPages(t, 2, func(page repo.Page) ([]*model.DMessage, error) {
+		return da.GetDMsByConversationId(ctx, conversation, page)
+	}, func(d *model.DMessage) repo.Key {
+		return repo.DMKey(*d)
+	}, func() {
+		want = append(want, dm("new"))
+	})
+	if !slices.Equal(got, want) {
+		t.Errorf("dm pages read %v, want %v", got, want)
+	}
+}
@@ -1,31 +1,40 @@
 package orm
 
 import (
+	"context"
 	"database/sql"
-	"time"
+	"errors"
+	"fmt"
 
 	"github.com/Anacardo89/lenic_api/internal/data/model"
 	"github.com/Anacardo89/lenic_api/internal/data/query"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
 	"github.com/Anacardo89/lenic_api/pkg/db"
 )
 
-func (da *DataAccess) CreatePost(p *model.Post) (sql.Result, error) {
-	res, err := da.Db.Exec(query.InsertPost,
+func (da *DataAccess) CreatePost(ctx context.Context, p *model.Post) (int, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.insert(ctx, query.InsertPost,
 		p.GUID,
 		p.AuthorId,
 		p.Title,
 		p.Content,
 		p.Image,
 		p.ImageExt,
-		p.IsPublic,
+		p.Visibility == model.VisibilityPublic,
 		p.Rating,
-		p.Active)
-	return res, err
+		p.Active,
+		p.Visibility)
 }
 
-func (da *DataAccess) GetFeed(user_id int) (*[]model.Post, error) {
+func (da *DataAccess) GetFeed(ctx context.Context, user_id int, page repo.Page) (*[]model.Post, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	posts := []model.Post{}
-	rows, err := da.Db.Query(query.SelectFeed, user_id, user_id, user_id)
+	after, key, t := da.keyset(page)
+	rows, err := da.query(ctx, query.SelectFeed, user_id, user_id, user_id, user_id, user_id, user_id, user_id,
+		after, t, t, key.Id, page.Limit())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return &posts, nil
@@ -35,8 +44,10 @@ func (da *DataAccess) GetFeed(user_id int) (*[]model.Post, error) {
 	defer rows.Close()
 	for rows.Next() {
 		var (
-			createdAt []byte
-			updatedAt []byte
+			createdAt interface{}
+			updatedAt interface{}
+			isPublic  bool
+			editedAt  interface{}
 		)
 		p := model.Post{}
 		err = rows.Scan(
@@ -49,29 +60,41 @@ func (da *DataAccess) GetFeed(user_id int) (*[]model.Post, error) {
 			&p.ImageExt,
 			&createdAt,
 			&updatedAt,
-			&p.IsPublic,
+			&isPublic,
 			&p.Rating,
 			&p.Active,
+			&p.Visibility,
+			&p.EditCount,
+			&editedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
-		p.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+		p.CreatedAt, err = db.ParseTime(createdAt)
 		if err != nil {
 			return nil, err
 		}
-		p.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+		p.UpdatedAt, err = db.ParseTime(updatedAt)
+		if err != nil {
+			return nil, err
+		}
+		p.EditedAt, err = db.ParseTime(editedAt)
 		if err != nil {
 			return nil, err
 		}
 		posts = append(posts, p)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 	return &posts, nil
 }
 
-func (da *DataAccess) GetPosts() (*[]model.Post, error) {
+func (da *DataAccess) GetPosts(ctx context.Context) (*[]model.Post, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	posts := []model.Post{}
-	rows, err := da.Db.Query(query.SelectActivePosts)
+	rows, err := da.query(ctx, query.SelectActivePosts)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return &posts, nil
@@ -81,8 +104,10 @@ func (da *DataAccess) GetPosts() (*[]model.Post, error) {
 	defer rows.Close()
 	for rows.Next() {
 		var (
-			createdAt []byte
-			updatedAt []byte
+			createdAt interface{}
+			updatedAt interface{}
+			isPublic  bool
+			editedAt  interface{}
 		)
 		p := model.Post{}
 		err = rows.Scan(
@@ -95,29 +120,42 @@ func (da *DataAccess) GetPosts() (*[]model.Post, error) {
 			&p.ImageExt,
 			&createdAt,
 			&updatedAt,
-			&p.IsPublic,
+			&isPublic,
 			&p.Rating,
 			&p.Active,
+			&p.Visibility,
+			&p.EditCount,
+			&editedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
-		p.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+		p.CreatedAt, err = db.ParseTime(createdAt)
 		if err != nil {
 			return nil, err
 		}
-		p.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+		p.UpdatedAt, err = db.ParseTime(updatedAt)
+		if err != nil {
+			return nil, err
+		}
+		p.EditedAt, err = db.ParseTime(editedAt)
 		if err != nil {
 			return nil, err
 		}
 		posts = append(posts, p)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 	return &posts, nil
 }
 
-func (da *DataAccess) GetUserPosts(user_id int) (*[]model.Post, error) {
+func (da *DataAccess) GetUserPosts(ctx context.Context, user_id int, page repo.Page) (*[]model.Post, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	posts := []model.Post{}
-	rows, err := da.Db.Query(query.SelectUserActivePosts, user_id)
+	after, key, t := da.keyset(page)
+	rows, err := da.query(ctx, query.SelectUserActivePosts, user_id, after, t, t, key.Id, page.Limit())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return &posts, nil
@@ -127,8 +165,10 @@ func (da *DataAccess) GetUserPosts(user_id int) (*[]model.Post, error) {
 	defer rows.Close()
 	for rows.Next() {
 		var (
-			createdAt []byte
-			updatedAt []byte
+			createdAt interface{}
+			updatedAt interface{}
+			isPublic  bool
+			editedAt  interface{}
 		)
 		p := model.Post{}
 		err = rows.Scan(
@@ -141,29 +181,42 @@ func (da *DataAccess) GetUserPosts(user_id int) (*[]model.Post, error) {
 			&p.ImageExt,
 			&createdAt,
 			&updatedAt,
-			&p.IsPublic,
+			&isPublic,
 			&p.Rating,
 			&p.Active,
+			&p.Visibility,
+			&p.EditCount,
+			&editedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
-		p.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+		p.CreatedAt, err = db.ParseTime(createdAt)
+		if err != nil {
+			return nil, err
+		}
+		p.UpdatedAt, err = db.ParseTime(updatedAt)
 		if err != nil {
 			return nil, err
 		}
-		p.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+		p.EditedAt, err = db.ParseTime(editedAt)
 		if err != nil {
 			return nil, err
 		}
 		posts = append(posts, p)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 	return &posts, nil
 }
 
-func (da *DataAccess) GetUserPublicPosts(user_id int) (*[]model.Post, error) {
+func (da *DataAccess) GetUserPublicPosts(ctx context.Context, user_id int, page repo.Page) (*[]model.Post, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	posts := []model.Post{}
-	rows, err := da.Db.Query(query.SelectUserPublicPosts, user_id)
+	after, key, t := da.keyset(page)
+	rows, err := da.query(ctx, query.SelectUserPublicPosts, user_id, after, t, t, key.Id, page.Limit())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return &posts, nil
@@ -173,8 +226,10 @@ func (da *DataAccess) GetUserPublicPosts(user_id int) (*[]model.Post, error) {
 	defer rows.Close()
 	for rows.Next() {
 		var (
-			createdAt []byte
-			updatedAt []byte
+			createdAt interface{}
+			updatedAt interface{}
+			isPublic  bool
+			editedAt  interface{}
 		)
 		p := model.Post{}
 		err = rows.Scan(
@@ -187,33 +242,118 @@ func (da *DataAccess) GetUserPublicPosts(user_id int) (*[]model.Post, error) {
 			&p.ImageExt,
 			&createdAt,
 			&updatedAt,
-			&p.IsPublic,
+			&isPublic,
 			&p.Rating,
 			&p.Active,
+			&p.Visibility,
+			&p.EditCount,
+			&editedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
-		p.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+		p.CreatedAt, err = db.ParseTime(createdAt)
+		if err != nil {
+			return nil, err
+		}
+		p.UpdatedAt, err = db.ParseTime(updatedAt)
 		if err != nil {
 			return nil, err
 		}
-		p.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+		p.EditedAt, err = db.ParseTime(editedAt)
 		if err != nil {
 			return nil, err
 		}
 		posts = append(posts, p)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 	return &posts, nil
 }
 
-func (da *DataAccess) GetPostByGUID(guid string) (*model.Post, error) {
+func (da *DataAccess) GetUserVisiblePosts(ctx context.Context, author_id int, viewer_id int, page repo.Page) (*[]model.Post, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	posts := []model.Post{}
+	after, key, t := da.keyset(page)
+	rows, err := da.query(ctx, query.SelectUserVisiblePosts, viewer_id, viewer_id, viewer_id, author_id,
+		after, t, t, key.Id, page.Limit())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &posts, nil
+		}
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			createdAt interface{}
+			updatedAt interface{}
+			isPublic  bool
+			editedAt  interface{}
+		)
+		p := model.Post{}
+		err = rows.Scan(
+			&p.Id,
+			&p.GUID,
+			&p.AuthorId,
+			&p.Title,
+			&p.Content,
+			&p.Image,
+			&p.ImageExt,
+			&createdAt,
+			&updatedAt,
+			&isPublic,
+			&p.Rating,
+			&p.Active,
+			&p.Visibility,
+			&p.EditCount,
+			&editedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		p.CreatedAt, err = db.ParseTime(createdAt)
+		if err != nil {
+			return nil, err
+		}
+		p.UpdatedAt, err = db.ParseTime(updatedAt)
+		if err != nil {
+			return nil, err
+		}
+		p.EditedAt, err = db.ParseTime(editedAt)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &posts, nil
+}
+
+func (da *DataAccess) GetPostByGUID(ctx context.Context, guid string) (*model.Post, error) {
+	return da.getPost(ctx, query.SelectPostByGUID, guid)
+}
+
+func (da *DataAccess) GetPostByID(ctx context.Context, id int) (*model.Post, error) {
+	return da.getPost(ctx, query.SelectPostByID, id)
+}
+
+// getPost reads the post q, a select of postColumns, finds with arg
+func (da *DataAccess) getPost(ctx context.Context, q string, arg interface{}) (*model.Post, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	var (
-		createdAt []byte
-		updatedAt []byte
+		createdAt interface{}
+		updatedAt interface{}
+		isPublic  bool
+		editedAt  interface{}
 	)
 	p := model.Post{}
-	row := da.Db.QueryRow(query.SelectPostByGUID, guid)
+	row := da.queryRow(ctx, q, arg)
 	err := row.Scan(
 		&p.Id,
 		&p.GUID,
@@ -224,91 +364,212 @@ func (da *DataAccess) GetPostByGUID(guid string) (*model.Post, error) {
 		&p.ImageExt,
 		&createdAt,
 		&updatedAt,
-		&p.IsPublic,
+		&isPublic,
 		&p.Rating,
 		&p.Active,
+		&p.Visibility,
+		&p.EditCount,
+		&editedAt,
 	)
 	if err != nil {
 		return nil, err
 	}
-	p.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	p.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
-	p.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+	p.UpdatedAt, err = db.ParseTime(updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	p.EditedAt, err = db.ParseTime(editedAt)
 	if err != nil {
 		return nil, err
 	}
 	return &p, nil
 }
 
-func (da *DataAccess) GetPostByID(id int) (*model.Post, error) {
-	var (
-		createdAt []byte
-		updatedAt []byte
-	)
-	p := model.Post{}
-	row := da.Db.QueryRow(query.SelectPostByID, id)
-	err := row.Scan(
-		&p.Id,
-		&p.GUID,
-		&p.AuthorId,
-		&p.Title,
-		&p.Content,
-		&p.Image,
-		&p.ImageExt,
-		&createdAt,
-		&updatedAt,
-		&p.IsPublic,
-		&p.Rating,
-		&p.Active,
-	)
+// UpdatePost keeps the version it replaces as a revision, an update that
+// changes nothing is skipped
+func (da *DataAccess) UpdatePost(ctx context.Context, post model.Post) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.inTx(ctx, func(tx *DataAccess) error {
+		current, err := tx.getPost(ctx, tx.forUpdate(query.SelectPostByGUID), post.GUID)
+		if err != nil {
+			return err
+		}
+		if current.Title == post.Title && current.Content == post.Content && current.Visibility == post.Visibility {
+			return nil
+		}
+		_, err = tx.exec(ctx, query.InsertPostRevision,
+			current.Id,
+			current.EditCount+1,
+			current.Title,
+			current.Content,
+			current.Visibility,
+			current.VersionTime().UTC().Format(db.DateLayout))
+		if errors.Is(err, repo.ErrDuplicate) {
+			return fmt.Errorf("%w: %w", errLostRace, err)
+		}
+		if err != nil {
+			return err
+		}
+		_, err = tx.exec(ctx, query.UpdatePost,
+			post.Title,
+			post.Content,
+			post.Visibility == model.VisibilityPublic,
+			post.Visibility,
+			post.GUID)
+		return err
+	})
+}
+
+func (da *DataAccess) GetPostRevisions(ctx context.Context, post_id int) (*[]model.PostRevision, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	revisions := []model.PostRevision{}
+	rows, err := da.query(ctx, query.SelectPostRevisions, post_id)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return &revisions, nil
+		}
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var createdAt interface{}
+		r := model.PostRevision{}
+		err = rows.Scan(
+			&r.PostId,
+			&r.Revision,
+			&r.Title,
+			&r.Content,
+			&r.Visibility,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		r.CreatedAt, err = db.ParseTime(createdAt)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, r)
+	}
+	if err := rows.Err(); err != nil {
 		return nil, err
 	}
-	p.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	return &revisions, nil
+}
+
+func (da *DataAccess) GetPostRevision(ctx context.Context, post_id int, revision int) (*model.PostRevision, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	var createdAt interface{}
+	r := model.PostRevision{}
+	row := da.queryRow(ctx, query.SelectPostRevision, post_id, revision)
+	err := row.Scan(
+		&r.PostId,
+		&r.Revision,
+		&r.Title,
+		&r.Content,
+		&r.Visibility,
+		&createdAt,
+	)
 	if err != nil {
 		return nil, err
 	}
-	p.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+	r.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
-	return &p, nil
+	return &r, nil
 }
 
-func (da *DataAccess) UpdatePost(post model.Post) error {
-	_, err := da.Db.Exec(query.UpdatePost,
-		post.Title,
-		post.Content,
-		post.IsPublic,
-		post.GUID)
+func (da *DataAccess) DisablePost(ctx context.Context, guid string) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.SetPostAsInactive, guid)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (da *DataAccess) DisablePost(guid string) error {
-	_, err := da.Db.Exec(query.SetPostAsInactive, guid)
+func (da *DataAccess) SetPostAudience(ctx context.Context, post_id int, user_ids []int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.inTx(ctx, func(tx *DataAccess) error {
+		_, err := tx.exec(ctx, query.DeletePostAudience, post_id)
+		if err != nil {
+			return err
+		}
+		for _, user_id := range user_ids {
+			_, err = tx.exec(ctx, query.InsertPostAudience, post_id, user_id)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (da *DataAccess) IsInPostAudience(ctx context.Context, post_id int, user_id int) (bool, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	var id int
+	row := da.queryRow(ctx, query.SelectPostAudienceMember, post_id, user_id)
+	err := row.Scan(&id)
 	if err != nil {
-		return err
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
 	}
-	return nil
+	return true, nil
 }
 
-func (da *DataAccess) RatePostUp(post_id int, user_id int) error {
-	_, err := da.Db.Exec(query.RatePostUp, post_id, user_id)
+func (da *DataAccess) RatePostUp(ctx context.Context, post_id int, user_id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, da.upsert(query.RatePostUp), post_id, user_id)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (da *DataAccess) RatePostDown(post_id int, user_id int) error {
-	_, err := da.Db.Exec(query.RatePostDown, post_id, user_id)
+func (da *DataAccess) RatePostDown(ctx context.Context, post_id int, user_id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, da.upsert(query.RatePostDown), post_id, user_id)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// SetPostRating locks the post while it reads the vote it replaces, a vote
+// row that isn't there yet can't be locked
+func (da *DataAccess) SetPostRating(ctx context.Context, post_id int, user_id int, value int) (int, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	previous := 0
+	err := da.inTx(ctx, func(tx *DataAccess) error {
+		previous = 0
+		_, err := tx.getPost(ctx, tx.forUpdate(query.SelectPostByID), post_id)
+		if err != nil {
+			return err
+		}
+		pr, err := tx.GetPostUserRating(ctx, post_id, user_id)
+		if err == nil {
+			previous = pr.RatingValue
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+		_, err = tx.exec(ctx, tx.upsert(query.SetPostRating), post_id, user_id, value)
+		return err
+	})
+	return previous, err
+}
@@ -1,15 +1,19 @@
 package orm
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/Anacardo89/lenic_api/internal/data/model"
 	"github.com/Anacardo89/lenic_api/internal/data/query"
 )
 
-func (da *DataAccess) GetPostUserRating(post_id int, user_id int) (*model.PostRatings, error) {
+func (da *DataAccess) GetPostUserRating(ctx context.Context, post_id int, user_id int) (*model.PostRatings, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	pr := model.PostRatings{}
-	row := da.Db.QueryRow(query.SelectPostUserRating, post_id, user_id)
+	row := da.queryRow(ctx, query.SelectPostUserRating, post_id, user_id)
 	err := row.Scan(
 		&pr.PostId,
 		&pr.UserId,
@@ -25,9 +29,11 @@ func (da *DataAccess) GetPostUserRating(post_id int, user_id int) (*model.PostRa
 	return &pr, nil
 }
 
-func (da *DataAccess) GetCommentUserRating(comment_id int, user_id int) (*model.CommentRatings, error) {
+func (da *DataAccess) GetCommentUserRating(ctx context.Context, comment_id int, user_id int) (*model.CommentRatings, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	cr := model.CommentRatings{}
-	row := da.Db.QueryRow(query.SelectCommentUserRating, comment_id, user_id)
+	row := da.queryRow(ctx, query.SelectCommentUserRating, comment_id, user_id)
 	err := row.Scan(
 		&cr.CommentId,
 		&cr.UserId,
@@ -42,3 +48,83 @@ func (da *DataAccess) GetCommentUserRating(comment_id int, user_id int) (*model.
 	}
 	return &cr, nil
 }
+
+func (da *DataAccess) GetPostVotes(ctx context.Context, user_id int, post_ids []int) (map[int]model.Votes, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.getVotes(ctx, query.SelectPostVotes, user_id, post_ids)
+}
+
+func (da *DataAccess) GetCommentVotes(ctx context.Context, user_id int, comment_ids []int) (map[int]model.Votes, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.getVotes(ctx, query.SelectCommentVotes, user_id, comment_ids)
+}
+
+// getVotes runs SelectPostVotes or SelectCommentVotes
+func (da *DataAccess) getVotes(ctx context.Context, q string, user_id int, ids []int) (map[int]model.Votes, error) {
+	votes := map[int]model.Votes{}
+	if len(ids) == 0 {
+		return votes, nil
+	}
+	args := []interface{}{user_id}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	rows, err := da.query(ctx, fmt.Sprintf(q, query.Placeholders(len(ids))), args...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return votes, nil
+		}
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int
+		v := model.Votes{}
+		err = rows.Scan(
+			&id,
+			&v.Up,
+			&v.Down,
+			&v.Mine,
+		)
+		if err != nil {
+			return nil, err
+		}
+		votes[id] = v
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return votes, nil
+}
+
+func (da *DataAccess) GetPostRatings(ctx context.Context, post_id int) (*[]model.PostRatings, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	ratings := []model.PostRatings{}
+	rows, err := da.query(ctx, query.SelectPostRatings, post_id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &ratings, nil
+		}
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		pr := model.PostRatings{}
+		err = rows.Scan(
+			&pr.PostId,
+			&pr.UserId,
+			&pr.RatingValue,
+		)
+		if err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &ratings, nil
+}
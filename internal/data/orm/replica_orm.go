@@ -0,0 +1,150 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"github.com/Anacardo89/lenic_api/pkg/auth"
+)
+
+const (
+	// defaultPrimaryPin is how long a user's reads stay on the primary
+	// after they write when UseReplicas isn't given a window
+	defaultPrimaryPin = 5 * time.Second
+	// replicaCheckInterval is how often replicas are pinged to eject the
+	// dead ones and bring back the recovered ones
+	replicaCheckInterval = 5 * time.Second
+)
+
+// replicaSet spreads reads over read replicas round-robin, skipping the
+// ones that failed their last check
+type replicaSet struct {
+	replicas []*replica
+	next     atomic.Uint32
+	// pinFor is how long reads of a user who wrote go to the primary
+	pinFor time.Duration
+	// pinned maps usernames to when their pin ends
+	pinned sync.Map
+}
+
+type replica struct {
+	db      *sql.DB
+	healthy atomic.Bool
+}
+
+// UseReplicas sends the reads of da that aren't in a transaction to
+// replicas, except those of a user who wrote in the last pinFor, so they
+// always read their own writes. Reads fall back to the primary while
+// every replica is down.
+func (da *DataAccess) UseReplicas(replicas []*sql.DB, pinFor time.Duration) {
+	if len(replicas) == 0 {
+		return
+	}
+	if pinFor <= 0 {
+		pinFor = defaultPrimaryPin
+	}
+	rs := &replicaSet{pinFor: pinFor}
+	for _, database := range replicas {
+		r := &replica{db: database}
+		r.healthy.Store(true)
+		rs.replicas = append(rs.replicas, r)
+	}
+	da.replicas = rs
+	go rs.watch()
+}
+
+// reader is the connection reads of ctx go to, the primary for
+// repo.ReadPrimary
+func (da *DataAccess) reader(ctx context.Context) (querier, *replica) {
+	if da.tx != nil || da.replicas == nil || repo.ReadsPrimary(ctx) || da.replicas.isPinned(ctx) {
+		return da.conn(), nil
+	}
+	r := da.replicas.pick()
+	if r == nil {
+		return da.Db, nil
+	}
+	return r.db, r
+}
+
+// wrote pins the reads of the user of ctx to the primary
+func (da *DataAccess) wrote(ctx context.Context) {
+	if da.replicas == nil {
+		return
+	}
+	if claims, ok := auth.FromContext(ctx); ok {
+		da.replicas.pinned.Store(claims.Username, time.Now().Add(da.replicas.pinFor))
+	}
+}
+
+func (rs *replicaSet) isPinned(ctx context.Context) bool {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	until, ok := rs.pinned.Load(claims.Username)
+	if !ok {
+		return false
+	}
+	if time.Now().After(until.(time.Time)) {
+		rs.pinned.CompareAndDelete(claims.Username, until)
+		return false
+	}
+	return true
+}
+
+// pick returns the next healthy replica, or nil if there's none
+func (rs *replicaSet) pick() *replica {
+	n := uint32(len(rs.replicas))
+	start := rs.next.Add(1)
+	for i := uint32(0); i < n; i++ {
+		r := rs.replicas[(start+i)%n]
+		if r.healthy.Load() {
+			return r
+		}
+	}
+	return nil
+}
+
+// failed ejects r if err means it can't be reached, and reports whether
+// it did
+func (r *replica) failed(err error) bool {
+	if r == nil || !isConnError(err) {
+		return false
+	}
+	r.healthy.Store(false)
+	return true
+}
+
+// watch pings every replica every replicaCheckInterval and marks each
+// healthy or not, it runs for the life of the process
+func (rs *replicaSet) watch() {
+	ticker := time.NewTicker(replicaCheckInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		for _, r := range rs.replicas {
+			ctx, cancel := context.WithTimeout(context.Background(), replicaCheckInterval)
+			r.healthy.Store(r.db.PingContext(ctx) == nil)
+			cancel()
+		}
+		rs.pinned.Range(func(user, until any) bool {
+			if time.Now().After(until.(time.Time)) {
+				rs.pinned.CompareAndDelete(user, until)
+			}
+			return true
+		})
+	}
+}
+
+// isConnError reports whether err comes from the connection rather than
+// the query
+func isConnError(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, driver.ErrBadConn) || errors.As(err, &netErr)
+}
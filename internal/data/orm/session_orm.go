@@ -0,0 +1,59 @@
+package orm
+
+import (
+	"context"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/query"
+	"github.com/Anacardo89/lenic_api/pkg/db"
+)
+
+func (da *DataAccess) CreateSession(ctx context.Context, s *model.Session) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, da.upsert(query.InsertSession),
+		s.SessionId,
+		s.UserId,
+		s.Active,
+	)
+	return err
+}
+
+func (da *DataAccess) GetSessionById(ctx context.Context, id int) (*model.Session, error) {
+	return da.getSession(ctx, query.SelectSessionById, id)
+}
+
+func (da *DataAccess) GetSessionBySessionId(ctx context.Context, session_id string) (*model.Session, error) {
+	return da.getSession(ctx, query.SelectSessionBySessionId, session_id)
+}
+
+func (da *DataAccess) getSession(ctx context.Context, q string, arg interface{}) (*model.Session, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	var (
+		createdAt interface{}
+		updatedAt interface{}
+	)
+	s := model.Session{}
+	row := da.queryRow(ctx, q, arg)
+	err := row.Scan(
+		&s.Id,
+		&s.SessionId,
+		&s.UserId,
+		&createdAt,
+		&updatedAt,
+		&s.Active,
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.CreatedAt, err = db.ParseTime(createdAt)
+	if err != nil {
+		return nil, err
+	}
+	s.UpdatedAt, err = db.ParseTime(updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
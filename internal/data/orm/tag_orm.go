@@ -1,21 +1,26 @@
 package orm
 
 import (
+	"context"
 	"github.com/Anacardo89/lenic_api/internal/data/model"
 	"github.com/Anacardo89/lenic_api/internal/data/query"
 )
 
-func (da *DataAccess) CreateTag(t *model.Tag) error {
-	_, err := da.Db.Exec(query.InsertTag,
+func (da *DataAccess) CreateTag(ctx context.Context, t *model.Tag) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.InsertTag,
 		t.TagName,
 		t.TagType,
 	)
 	return err
 }
 
-func (da *DataAccess) GetTagByName(tag_name string) (*model.Tag, error) {
+func (da *DataAccess) GetTagByName(ctx context.Context, tag_name string) (*model.Tag, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	t := model.Tag{}
-	row := da.Db.QueryRow(query.SelectTagByName, tag_name)
+	row := da.queryRow(ctx, query.SelectTagByName, tag_name)
 	err := row.Scan(
 		&t.Id,
 		&t.TagName,
@@ -27,8 +32,10 @@ func (da *DataAccess) GetTagByName(tag_name string) (*model.Tag, error) {
 	return &t, nil
 }
 
-func (da *DataAccess) CreateUserTag(t *model.UserTag) error {
-	_, err := da.Db.Exec(query.InsertUserTag,
+func (da *DataAccess) CreateUserTag(ctx context.Context, t *model.UserTag) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.InsertUserTag,
 		t.TagId,
 		t.PostId,
 		t.CommentId,
@@ -37,9 +44,11 @@ func (da *DataAccess) CreateUserTag(t *model.UserTag) error {
 	return err
 }
 
-func (da *DataAccess) GetUserTagById(tag_id int) (*model.UserTag, error) {
+func (da *DataAccess) GetUserTagById(ctx context.Context, tag_id int) (*model.UserTag, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	t := model.UserTag{}
-	row := da.Db.QueryRow(query.SelectUserTagById, tag_id)
+	row := da.queryRow(ctx, query.SelectUserTagById, tag_id)
 	err := row.Scan(
 		&t.Id,
 		&t.TagId,
@@ -53,13 +62,17 @@ func (da *DataAccess) GetUserTagById(tag_id int) (*model.UserTag, error) {
 	return &t, nil
 }
 
-func (da *DataAccess) DeleteUserTagByID(id int) error {
-	_, err := da.Db.Exec(query.DeleteUserTagById, id)
+func (da *DataAccess) DeleteUserTagByID(ctx context.Context, id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.DeleteUserTagById, id)
 	return err
 }
 
-func (da *DataAccess) CreateReferenceTag(t *model.ReferenceTag) error {
-	_, err := da.Db.Exec(query.InsertUserTag,
+func (da *DataAccess) CreateReferenceTag(ctx context.Context, t *model.ReferenceTag) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.InsertReferenceTag,
 		t.TagId,
 		t.PostId,
 		t.CommentId,
@@ -68,9 +81,11 @@ func (da *DataAccess) CreateReferenceTag(t *model.ReferenceTag) error {
 	return err
 }
 
-func (da *DataAccess) GetReferenceTagById(tag_id int) (*model.ReferenceTag, error) {
+func (da *DataAccess) GetReferenceTagById(ctx context.Context, tag_id int) (*model.ReferenceTag, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	t := model.ReferenceTag{}
-	row := da.Db.QueryRow(query.SelectReferenceTagById, tag_id)
+	row := da.queryRow(ctx, query.SelectReferenceTagById, tag_id)
 	err := row.Scan(
 		&t.Id,
 		&t.TagId,
@@ -84,7 +99,9 @@ func (da *DataAccess) GetReferenceTagById(tag_id int) (*model.ReferenceTag, erro
 	return &t, nil
 }
 
-func (da *DataAccess) DeleteReferenceTagByID(id int) error {
-	_, err := da.Db.Exec(query.DeleteReferenceTagById, id)
+func (da *DataAccess) DeleteReferenceTagByID(ctx context.Context, id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.DeleteReferenceTagById, id)
 	return err
 }
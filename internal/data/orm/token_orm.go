@@ -1,29 +1,32 @@
 package orm
 
 import (
-	"time"
+	"context"
 
 	"github.com/Anacardo89/lenic_api/internal/data/model"
 	"github.com/Anacardo89/lenic_api/internal/data/query"
 	"github.com/Anacardo89/lenic_api/pkg/db"
 )
 
-func (da *DataAccess) CreateToken(t *model.Token) error {
-	_, err := da.Db.Exec(query.InsertToken,
+func (da *DataAccess) CreateToken(ctx context.Context, t *model.Token) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, da.upsert(query.InsertToken),
 		t.Token,
 		t.UserId,
-		t.Token,
 	)
 	return err
 }
 
-func (da *DataAccess) GetTokenByUserId(id int) (*model.Token, error) {
+func (da *DataAccess) GetTokenByUserId(ctx context.Context, id int) (*model.Token, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	var (
-		createdAt []byte
-		updatedAt []byte
+		createdAt interface{}
+		updatedAt interface{}
 	)
 	t := model.Token{}
-	row := da.Db.QueryRow(query.SelectTokenByUserId, id)
+	row := da.queryRow(ctx, query.SelectTokenByUserId, id)
 	err := row.Scan(
 		&t.Id,
 		&t.Token,
@@ -34,19 +37,21 @@ func (da *DataAccess) GetTokenByUserId(id int) (*model.Token, error) {
 	if err != nil {
 		return nil, err
 	}
-	t.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	t.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
-	t.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+	t.UpdatedAt, err = db.ParseTime(updatedAt)
 	if err != nil {
 		return nil, err
 	}
 	return &t, nil
 }
 
-func (da *DataAccess) DeleteTokenByUserId(id int) error {
-	_, err := da.Db.Exec(query.DeleteTokenByUserId, id)
+func (da *DataAccess) DeleteTokenByUserId(ctx context.Context, id int) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.DeleteTokenByUserId, id)
 	if err != nil {
 		return err
 	}
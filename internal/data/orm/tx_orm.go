@@ -0,0 +1,67 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+)
+
+const (
+	// txAttempts bounds how many times a transaction the database keeps
+	// aborting is run
+	txAttempts = 3
+	txBackoff  = 20 * time.Millisecond
+)
+
+// errLostRace aborts a transaction that lost a race with another one, like
+// two edits numbering the same revision, and can be run again as is
+var errLostRace = errors.New("transaction lost a race")
+
+// InTx implements repo.UnitOfWork
+func (da *DataAccess) InTx(ctx context.Context, f func(r repo.Repositories) error) error {
+	return da.inTx(ctx, func(tx *DataAccess) error {
+		return f(tx.Repositories())
+	})
+}
+
+// inTx runs f with a DataAccess bound to a transaction, or joins the one
+// da is bound to. Transactions aborted by a deadlock, or by errLostRace,
+// are retried up to txAttempts times with a growing pause.
+func (da *DataAccess) inTx(ctx context.Context, f func(tx *DataAccess) error) error {
+	if da.tx != nil {
+		return f(da)
+	}
+	for attempt := 1; ; attempt++ {
+		err := da.runTx(ctx, f)
+		if err == nil || attempt == txAttempts || !(da.Dialect.Retryable(err) || errors.Is(err, errLostRace)) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * txBackoff):
+		}
+	}
+}
+
+func (da *DataAccess) runTx(ctx context.Context, f func(tx *DataAccess) error) error {
+	tx, err := da.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	bound := &DataAccess{
+		Db:       da.Db,
+		Dialect:  da.Dialect,
+		Timeout:  da.Timeout,
+		rebound:  da.rebound,
+		tx:       tx,
+		replicas: da.replicas,
+	}
+	if err := f(bound); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
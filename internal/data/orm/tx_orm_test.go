@@ -0,0 +1,71 @@
+package orm_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"github.com/mattn/go-sqlite3"
+)
+
+// busy is the error sqlite aborts a transaction with when it can't get
+// the write lock, which InTx retries like a deadlock
+var busy = sqlite3.Error{Code: sqlite3.ErrBusy}
+
+func TestInTxRetries(t *testing.T) {
+	ctx := context.Background()
+	da, conn := newDA(t)
+	attempts := 0
+	err := da.InTx(ctx, func(tx repo.Repositories) error {
+		attempts++
+		if _, err := tx.Users.CreateUser(ctx, &model.User{UserName: "ann", Email: "ann@example.com", HashPass: "x", Active: 1}); err != nil {
+			return err
+		}
+		if attempts < 3 {
+			return busy
+		}
+		return nil
+	})
+	if err != nil || attempts != 3 {
+		t.Fatalf("InTx returned %v after %d attempts, want success on the third", err, attempts)
+	}
+	// the user of each aborted attempt was rolled back
+	if _, err := da.GetUserByName(ctx, "ann"); err != nil {
+		t.Errorf("user of the last attempt: %v", err)
+	}
+	if n := count(t, conn, "users"); n != 1 {
+		t.Errorf("%d users after the retries, want 1", n)
+	}
+}
+
+func TestInTxGivesUp(t *testing.T) {
+	ctx := context.Background()
+	da, conn := newDA(t)
+	attempts := 0
+	err := da.InTx(ctx, func(tx repo.Repositories) error {
+		attempts++
+		if _, err := tx.Users.CreateUser(ctx, &model.User{UserName: "ann", Email: "ann@example.com", HashPass: "x", Active: 1}); err != nil {
+			return err
+		}
+		return busy
+	})
+	if err != busy || attempts != 3 {
+		t.Errorf("InTx returned %v after %d attempts, want the busy error after 3", err, attempts)
+	}
+	if n := count(t, conn, "users"); n != 0 {
+		t.Errorf("%d users after every attempt failed, want none", n)
+	}
+
+	// other errors aren't retried, and inner calls join the transaction
+	attempts = 0
+	err = da.InTx(ctx, func(tx repo.Repositories) error {
+		attempts++
+		return tx.InTx(ctx, func(inner repo.Repositories) error {
+			return repo.ErrDuplicate
+		})
+	})
+	if err != repo.ErrDuplicate || attempts != 1 {
+		t.Errorf("InTx returned %v after %d attempts, want the error after 1", err, attempts)
+	}
+}
@@ -1,30 +1,40 @@
 package orm
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/Anacardo89/lenic_api/internal/data/model"
 	"github.com/Anacardo89/lenic_api/internal/data/query"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
 	"github.com/Anacardo89/lenic_api/pkg/db"
 )
 
-func (da *DataAccess) CreateUser(u *model.User) (sql.Result, error) {
-	res, err := da.Db.Exec(query.InsertUser,
+func (da *DataAccess) CreateUser(ctx context.Context, u *model.User) (int, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.insert(ctx, query.InsertUser,
 		u.UserName,
 		u.Email,
 		u.HashPass,
-		u.Active)
-	return res, err
+		u.Active,
+		u.IsPrivate)
 }
 
-func (da *DataAccess) GetUserByID(id int) (*model.User, error) {
+func (da *DataAccess) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	var (
-		createdAt []byte
-		updatedAt []byte
+		createdAt interface{}
+		updatedAt interface{}
+		deletedAt interface{}
 	)
 	u := model.User{}
-	row := da.Db.QueryRow(query.SelectUserById, id)
+	row := da.queryRow(ctx, query.SelectUserById, id)
 	err := row.Scan(
 		&u.Id,
 		&u.UserName,
@@ -36,28 +46,79 @@ func (da *DataAccess) GetUserByID(id int) (*model.User, error) {
 		&u.Following,
 		&createdAt,
 		&updatedAt,
-		&u.Active)
+		&u.Active,
+		&u.IsPrivate,
+		&deletedAt)
 	if err != nil {
 		return nil, err
 	}
-	u.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	u.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
-	u.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+	u.UpdatedAt, err = db.ParseTime(updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	u.DeletedAt, err = db.ParseTime(deletedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (da *DataAccess) GetUserByName(ctx context.Context, name string) (*model.User, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	var (
+		createdAt interface{}
+		updatedAt interface{}
+		deletedAt interface{}
+	)
+	u := model.User{}
+	row := da.queryRow(ctx, query.SelectUserByName, name)
+	err := row.Scan(
+		&u.Id,
+		&u.UserName,
+		&u.Email,
+		&u.HashPass,
+		&u.ProfilePic,
+		&u.ProfilePicExt,
+		&u.Followers,
+		&u.Following,
+		&createdAt,
+		&updatedAt,
+		&u.Active,
+		&u.IsPrivate,
+		&deletedAt)
+	if err != nil {
+		return nil, err
+	}
+	u.CreatedAt, err = db.ParseTime(createdAt)
+	if err != nil {
+		return nil, err
+	}
+	u.UpdatedAt, err = db.ParseTime(updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	u.DeletedAt, err = db.ParseTime(deletedAt)
 	if err != nil {
 		return nil, err
 	}
 	return &u, nil
 }
 
-func (da *DataAccess) GetUserByName(name string) (*model.User, error) {
+func (da *DataAccess) GetDeletedUserByName(ctx context.Context, name string) (*model.User, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	var (
-		createdAt []byte
-		updatedAt []byte
+		createdAt interface{}
+		updatedAt interface{}
+		deletedAt interface{}
 	)
 	u := model.User{}
-	row := da.Db.QueryRow(query.SelectUserByName, name)
+	row := da.queryRow(ctx, query.SelectDeletedUserByName, name)
 	err := row.Scan(
 		&u.Id,
 		&u.UserName,
@@ -69,25 +130,95 @@ func (da *DataAccess) GetUserByName(name string) (*model.User, error) {
 		&u.Following,
 		&createdAt,
 		&updatedAt,
-		&u.Active)
+		&u.Active,
+		&u.IsPrivate,
+		&deletedAt)
+	if err != nil {
+		return nil, err
+	}
+	u.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
-	u.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	u.UpdatedAt, err = db.ParseTime(updatedAt)
 	if err != nil {
 		return nil, err
 	}
-	u.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+	u.DeletedAt, err = db.ParseTime(deletedAt)
 	if err != nil {
 		return nil, err
 	}
 	return &u, nil
 }
 
-func (da *DataAccess) GetSearchUsers(username string) (*[]model.User, error) {
+func (da *DataAccess) SearchUsers(ctx context.Context, term string, viewer_id int, limit int) (*[]model.User, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	letters := []rune(strings.ToLower(term))
+	if len(letters) == 0 {
+		return &[]model.User{}, nil
+	}
+	prefix := escapeLike(string(letters)) + "%"
+	contains := "%" + prefix
+	first, last := escapeLike(string(letters[0])), escapeLike(string(letters[len(letters)-1]))
+	return da.getUsers(ctx, query.SelectSearchUsers,
+		contains, first+"%", "%"+last,
+		viewer_id, viewer_id,
+		string(letters), prefix, contains, limit)
+}
+
+// escapeLike makes s match itself in a LIKE pattern with ESCAPE '!'
+func escapeLike(s string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(s)
+}
+
+// usersByIDsChunk bounds the IN list of a single query
+const usersByIDsChunk = 500
+
+func (da *DataAccess) GetUsersByIDs(ctx context.Context, ids []int) (*[]model.User, error) {
+	keys := make([]interface{}, len(ids))
+	for i, id := range ids {
+		keys[i] = id
+	}
+	return da.getUsersIn(ctx, query.SelectUsersByIDs, keys)
+}
+
+func (da *DataAccess) GetUsersByNames(ctx context.Context, names []string) (*[]model.User, error) {
+	keys := make([]interface{}, len(names))
+	for i, name := range names {
+		keys[i] = name
+	}
+	return da.getUsersIn(ctx, query.SelectUsersByNames, keys)
+}
+
+// getUsersIn runs q, which takes its IN list from Placeholders, over keys
+// a chunk at a time and returns the users found ordered by id
+func (da *DataAccess) getUsersIn(ctx context.Context, q string, keys []interface{}) (*[]model.User, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	users := []model.User{}
+	for len(keys) > 0 {
+		chunk := keys
+		if len(chunk) > usersByIDsChunk {
+			chunk = chunk[:usersByIDsChunk]
+		}
+		keys = keys[len(chunk):]
+		found, err := da.getUsers(ctx, fmt.Sprintf(q, query.Placeholders(len(chunk))), chunk...)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *found...)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Id < users[j].Id
+	})
+	return &users, nil
+}
+
+// getUsers runs q and scans the user rows it returns
+func (da *DataAccess) getUsers(ctx context.Context, q string, args ...interface{}) (*[]model.User, error) {
 	users := []model.User{}
-	likeuser := "%" + username + "%"
-	rows, err := da.Db.Query(query.SelectSearchUsers, likeuser)
+	rows, err := da.query(ctx, q, args...)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return &users, nil
@@ -97,8 +228,9 @@ func (da *DataAccess) GetSearchUsers(username string) (*[]model.User, error) {
 	defer rows.Close()
 	for rows.Next() {
 		var (
-			createdAt []byte
-			updatedAt []byte
+			createdAt interface{}
+			updatedAt interface{}
+			deletedAt interface{}
 		)
 		u := model.User{}
 		err = rows.Scan(
@@ -113,30 +245,42 @@ func (da *DataAccess) GetSearchUsers(username string) (*[]model.User, error) {
 			&createdAt,
 			&updatedAt,
 			&u.Active,
+			&u.IsPrivate,
+			&deletedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
-		u.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+		u.CreatedAt, err = db.ParseTime(createdAt)
 		if err != nil {
 			return nil, err
 		}
-		u.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+		u.UpdatedAt, err = db.ParseTime(updatedAt)
+		if err != nil {
+			return nil, err
+		}
+		u.DeletedAt, err = db.ParseTime(deletedAt)
 		if err != nil {
 			return nil, err
 		}
 		users = append(users, u)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 	return &users, nil
 }
 
-func (da *DataAccess) GetUserByEmail(email string) (*model.User, error) {
+func (da *DataAccess) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
 	var (
-		createdAt []byte
-		updatedAt []byte
+		createdAt interface{}
+		updatedAt interface{}
+		deletedAt interface{}
 	)
 	u := model.User{}
-	row := da.Db.QueryRow(query.SelectUserByEmail, email)
+	row := da.queryRow(ctx, query.SelectUserByEmail, email)
 	err := row.Scan(
 		&u.Id,
 		&u.UserName,
@@ -148,133 +292,174 @@ func (da *DataAccess) GetUserByEmail(email string) (*model.User, error) {
 		&u.Following,
 		&createdAt,
 		&updatedAt,
-		&u.Active)
+		&u.Active,
+		&u.IsPrivate,
+		&deletedAt)
+	if err != nil {
+		return nil, err
+	}
+	u.CreatedAt, err = db.ParseTime(createdAt)
 	if err != nil {
 		return nil, err
 	}
-	u.CreatedAt, err = time.Parse(db.DateLayout, string(createdAt))
+	u.UpdatedAt, err = db.ParseTime(updatedAt)
 	if err != nil {
 		return nil, err
 	}
-	u.UpdatedAt, err = time.Parse(db.DateLayout, string(updatedAt))
+	u.DeletedAt, err = db.ParseTime(deletedAt)
 	if err != nil {
 		return nil, err
 	}
 	return &u, nil
 }
 
-func (da *DataAccess) SetUserAsActive(name string) error {
-	_, err := da.Db.Exec(query.UpdateUserActive, name)
+func (da *DataAccess) SetUserAsActive(ctx context.Context, name string) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.UpdateUserActive, name)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (da *DataAccess) SetNewPassword(user string, pass string) error {
-	_, err := da.Db.Exec(query.UpdatePassword, pass, user)
+func (da *DataAccess) SetNewPassword(ctx context.Context, user string, pass string) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.UpdatePassword, pass, user)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (da *DataAccess) DeleteUser(user string) error {
-	_, err := da.Db.Exec(query.DeleteUser, user)
+func (da *DataAccess) SetUserPrivacy(ctx context.Context, user string, is_private bool) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.UpdateUserPrivacy, is_private, user)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (da *DataAccess) GetUserFollows(follower_id int, followed_id int) (*model.Follows, error) {
-	f := model.Follows{}
-	row := da.Db.QueryRow(query.SelectUserFollows, follower_id, followed_id)
-	err := row.Scan(
-		&f.FollowerId,
-		&f.FollowedId,
-		&f.Status)
+func (da *DataAccess) SetUserEmail(ctx context.Context, user string, email string) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	_, err := da.exec(ctx, query.UpdateUserEmail, email, user)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return &f, nil
+	return nil
 }
 
-func (da *DataAccess) GetFollowers(followed_id int) (*[]model.Follows, error) {
-	follows := []model.Follows{}
-	rows, err := da.Db.Query(query.SelectUserFollowers, followed_id)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return &follows, nil
+// DeleteUser hides the user and their posts and comments until they're
+// restored or purged
+func (da *DataAccess) DeleteUser(ctx context.Context, user string) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.inTx(ctx, func(tx *DataAccess) error {
+		for _, q := range []string{query.HideUserPosts, query.HideUserComments} {
+			if _, err := tx.exec(ctx, q, user); err != nil {
+				return err
+			}
 		}
-		return nil, err
-	}
-	defer rows.Close()
-	for rows.Next() {
-		f := model.Follows{}
-		err = rows.Scan(
-			&f.FollowerId,
-			&f.FollowedId,
-			&f.Status,
-		)
+		if _, err := tx.exec(ctx, query.DeleteUser, time.Now().UTC().Format(db.DateLayout), user); err != nil {
+			return err
+		}
+		return tx.recountFollows(ctx, query.SelectUserFollowPartners, user, user, user)
+	})
+}
+
+func (da *DataAccess) RestoreUser(ctx context.Context, user string, since time.Time) error {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	return da.inTx(ctx, func(tx *DataAccess) error {
+		res, err := tx.exec(ctx, query.RestoreUser, user, since.UTC().Format(db.DateLayout))
 		if err != nil {
-			return nil, err
+			return err
 		}
-		if f.Status == 1 {
-			follows = append(follows, f)
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
 		}
-	}
-	return &follows, nil
+		if n == 0 {
+			return repo.ErrNotRestorable
+		}
+		for _, q := range []string{query.ShowUserPosts, query.ShowUserComments} {
+			if _, err := tx.exec(ctx, q, user); err != nil {
+				return err
+			}
+		}
+		return tx.recountFollows(ctx, query.SelectUserFollowPartners, user, user, user)
+	})
 }
 
-func (da *DataAccess) GetFollowing(follower_id int) (*[]model.Follows, error) {
-	follows := []model.Follows{}
-	rows, err := da.Db.Query(query.SelectUserFollowing, follower_id)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return &follows, nil
+// PurgeDeletedUsers deletes the accounts with everything they own, and
+// recounts the follows of the users they were linked to
+func (da *DataAccess) PurgeDeletedUsers(ctx context.Context, before time.Time) (int, error) {
+	ctx, cancel := da.withTimeout(ctx)
+	defer cancel()
+	cutoff := before.UTC().Format(db.DateLayout)
+	purged := 0
+	err := da.inTx(ctx, func(tx *DataAccess) error {
+		partners, err := tx.followPartners(ctx, query.SelectPurgedFollowPartners, cutoff, cutoff)
+		if err != nil {
+			return err
 		}
-		return nil, err
-	}
-	defer rows.Close()
-	for rows.Next() {
-		f := model.Follows{}
-		err = rows.Scan(
-			&f.FollowerId,
-			&f.FollowedId,
-			&f.Status,
-		)
+		res, err := tx.exec(ctx, query.PurgeDeletedUsers, cutoff)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		if f.Status == 1 {
-			follows = append(follows, f)
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
 		}
+		purged = int(n)
+		return tx.setFollowCounts(ctx, partners)
+	})
+	if err != nil {
+		return 0, err
 	}
-	return &follows, nil
+	return purged, nil
 }
 
-func (da *DataAccess) FollowUser(follower_id int, followed_id int) (sql.Result, error) {
-	res, err := da.Db.Exec(query.FollowUser, follower_id, followed_id)
+// recountFollows sets the follow counts of the users q selects, see
+// query.SelectFollowCounts
+func (da *DataAccess) recountFollows(ctx context.Context, q string, args ...interface{}) error {
+	ids, err := da.followPartners(ctx, q, args...)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return res, nil
+	return da.setFollowCounts(ctx, ids)
 }
 
-func (da *DataAccess) AcceptFollow(follower_id int, followed_id int) error {
-	_, err := da.Db.Exec(query.AcceptFollow, follower_id, followed_id)
+func (da *DataAccess) followPartners(ctx context.Context, q string, args ...interface{}) ([]int, error) {
+	rows, err := da.query(ctx, q, args...)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
-
+	defer rows.Close()
+	ids := []int{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
 }
 
-func (da *DataAccess) UnfollowUser(follower_id int, followed_id int) error {
-	_, err := da.Db.Exec(query.UnfollowUser, follower_id, followed_id)
-	if err != nil {
-		return err
+func (da *DataAccess) setFollowCounts(ctx context.Context, ids []int) error {
+	for _, id := range ids {
+		var followers, following int
+		if err := da.queryRow(ctx, query.SelectFollowCounts, id, id).Scan(&followers, &following); err != nil {
+			return err
+		}
+		if _, err := da.exec(ctx, query.UpdateFollowCounts, followers, following, id); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -0,0 +1,80 @@
+package orm_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/orm"
+)
+
+// followCounts returns the followers and following of name as stored
+func followCounts(t *testing.T, da *orm.DataAccess, name string) [2]int {
+	t.Helper()
+	u, err := da.GetUserByName(context.Background(), name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return [2]int{u.Followers, u.Following}
+}
+
+func TestFollowCounts(t *testing.T) {
+	ctx := context.Background()
+	da, conn := newDA(t)
+	ids := users(t, da, "ann", "bob", "cat")
+	ann, bob, cat := ids[0], ids[1], ids[2]
+	if _, err := da.CreatePost(ctx, &model.Post{GUID: "guid", AuthorId: bob, Title: "title", Active: 1, Visibility: model.VisibilityPublic}); err != nil {
+		t.Fatal(err)
+	}
+	if err := da.FollowUser(ctx, bob, ann, model.FollowAccepted); err != nil {
+		t.Fatal(err)
+	}
+	if err := da.FollowUser(ctx, cat, ann, model.FollowPending); err != nil {
+		t.Fatal(err)
+	}
+	if err := da.FollowUser(ctx, ann, bob, model.FollowAccepted); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(step string, want map[string][2]int) {
+		t.Helper()
+		for name, counts := range want {
+			if got := followCounts(t, da, name); got != counts {
+				t.Errorf("%s: %s has %d followers and follows %d, want %d and %d", step, name, got[0], got[1], counts[0], counts[1])
+			}
+		}
+	}
+	// the triggers count accepted follows only
+	check("pending", map[string][2]int{"ann": {1, 1}, "bob": {1, 1}, "cat": {0, 0}})
+	if err := da.AcceptFollow(ctx, cat, ann); err != nil {
+		t.Fatal(err)
+	}
+	check("accepted", map[string][2]int{"ann": {2, 1}, "cat": {0, 1}})
+
+	if err := da.DeleteUser(ctx, "bob"); err != nil {
+		t.Fatal(err)
+	}
+	check("deleted", map[string][2]int{"ann": {1, 0}, "cat": {0, 1}})
+	if err := da.RestoreUser(ctx, "bob", time.Now().Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	check("restored", map[string][2]int{"ann": {2, 1}, "bob": {1, 1}})
+
+	if err := da.DeleteUser(ctx, "bob"); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := da.PurgeDeletedUsers(ctx, time.Now().Add(-time.Minute)); err != nil || n != 0 {
+		t.Errorf("purging before the deletion removed %d users, %v, want none", n, err)
+	}
+	n, err := da.PurgeDeletedUsers(ctx, time.Now().Add(time.Minute))
+	if err != nil || n != 1 {
+		t.Fatalf("purge removed %d users, %v, want 1", n, err)
+	}
+	check("purged", map[string][2]int{"ann": {1, 0}, "cat": {0, 1}})
+	for table, want := range map[string]int{"users": 2, "follows": 1, "posts": 0} {
+		if got := count(t, conn, table); got != want {
+			t.Errorf("%d rows left in %s after the purge, want %d", got, table, want)
+		}
+	}
+}
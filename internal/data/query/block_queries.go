@@ -0,0 +1,59 @@
+package query
+
+const (
+	InsertBlock = `
+	INSERT INTO user_blocks (blocker_id, blocked_id)
+		VALUES (?, ?)
+	;`
+
+	SelectBlock = `
+	SELECT ` + blockColumns + ` FROM user_blocks
+		WHERE blocker_id=? AND blocked_id=?
+	;`
+
+	DeleteBlock = `
+	DELETE FROM user_blocks
+		WHERE blocker_id=? AND blocked_id=?
+	;`
+
+	DeleteFollowsBetween = `
+	DELETE FROM follows
+		WHERE (follower_id=? AND followed_id=?) OR (follower_id=? AND followed_id=?)
+	;`
+
+	InsertMute = `
+	INSERT INTO user_mutes (muter_id, muted_id)
+		VALUES (?, ?)
+	;`
+
+	SelectMute = `
+	SELECT ` + muteColumns + ` FROM user_mutes
+		WHERE muter_id=? AND muted_id=?
+	;`
+
+	DeleteMute = `
+	DELETE FROM user_mutes
+		WHERE muter_id=? AND muted_id=?
+	;`
+)
+
+// The queries of GetRelations take the user and an IN list from
+// Placeholders, the ones looking both ways take them twice
+const (
+	SelectFollowsBetween = `
+	SELECT ` + followColumns + ` FROM follows
+		WHERE (follower_id=? AND followed_id IN (%[1]s))
+			OR (followed_id=? AND follower_id IN (%[1]s))
+	;`
+
+	SelectBlocksBetween = `
+	SELECT ` + blockColumns + ` FROM user_blocks
+		WHERE (blocker_id=? AND blocked_id IN (%[1]s))
+			OR (blocked_id=? AND blocker_id IN (%[1]s))
+	;`
+
+	SelectMutesOf = `
+	SELECT ` + muteColumns + ` FROM user_mutes
+		WHERE muter_id=? AND muted_id IN (%s)
+	;`
+)
@@ -0,0 +1,37 @@
+package query
+
+import "strings"
+
+// Column lists in the order the orm scans them, so that adding a column to
+// a table never shifts the values read by existing queries
+const (
+	userColumns            = `id, username, email, hashpass, profile_pic, profile_pic_ext, followers, following, created_at, updated_at, active, is_private, deleted_at`
+	joinedUserColumns      = `u.id, u.username, u.email, u.hashpass, u.profile_pic, u.profile_pic_ext, u.followers, u.following, u.created_at, u.updated_at, u.active, u.is_private, u.deleted_at`
+	followColumns          = `follower_id, followed_id, follow_status`
+	closeFriendColumns     = `user_id, friend_id`
+	blockColumns           = `blocker_id, blocked_id`
+	muteColumns            = `muter_id, muted_id`
+	postColumns            = `id, post_guid, author_id, title, content, post_image, image_ext, created_at, updated_at, is_public, rating, active, visibility, edit_count, edited_at`
+	postRevisionColumns    = `post_id, revision, title, content, visibility, created_at`
+	postRatingColumns      = `post_id, user_id, rating_value`
+	commentColumns         = `id, post_guid, author_id, content, created_at, updated_at, rating, active, edit_count, edited_at`
+	commentRevisionColumns = `comment_id, revision, content, created_at`
+	commentRatingColumns   = `comment_id, user_id, rating_value`
+	conversationColumns    = `id, user1_id, user2_id, created_at, updated_at`
+	dmColumns              = `id, conversation_id, sender_id, content, is_read, created_at`
+	notificationColumns    = `id, user_id, from_user_id, notif_type, notif_message, resource_id, parent_id, is_read, created_at, updated_at`
+	tagColumns             = `id, tag_name, tag_type`
+	userTagColumns         = `id, tag_id, post_id, comment_id, tag_place`
+	referenceTagColumns    = `id, tag_id, post_id, comment_id, tag_place`
+	tokenColumns           = `id, token, user_id, created_at, updated_at`
+	sessionColumns         = `id, session_id, user_id, created_at, updated_at, active`
+)
+
+// deletedUserIds selects the accounts waiting to be purged, which lists
+// leave out
+const deletedUserIds = `SELECT id FROM users WHERE deleted_at IS NOT NULL`
+
+// Placeholders returns n comma separated placeholders for an IN list
+func Placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
@@ -2,62 +2,133 @@ package query
 
 const (
 	InsertComment = `
-	INSERT INTO comments
-		SET post_guid=?,
-			author_id=?,
-			content=?,
-			rating=?,
-			active=?
+	INSERT INTO comments (post_guid, author_id, content, rating, active)
+		VALUES (?, ?, ?, ?, ?)
 	;`
 
 	SelectCommentById = `
-	SELECT * FROM comments
+	SELECT ` + commentColumns + ` FROM comments
 		WHERE id=?
 	;`
 
+	// SelectActiveCommentsByPost takes the page after the post: 1 and the
+	// id of the last comment read, or 0 for the first page, and the page
+	// size
 	SelectActiveCommentsByPost = `
-	SELECT * FROM comments
-		WHERE post_guid=? AND active=1 ORDER BY rating DESC
+	SELECT ` + commentColumns + ` FROM comments
+		WHERE post_guid=? AND active=1
+			AND (? = 0 OR id > ?)
+		ORDER BY id
+		LIMIT ?
+	;`
+
+	// SelectUserThreadComments takes the user twice
+	SelectUserThreadComments = `
+	SELECT ` + commentColumns + ` FROM comments
+		WHERE active=1
+			AND (author_id=?
+				OR post_guid IN (SELECT post_guid FROM posts WHERE author_id=? AND active=1))
+		ORDER BY id
 	;`
 
 	UpdateCommentText = `
 	UPDATE comments
-		SET content=?
+		SET content=?,
+			edit_count=edit_count+1,
+			edited_at=CURRENT_TIMESTAMP,
+			updated_at=CURRENT_TIMESTAMP
 		WHERE id=?
 	;`
 
+	InsertCommentRevision = `
+	INSERT INTO comment_revisions (comment_id, revision, content, created_at)
+		VALUES (?, ?, ?, ?)
+	;`
+
+	SelectCommentRevisions = `
+	SELECT ` + commentRevisionColumns + ` FROM comment_revisions
+		WHERE comment_id=?
+		ORDER BY revision
+	;`
+
 	SetCommentAsInactive = `
 	UPDATE comments
-		SET active=0
+		SET active=0,
+			updated_at=CURRENT_TIMESTAMP
 		WHERE id=?
 	;`
 
-	RateCommentUp = `
-	INSERT INTO comment_ratings
-		SET comment_id=?,
-		user_id=?,
-		rating_value=1
+	SelectCommentUserRating = `
+	SELECT ` + commentRatingColumns + ` FROM comment_ratings
+		WHERE comment_id=? AND user_id=?
+	;`
+
+	// SelectCommentVotes is read like SelectPostVotes
+	SelectCommentVotes = `
+	SELECT comment_id,
+		SUM(CASE WHEN rating_value = 1 THEN 1 ELSE 0 END),
+		SUM(CASE WHEN rating_value = -1 THEN 1 ELSE 0 END),
+		SUM(CASE WHEN user_id = ? THEN rating_value ELSE 0 END)
+	FROM comment_ratings
+		WHERE comment_id IN (%s)
+		GROUP BY comment_id
+	;`
+)
+
+// Rating the same way twice takes the vote back
+var (
+	RateCommentUp = Upsert{
+		OnDuplicateKey: `
+	INSERT INTO comment_ratings (comment_id, user_id, rating_value)
+		VALUES (?, ?, 1)
 		ON DUPLICATE KEY UPDATE rating_value = CASE
-        	WHEN rating_value = 1
+			WHEN rating_value = 1
 				THEN 0
-        	ELSE 1
-    	END
-	;`
+			ELSE 1
+		END
+	;`,
+		OnConflict: `
+	INSERT INTO comment_ratings (comment_id, user_id, rating_value)
+		VALUES (?, ?, 1)
+		ON CONFLICT (comment_id, user_id) DO UPDATE SET rating_value = CASE
+			WHEN comment_ratings.rating_value = 1
+				THEN 0
+			ELSE 1
+		END
+	;`,
+	}
 
-	RateCommentDown = `
-	INSERT INTO comment_ratings
-		SET comment_id=?,
-		user_id=?,
-		rating_value=-1
+	RateCommentDown = Upsert{
+		OnDuplicateKey: `
+	INSERT INTO comment_ratings (comment_id, user_id, rating_value)
+		VALUES (?, ?, -1)
 		ON DUPLICATE KEY UPDATE rating_value = CASE
-        	WHEN rating_value = -1
+			WHEN rating_value = -1
 				THEN 0
-        	ELSE -1
-    	END
-	;`
+			ELSE -1
+		END
+	;`,
+		OnConflict: `
+	INSERT INTO comment_ratings (comment_id, user_id, rating_value)
+		VALUES (?, ?, -1)
+		ON CONFLICT (comment_id, user_id) DO UPDATE SET rating_value = CASE
+			WHEN comment_ratings.rating_value = -1
+				THEN 0
+			ELSE -1
+		END
+	;`,
+	}
 
-	SelectCommentUserRating = `
-	SELECT * FROM comment_ratings
-		WHERE comment_id=? AND user_id=?
-	;`
+	SetCommentRating = Upsert{
+		OnDuplicateKey: `
+	INSERT INTO comment_ratings (comment_id, user_id, rating_value)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE rating_value = VALUES(rating_value)
+	;`,
+		OnConflict: `
+	INSERT INTO comment_ratings (comment_id, user_id, rating_value)
+		VALUES (?, ?, ?)
+		ON CONFLICT (comment_id, user_id) DO UPDATE SET rating_value = excluded.rating_value
+	;`,
+	}
 )
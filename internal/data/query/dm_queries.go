@@ -2,50 +2,55 @@ package query
 
 const (
 	InsertConversation = `
-	INSERT INTO conversations
-		SET user1_id=?,
-			user2_id=?
+	INSERT INTO conversations (user1_id, user2_id)
+		VALUES (?, ?)
 	;`
 
 	InsertDMessage = `
-	INSERT INTO dmessages
-		SET conversation_id=?,
-			sender_id=?,
-			content=?,
-			is_read=FALSE
+	INSERT INTO dmessages (conversation_id, sender_id, content, is_read)
+		VALUES (?, ?, ?, FALSE)
 	;`
 
 	SelectConversationById = `
-	SELECT * FROM conversations
+	SELECT ` + conversationColumns + ` FROM conversations
 		WHERE id=?
 	;`
 
 	SelectConversationByUserIds = `
-	SELECT * FROM conversations
+	SELECT ` + conversationColumns + ` FROM conversations
 		WHERE user1_id=? AND user2_id=?
 	;`
 
+	// SelectConversationsByUserId and SelectDMsByConversationId take the
+	// page last: 1 and the time and id of the last row read, or 0 for the
+	// first page, and the page size
 	SelectConversationsByUserId = `
-	SELECT * FROM conversations
-		WHERE user1_id=? OR user2_id=?
-			ORDER BY updated_at DESC
+	SELECT ` + conversationColumns + ` FROM conversations
+		WHERE (user1_id=? OR user2_id=?)
+			AND user1_id NOT IN (` + deletedUserIds + `)
+			AND user2_id NOT IN (` + deletedUserIds + `)
+			AND (? = 0 OR created_at < ? OR (created_at = ? AND id < ?))
+			ORDER BY created_at DESC, id DESC
+			LIMIT ?
 	;`
 
 	SelectDMById = `
-	SELECT * FROM dmessages
+	SELECT ` + dmColumns + ` FROM dmessages
 		WHERE id=?
 	;`
 
 	SelectLastDMBySenderInConversation = `
-	SELECT * FROM dmessages
+	SELECT ` + dmColumns + ` FROM dmessages
 		WHERE conversation_id = ? AND sender_id = ?
 		ORDER BY created_at DESC
 		LIMIT 1;`
 
 	SelectDMsByConversationId = `
-	SELECT * FROM dmessages
+	SELECT ` + dmColumns + ` FROM dmessages
 		WHERE conversation_id=?
-			ORDER BY created_at
+			AND (? = 0 OR created_at > ? OR (created_at = ? AND id > ?))
+			ORDER BY created_at, id
+			LIMIT ?
 	;`
 
 	UpdateConversationById = `
@@ -2,38 +2,39 @@ package query
 
 const (
 	InsertNotification = `
-	INSERT INTO notifications
-		SET user_id=?,
-			from_user_id=?,
-			notif_type=?,
-			notif_message=?,
-			resource_id=?,
-			parent_id=?
+	INSERT INTO notifications (user_id, from_user_id, notif_type, notif_message, resource_id, parent_id)
+		VALUES (?, ?, ?, ?, ?, ?)
 	;`
 
 	SelectFollowNotification = `
-	SELECT * FROM notifications
+	SELECT ` + notificationColumns + ` FROM notifications
 		WHERE user_id=? AND from_user_id=? AND notif_type='follow_request'
 	;`
 
 	SelectNotificationById = `
-	SELECT * FROM notifications
+	SELECT ` + notificationColumns + ` FROM notifications
 		WHERE id=?
 	;`
 
 	SelectNotificationsByUser = `
-	SELECT * FROM notifications
-		WHERE user_id=?
+	SELECT ` + notificationColumns + ` FROM notifications
+		WHERE user_id=? AND from_user_id NOT IN (` + deletedUserIds + `)
 			ORDER BY created_at DESC
 			LIMIT ? OFFSET ?
 	;`
 
 	UpdateNotificationRead = `
 	UPDATE notifications
-		SET is_read=TRUE
+		SET is_read=TRUE,
+			updated_at=CURRENT_TIMESTAMP
 		WHERE id=?
 	;`
 
+	DeleteFollowNotification = `
+	DELETE FROM notifications
+		WHERE user_id=? AND from_user_id=? AND notif_type=?
+	;`
+
 	DeleteNotificationByID = `
 	DELETE FROM notifications
 		WHERE id=?
@@ -2,56 +2,91 @@ package query
 
 const (
 	InsertPost = `
-	INSERT INTO posts
-		SET post_guid=?,
-			author_id=?,
-			title=?,
-			content=?,
-			post_image=?,
-			image_ext=?,
-			is_public=?,
-			rating=?,
-			active=?
+	INSERT INTO posts (post_guid, author_id, title, content, post_image, image_ext, is_public, rating, active, visibility)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	;`
 
+	// SelectFeed takes the viewer seven times, then the page like
+	// SelectUserActivePosts
 	SelectFeed = `
-	SELECT p.* FROM posts p
-	LEFT JOIN follows f ON p.author_id = f.followed_id AND f.follower_id=?
-	WHERE (p.is_public = TRUE AND p.active=1) OR (f.follower_id=? AND f.follow_status = 1 AND p.active=1) OR (p.author_id=? AND p.active=1)
-	ORDER BY 
-		CASE 
-			WHEN p.created_at >= NOW() - INTERVAL 24 HOUR THEN 1 
-			ELSE 2 
-    	END ASC,
-		p.rating DESC,
-		p.created_at DESC
+	SELECT p.id, p.post_guid, p.author_id, p.title, p.content, p.post_image, p.image_ext,
+		p.created_at, p.updated_at, p.is_public, p.rating, p.active, p.visibility,
+		p.edit_count, p.edited_at
+	FROM posts p
+	LEFT JOIN follows f ON p.author_id = f.followed_id AND f.follower_id=? AND f.follow_status = 1
+	LEFT JOIN close_friends cf ON p.author_id = cf.user_id AND cf.friend_id=?
+	LEFT JOIN post_audience pa ON p.id = pa.post_id AND pa.user_id=?
+	WHERE p.active=1 AND (
+		p.author_id=?
+		OR p.visibility = 1
+		OR (p.visibility = 3 AND f.follower_id IS NOT NULL)
+		OR (p.visibility = 4 AND cf.friend_id IS NOT NULL)
+		OR (p.visibility = 5 AND pa.user_id IS NOT NULL)
+	)
+	AND NOT EXISTS (
+		SELECT 1 FROM user_blocks b
+			WHERE (b.blocker_id=? AND b.blocked_id=p.author_id) OR (b.blocker_id=p.author_id AND b.blocked_id=?)
+	)
+	AND NOT EXISTS (
+		SELECT 1 FROM user_mutes m
+			WHERE m.muter_id=? AND m.muted_id=p.author_id
+	)
+	AND (? = 0 OR p.created_at < ? OR (p.created_at = ? AND p.id < ?))
+	ORDER BY p.created_at DESC, p.id DESC
+	LIMIT ?
 	;`
 
 	SelectActivePosts = `
-	SELECT * FROM posts
+	SELECT ` + postColumns + ` FROM posts
 		WHERE active=1
 		ORDER BY created_at DESC
 	;`
 
+	// SelectUserActivePosts and the other post lists take the page after
+	// the author: 1 and the creation time and id of the last post read, or
+	// 0 for the first page, and the page size
 	SelectUserActivePosts = `
-	SELECT * FROM posts
+	SELECT ` + postColumns + ` FROM posts
 		WHERE author_id=? AND active=1
-		ORDER BY created_at DESC
+			AND (? = 0 OR created_at < ? OR (created_at = ? AND id < ?))
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
 	;`
 
 	SelectUserPublicPosts = `
-	SELECT * FROM posts
-		WHERE author_id=? AND is_public=TRUE AND active=1
-		ORDER BY created_at DESC
+	SELECT ` + postColumns + ` FROM posts
+		WHERE author_id=? AND visibility=1 AND active=1
+			AND (? = 0 OR created_at < ? OR (created_at = ? AND id < ?))
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
+	;`
+
+	SelectUserVisiblePosts = `
+	SELECT p.id, p.post_guid, p.author_id, p.title, p.content, p.post_image, p.image_ext,
+		p.created_at, p.updated_at, p.is_public, p.rating, p.active, p.visibility,
+		p.edit_count, p.edited_at
+	FROM posts p
+	LEFT JOIN follows f ON p.author_id = f.followed_id AND f.follower_id=? AND f.follow_status = 1
+	LEFT JOIN close_friends cf ON p.author_id = cf.user_id AND cf.friend_id=?
+	LEFT JOIN post_audience pa ON p.id = pa.post_id AND pa.user_id=?
+	WHERE p.author_id=? AND p.active=1 AND (
+		p.visibility = 1
+		OR (p.visibility = 3 AND f.follower_id IS NOT NULL)
+		OR (p.visibility = 4 AND cf.friend_id IS NOT NULL)
+		OR (p.visibility = 5 AND pa.user_id IS NOT NULL)
+	)
+	AND (? = 0 OR p.created_at < ? OR (p.created_at = ? AND p.id < ?))
+	ORDER BY p.created_at DESC, p.id DESC
+	LIMIT ?
 	;`
 
 	SelectPostByID = `
-	SELECT * FROM posts
+	SELECT ` + postColumns + ` FROM posts
 		WHERE id=?
 	;`
 
 	SelectPostByGUID = `
-	SELECT * FROM posts
+	SELECT ` + postColumns + ` FROM posts
 		WHERE post_guid=?
 	;`
 
@@ -59,42 +94,133 @@ const (
 	UPDATE posts
 		SET title=?,
 			content=?,
-			is_public=?
+			is_public=?,
+			visibility=?,
+			edit_count=edit_count+1,
+			edited_at=CURRENT_TIMESTAMP,
+			updated_at=CURRENT_TIMESTAMP
 		WHERE post_guid=?
 	;`
 
+	InsertPostRevision = `
+	INSERT INTO post_revisions (post_id, revision, title, content, visibility, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	;`
+
+	SelectPostRevisions = `
+	SELECT ` + postRevisionColumns + ` FROM post_revisions
+		WHERE post_id=?
+		ORDER BY revision
+	;`
+
+	SelectPostRevision = `
+	SELECT ` + postRevisionColumns + ` FROM post_revisions
+		WHERE post_id=? AND revision=?
+	;`
+
 	SetPostAsInactive = `
 	UPDATE posts
-		SET active=0
+		SET active=0,
+			updated_at=CURRENT_TIMESTAMP
 		WHERE post_guid=?
 	;`
 
-	RatePostUp = `
-	INSERT INTO post_ratings
-		SET post_id=?,
-		user_id=?,
-		rating_value=1
-		ON DUPLICATE KEY UPDATE rating_value = CASE
-        	WHEN rating_value = 1
-				THEN 0
-        	ELSE 1
-    	END
+	InsertPostAudience = `
+	INSERT INTO post_audience (post_id, user_id)
+		VALUES (?, ?)
 	;`
 
-	RatePostDown = `
-	INSERT INTO post_ratings
-		SET post_id=?,
-		user_id=?,
-		rating_value=-1
-		ON DUPLICATE KEY UPDATE rating_value = CASE
-        	WHEN rating_value = -1
-				THEN 0
-        	ELSE -1
-    	END
+	SelectPostAudienceMember = `
+	SELECT user_id FROM post_audience
+		WHERE post_id=? AND user_id=?
+	;`
+
+	DeletePostAudience = `
+	DELETE FROM post_audience
+		WHERE post_id=?
 	;`
 
 	SelectPostUserRating = `
-	SELECT * FROM post_ratings
+	SELECT ` + postRatingColumns + ` FROM post_ratings
 		WHERE post_id=? AND user_id=?
 	;`
+
+	// SelectPostVotes takes the user asking and then its IN list from
+	// Placeholders
+	SelectPostVotes = `
+	SELECT post_id,
+		SUM(CASE WHEN rating_value = 1 THEN 1 ELSE 0 END),
+		SUM(CASE WHEN rating_value = -1 THEN 1 ELSE 0 END),
+		SUM(CASE WHEN user_id = ? THEN rating_value ELSE 0 END)
+	FROM post_ratings
+		WHERE post_id IN (%s)
+		GROUP BY post_id
+	;`
+
+	SelectPostRatings = `
+	SELECT r.post_id, r.user_id, r.rating_value FROM post_ratings r
+	JOIN users u ON r.user_id = u.id
+		WHERE r.post_id=? AND r.rating_value <> 0 AND u.deleted_at IS NULL
+		ORDER BY r.user_id
+	;`
+)
+
+// Rating the same way twice takes the vote back
+var (
+	RatePostUp = Upsert{
+		OnDuplicateKey: `
+	INSERT INTO post_ratings (post_id, user_id, rating_value)
+		VALUES (?, ?, 1)
+		ON DUPLICATE KEY UPDATE rating_value = CASE
+			WHEN rating_value = 1
+				THEN 0
+			ELSE 1
+		END
+	;`,
+		OnConflict: `
+	INSERT INTO post_ratings (post_id, user_id, rating_value)
+		VALUES (?, ?, 1)
+		ON CONFLICT (post_id, user_id) DO UPDATE SET rating_value = CASE
+			WHEN post_ratings.rating_value = 1
+				THEN 0
+			ELSE 1
+		END
+	;`,
+	}
+
+	RatePostDown = Upsert{
+		OnDuplicateKey: `
+	INSERT INTO post_ratings (post_id, user_id, rating_value)
+		VALUES (?, ?, -1)
+		ON DUPLICATE KEY UPDATE rating_value = CASE
+			WHEN rating_value = -1
+				THEN 0
+			ELSE -1
+		END
+	;`,
+		OnConflict: `
+	INSERT INTO post_ratings (post_id, user_id, rating_value)
+		VALUES (?, ?, -1)
+		ON CONFLICT (post_id, user_id) DO UPDATE SET rating_value = CASE
+			WHEN post_ratings.rating_value = -1
+				THEN 0
+			ELSE -1
+		END
+	;`,
+	}
+
+	// SetPostRating stores the vote as given, so repeating it changes
+	// nothing
+	SetPostRating = Upsert{
+		OnDuplicateKey: `
+	INSERT INTO post_ratings (post_id, user_id, rating_value)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE rating_value = VALUES(rating_value)
+	;`,
+		OnConflict: `
+	INSERT INTO post_ratings (post_id, user_id, rating_value)
+		VALUES (?, ?, ?)
+		ON CONFLICT (post_id, user_id) DO UPDATE SET rating_value = excluded.rating_value
+	;`,
+	}
 )
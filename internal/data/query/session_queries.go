@@ -1,21 +1,28 @@
 package query
 
 const (
-	InsertSession = `
-	INSERT INTO sessions
-		SET session_id=?,
-			user_id=?,
-			active=?
-		ON DUPLICATE KEY UPDATE user_id=?, updated_at=CURRENT_TIMESTAMP
-	;`
-
 	SelectSessionById = `
-	SELECT * FROM sessions
+	SELECT ` + sessionColumns + ` FROM sessions
 		WHERE id=?
 	;`
 
 	SelectSessionBySessionId = `
-	SELECT * FROM sessions
+	SELECT ` + sessionColumns + ` FROM sessions
 		WHERE session_id=?
 	;`
 )
+
+var (
+	InsertSession = Upsert{
+		OnDuplicateKey: `
+	INSERT INTO sessions (session_id, user_id, active)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE user_id=VALUES(user_id), updated_at=CURRENT_TIMESTAMP
+	;`,
+		OnConflict: `
+	INSERT INTO sessions (session_id, user_id, active)
+		VALUES (?, ?, ?)
+		ON CONFLICT (session_id) DO UPDATE SET user_id=EXCLUDED.user_id, updated_at=CURRENT_TIMESTAMP
+	;`,
+	}
+)
@@ -2,36 +2,32 @@ package query
 
 const (
 	InsertTag = `
-	INSERT INTO tags
-		SET tag_name=?,
-			tag_type=?
+	INSERT INTO tags (tag_name, tag_type)
+		VALUES (?, ?)
 	;`
 
 	SelectTagByName = `
-	SELECT * FROM tags
+	SELECT ` + tagColumns + ` FROM tags
 		WHERE tag_name=?
 	;`
 
 	InsertUserTag = `
-	INSERT INTO user_tags
-		SET tag_id=?,
-			post_id=?,
-			comment_id=?,
-			tag_place=?
+	INSERT INTO user_tags (tag_id, post_id, comment_id, tag_place)
+		VALUES (?, ?, ?, ?)
 	;`
 
 	SelectUserTagById = `
-	SELECT * FROM user_tags
+	SELECT ` + userTagColumns + ` FROM user_tags
 		WHERE tag_id=?
 	;`
 
 	SelectUserTagsByPostId = `
-	SELECT * FROM user_tags
+	SELECT ` + userTagColumns + ` FROM user_tags
 		WHERE post_id=?
 	;`
 
 	SelectUserTagsByCommentId = `
-	SELECT * FROM user_tags
+	SELECT ` + userTagColumns + ` FROM user_tags
 		WHERE comment_id=?
 	;`
 
@@ -41,15 +37,12 @@ const (
 	;`
 
 	InsertReferenceTag = `
-	INSERT INTO reference_tags
-		SET tag_id=?,
-			post_id=?,
-			comment_id=?,
-			tag_place=?
+	INSERT INTO reference_tags (tag_id, post_id, comment_id, tag_place)
+		VALUES (?, ?, ?, ?)
 	;`
 
 	SelectReferenceTagById = `
-	SELECT * FROM reference_tags
+	SELECT ` + referenceTagColumns + ` FROM reference_tags
 		WHERE tag_id=?
 	;`
 
@@ -1,20 +1,28 @@
 package query
 
 const (
-	InsertToken = `
-	INSERT INTO tokens
-		SET token=?,
-			user_id=?
-		ON DUPLICATE KEY UPDATE token=?, updated_at=CURRENT_TIMESTAMP
-	;`
-
 	SelectTokenByUserId = `
-	SELECT * FROM tokens
+	SELECT ` + tokenColumns + ` FROM tokens
 		WHERE user_id=?
 	;`
 
 	DeleteTokenByUserId = `
-	SELECT * FROM tokens
+	DELETE FROM tokens
 		WHERE user_id=?
 	;`
 )
+
+var (
+	InsertToken = Upsert{
+		OnDuplicateKey: `
+	INSERT INTO tokens (token, user_id)
+		VALUES (?, ?)
+		ON DUPLICATE KEY UPDATE token=VALUES(token), updated_at=CURRENT_TIMESTAMP
+	;`,
+		OnConflict: `
+	INSERT INTO tokens (token, user_id)
+		VALUES (?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET token=EXCLUDED.token, updated_at=CURRENT_TIMESTAMP
+	;`,
+	}
+)
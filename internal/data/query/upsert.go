@@ -0,0 +1,9 @@
+package query
+
+// Upsert is an insert that updates the row it conflicts with instead of
+// failing. MySQL spells it ON DUPLICATE KEY UPDATE, PostgreSQL and SQLite
+// ON CONFLICT, both spellings take the same arguments.
+type Upsert struct {
+	OnDuplicateKey string
+	OnConflict     string
+}
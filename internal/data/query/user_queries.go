@@ -2,80 +2,303 @@ package query
 
 const (
 	InsertUser = `
-	INSERT INTO users
-		SET username=?,
-			email=?,
-			hashpass=?,
-			active=?
+	INSERT INTO users (username, email, hashpass, active, is_private)
+		VALUES (?, ?, ?, ?, ?)
 	;`
 
 	SelectUserById = `
-	SELECT * FROM users
-		WHERE id=?
+	SELECT ` + userColumns + ` FROM users
+		WHERE id=? AND deleted_at IS NULL
 	;`
 
 	SelectUserByName = `
-	SELECT * FROM users
-		WHERE username = ?
+	SELECT ` + userColumns + ` FROM users
+		WHERE username = ? AND deleted_at IS NULL
 	;`
 
+	SelectDeletedUserByName = `
+	SELECT ` + userColumns + ` FROM users
+		WHERE username = ? AND deleted_at IS NOT NULL
+	;`
+
+	// SelectSearchUsers takes three LIKE patterns escaped with '!', then
+	// the term and its prefix and substring patterns to order by, so the
+	// exact, prefix and substring matches come first, see orm.SearchUsers
 	SelectSearchUsers = `
-	SELECT * FROM users
-		WHERE username LIKE ? COLLATE utf8mb4_general_ci;
+	SELECT ` + userColumns + ` FROM users
+		WHERE (LOWER(username) LIKE ? ESCAPE '!'
+				OR LOWER(username) LIKE ? ESCAPE '!'
+				OR LOWER(username) LIKE ? ESCAPE '!')
+			AND active = 1 AND deleted_at IS NULL
+			AND id NOT IN (SELECT blocked_id FROM user_blocks WHERE blocker_id=?)
+			AND id NOT IN (SELECT blocker_id FROM user_blocks WHERE blocked_id=?)
+		ORDER BY CASE
+				WHEN LOWER(username) = ? THEN 0
+				WHEN LOWER(username) LIKE ? ESCAPE '!' THEN 1
+				WHEN LOWER(username) LIKE ? ESCAPE '!' THEN 2
+				ELSE 3
+			END,
+			followers DESC, id
+		LIMIT ?
+	;`
+
+	// SelectUsersByIDs takes its IN list from Placeholders
+	SelectUsersByIDs = `
+	SELECT ` + userColumns + ` FROM users
+		WHERE id IN (%s) AND deleted_at IS NULL
+		ORDER BY id
+	;`
+
+	// SelectUsersByNames takes its IN list from Placeholders
+	SelectUsersByNames = `
+	SELECT ` + userColumns + ` FROM users
+		WHERE username IN (%s) AND deleted_at IS NULL
+		ORDER BY id
 	;`
 
 	SelectUserByEmail = `
-	SELECT * FROM users
-		WHERE email = ?
+	SELECT ` + userColumns + ` FROM users
+		WHERE email = ? AND deleted_at IS NULL
 	;`
 
 	UpdateUserActive = `
 	UPDATE users
-		SET active=1
+		SET active=1,
+			updated_at=CURRENT_TIMESTAMP
 		WHERE username=?
 	;`
 
 	UpdatePassword = `
 	UPDATE users
-		SET hashpass = ?
+		SET hashpass = ?,
+			updated_at = CURRENT_TIMESTAMP
 		WHERE username = ?
 	;`
 
+	UpdateUserPrivacy = `
+	UPDATE users
+		SET is_private = ?,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE username = ?
+	;`
+
+	UpdateUserEmail = `
+	UPDATE users
+		SET email = ?,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE username = ?
+	;`
+
+	// DeleteUser takes the deletion time in UTC, like the times RestoreUser
+	// and PurgeDeletedUsers compare it with
 	DeleteUser = `
+	UPDATE users
+		SET deleted_at=?
+		WHERE username=? AND deleted_at IS NULL
+	;`
+
+	// RestoreUser takes the earliest deletion time that can be restored
+	RestoreUser = `
+	UPDATE users
+		SET deleted_at=NULL
+		WHERE username=? AND deleted_at >= ?
+	;`
+
+	// PurgeDeletedUsers takes the time accounts deleted before are purged,
+	// the foreign keys delete everything they own
+	PurgeDeletedUsers = `
 	DELETE FROM users
-		WHERE username=?
+		WHERE deleted_at IS NOT NULL AND deleted_at < ?
+	;`
+
+	// SelectPurgedFollowPartners takes the time of PurgeDeletedUsers twice,
+	// and returns the users following or followed by the accounts it purges
+	SelectPurgedFollowPartners = `
+	SELECT follower_id FROM follows
+		WHERE followed_id IN (SELECT id FROM users WHERE deleted_at IS NOT NULL AND deleted_at < ?)
+	UNION
+	SELECT followed_id FROM follows
+		WHERE follower_id IN (SELECT id FROM users WHERE deleted_at IS NOT NULL AND deleted_at < ?)
+	;`
+
+	// SelectUserFollowPartners takes the username three times, and returns
+	// the user and the users following or followed by them
+	SelectUserFollowPartners = `
+	SELECT id FROM users WHERE username=?
+	UNION
+	SELECT follower_id FROM follows
+		WHERE followed_id IN (SELECT id FROM users WHERE username=?)
+	UNION
+	SELECT followed_id FROM follows
+		WHERE follower_id IN (SELECT id FROM users WHERE username=?)
+	;`
+
+	// SelectFollowCounts takes the user twice and counts their accepted
+	// follows with users that aren't deleted. The follow triggers don't
+	// see a user being deleted, nor the cascade of a purge on MySQL, so
+	// UpdateFollowCounts stores these counts afterwards.
+	SelectFollowCounts = `
+	SELECT
+		(SELECT COUNT(*) FROM follows f JOIN users u ON u.id = f.follower_id
+			WHERE f.followed_id=? AND f.follow_status=1 AND u.deleted_at IS NULL),
+		(SELECT COUNT(*) FROM follows f JOIN users u ON u.id = f.followed_id
+			WHERE f.follower_id=? AND f.follow_status=1 AND u.deleted_at IS NULL)
+	;`
+
+	UpdateFollowCounts = `
+	UPDATE users
+		SET followers=?, following=?
+		WHERE id=?
+	;`
+
+	HideUserPosts = `
+	UPDATE posts
+		SET active=2
+		WHERE active=1 AND author_id IN (SELECT id FROM users WHERE username=?)
+	;`
+
+	HideUserComments = `
+	UPDATE comments
+		SET active=2
+		WHERE active=1 AND author_id IN (SELECT id FROM users WHERE username=?)
+	;`
+
+	ShowUserPosts = `
+	UPDATE posts
+		SET active=1
+		WHERE active=2 AND author_id IN (SELECT id FROM users WHERE username=?)
+	;`
+
+	ShowUserComments = `
+	UPDATE comments
+		SET active=1
+		WHERE active=2 AND author_id IN (SELECT id FROM users WHERE username=?)
 	;`
 
 	SelectUserFollows = `
-	SELECT * FROM follows
+	SELECT ` + followColumns + ` FROM follows
 		WHERE follower_id=? AND followed_id=?
 	;`
 
 	SelectUserFollowers = `
-	SELECT * FROM follows
+	SELECT ` + followColumns + ` FROM follows
 		WHERE followed_id=? AND follow_status=1
 	;`
 
 	SelectUserFollowing = `
-	SELECT * FROM follows
+	SELECT ` + followColumns + ` FROM follows
 		WHERE follower_id=? AND follow_status=1
 	;`
 
+	// SelectFollowerUsers and SelectFollowedUsers take the follow status,
+	// accepted for followers and following, pending for requests, then the
+	// id of the last user read, 0 for the first page, and the page size
+	SelectFollowerUsers = `
+	SELECT ` + joinedUserColumns + `
+	FROM users u
+	JOIN follows f ON u.id = f.follower_id
+		WHERE f.followed_id=? AND f.follow_status=? AND u.deleted_at IS NULL
+			AND u.id > ?
+		ORDER BY u.id
+		LIMIT ?
+	;`
+
+	SelectFollowedUsers = `
+	SELECT ` + joinedUserColumns + `
+	FROM users u
+	JOIN follows f ON u.id = f.followed_id
+		WHERE f.follower_id=? AND f.follow_status=? AND u.deleted_at IS NULL
+			AND u.id > ?
+		ORDER BY u.id
+		LIMIT ?
+	;`
+
+	SelectFollowSuggestions = `
+	SELECT f2.followed_id,
+		COUNT(DISTINCT f1.followed_id) AS mutual_count,
+		(SELECT MAX(p.created_at) FROM posts p WHERE p.author_id = f2.followed_id AND p.active=1) AS last_post_at
+	FROM follows f1
+	JOIN follows f2 ON f1.followed_id = f2.follower_id AND f2.follow_status = 1
+	JOIN users u ON f2.followed_id = u.id AND u.active = 1 AND u.deleted_at IS NULL
+	WHERE f1.follower_id=? AND f1.follow_status = 1
+		AND f2.followed_id <> ?
+		AND f2.followed_id NOT IN (SELECT followed_id FROM follows WHERE follower_id=?)
+		AND f2.followed_id NOT IN (SELECT blocked_id FROM user_blocks WHERE blocker_id=?)
+		AND f2.followed_id NOT IN (SELECT blocker_id FROM user_blocks WHERE blocked_id=?)
+	GROUP BY f2.followed_id
+	ORDER BY mutual_count DESC
+	LIMIT ?
+	;`
+
+	// SelectMutualCounts takes its IN list from Placeholders
+	SelectMutualCounts = `
+	SELECT f2.followed_id, COUNT(DISTINCT f1.followed_id)
+	FROM follows f1
+	JOIN follows f2 ON f1.followed_id = f2.follower_id AND f2.follow_status = 1
+	WHERE f1.follower_id=? AND f1.follow_status = 1
+		AND f2.followed_id IN (%s)
+	GROUP BY f2.followed_id
+	;`
+
 	FollowUser = `
-	INSERT INTO follows
-		SET follower_id=?,
-			followed_id=?,
-			follow_status=0
+	INSERT INTO follows (follower_id, followed_id, follow_status)
+		VALUES (?, ?, ?)
+	;`
+
+	InsertCloseFriend = `
+	INSERT INTO close_friends (user_id, friend_id)
+		VALUES (?, ?)
+	;`
+
+	SelectCloseFriend = `
+	SELECT ` + closeFriendColumns + ` FROM close_friends
+		WHERE user_id=? AND friend_id=?
+	;`
+
+	SelectCloseFriends = `
+	SELECT ` + joinedUserColumns + `
+	FROM users u
+	JOIN close_friends cf ON u.id = cf.friend_id
+		WHERE cf.user_id=? AND u.deleted_at IS NULL
+	;`
+
+	DeleteCloseFriend = `
+	DELETE FROM close_friends
+		WHERE user_id=? AND friend_id=?
+	;`
+
+	// SelectIncomingFollowRequests and SelectOutgoingFollowRequests take
+	// the id of the last user on the other end read, 0 for the first page,
+	// and the page size
+	SelectIncomingFollowRequests = `
+	SELECT ` + followColumns + ` FROM follows
+		WHERE followed_id=? AND follow_status=0
+			AND follower_id > ?
+		ORDER BY follower_id
+		LIMIT ?
+	;`
+
+	SelectOutgoingFollowRequests = `
+	SELECT ` + followColumns + ` FROM follows
+		WHERE follower_id=? AND follow_status=0
+			AND followed_id > ?
+		ORDER BY followed_id
+		LIMIT ?
 	;`
 
 	AcceptFollow = `
 	UPDATE follows
 		SET follow_status=1
-		WHERE follower_id=? AND followed_id=?
+		WHERE follower_id=? AND followed_id=? AND follow_status=0
+	;`
+
+	DeleteFollowRequest = `
+	DELETE FROM follows
+		WHERE follower_id=? AND followed_id=? AND follow_status=0
 	;`
 
 	UnfollowUser = `
 	DELETE FROM follows
-		WHERE follower_id=? AND followed_id=?
+		WHERE follower_id=? AND followed_id=? AND follow_status=1
 	;`
 )
@@ -0,0 +1,18 @@
+package repo
+
+import "context"
+
+type primaryKey struct{}
+
+// ReadPrimary returns a copy of ctx whose reads go to the primary database
+// rather than a read replica, for rows kept past the request, like the
+// ones a cache is filled with, which mustn't lag behind a write
+func ReadPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// ReadsPrimary reports whether ctx comes from ReadPrimary
+func ReadsPrimary(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryKey{}).(bool)
+	return primary
+}
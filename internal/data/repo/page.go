@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"math"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+)
+
+// Page selects a slice of a list for keyset pagination: up to Size rows
+// after the row After was taken from, in the order of the list. A zero
+// Page is the whole list.
+type Page struct {
+	Size  int
+	After *Key
+}
+
+// Limit is the number of rows to read, every row when Size isn't set
+func (p Page) Limit() int {
+	if p.Size <= 0 {
+		return math.MaxInt32
+	}
+	return p.Size
+}
+
+// Key holds the columns a list is ordered by for one of its rows, each
+// list only uses the ones it sorts on. They never change once the row is
+// written, so a row can't move past a page that was already read.
+type Key struct {
+	Time time.Time `json:"time"`
+	Id   int       `json:"id"`
+}
+
+// PostKey is the key of p in the feed and the post lists, newest first
+func PostKey(p model.Post) Key {
+	return Key{Time: p.CreatedAt, Id: p.Id}
+}
+
+// CommentKey is the key of c in the comments of a post, oldest first
+func CommentKey(c model.Comment) Key {
+	return Key{Id: c.Id}
+}
+
+// ConversationKey is the key of c in a user's conversations, the newest
+// first. Not by the last message, which would move a conversation in front
+// of a page already read
+func ConversationKey(c model.Conversation) Key {
+	return Key{Time: c.CreatedAt, Id: c.Id}
+}
+
+// DMKey is the key of d in a conversation, oldest first
+func DMKey(d model.DMessage) Key {
+	return Key{Time: d.CreatedAt, Id: d.Id}
+}
+
+// UserKey is the key of u in the user lists, ordered by id
+func UserKey(u model.User) Key {
+	return Key{Id: u.Id}
+}
+
+// FollowerKey is the key of f in the follows of a followed user, ordered
+// by follower
+func FollowerKey(f model.Follows) Key {
+	return Key{Id: f.FollowerId}
+}
+
+// FollowedKey is the key of f in the follows of a follower, ordered by the
+// user followed
+func FollowedKey(f model.Follows) Key {
+	return Key{Id: f.FollowedId}
+}
@@ -0,0 +1,253 @@
+// Package repo defines the storage interfaces the API depends on.
+//
+// Implementations must return sql.ErrNoRows when a single lookup finds
+// nothing, handlers rely on it to tell "missing" apart from real failures.
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+)
+
+var (
+	// ErrInvalidFollowTransition is returned when a follow operation does
+	// not apply to the current state of the follow, see Follows
+	ErrInvalidFollowTransition = errors.New("invalid follow transition")
+	// ErrDuplicate is returned when a write would repeat a unique key, like
+	// a username or email that is taken
+	ErrDuplicate = errors.New("already exists")
+	// ErrNotRestorable is returned by RestoreUser when the account isn't
+	// deleted, or was deleted too long ago
+	ErrNotRestorable = errors.New("account can't be restored")
+)
+
+type Users interface {
+	CreateUser(ctx context.Context, u *model.User) (int, error)
+	// GetUserByID and the other lookups and lists leave out deleted
+	// accounts
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
+	GetUserByName(ctx context.Context, name string) (*model.User, error)
+	// GetDeletedUserByName only finds the account if it's deleted
+	GetDeletedUserByName(ctx context.Context, name string) (*model.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	// SearchUsers returns up to limit active users viewer_id may see whose
+	// name holds term, starts with its first letter or ends with its last:
+	// the name that is term, then the ones starting with it, then the ones
+	// holding it, each the most followed first. It casts a wide net for the
+	// caller to rank
+	SearchUsers(ctx context.Context, term string, viewer_id int, limit int) (*[]model.User, error)
+	// GetUsersByIDs returns the users found among ids, ordered by id
+	GetUsersByIDs(ctx context.Context, ids []int) (*[]model.User, error)
+	// GetUsersByNames returns the users found among names, ordered by id
+	GetUsersByNames(ctx context.Context, names []string) (*[]model.User, error)
+	SetUserAsActive(ctx context.Context, name string) error
+	SetNewPassword(ctx context.Context, user string, pass string) error
+	SetUserPrivacy(ctx context.Context, user string, is_private bool) error
+	SetUserEmail(ctx context.Context, user string, email string) error
+	// DeleteUser hides the account with its posts and comments, and
+	// RestoreUser brings them back if it was deleted at or after since
+	DeleteUser(ctx context.Context, user string) error
+	RestoreUser(ctx context.Context, user string, since time.Time) error
+	// PurgeDeletedUsers deletes the accounts deleted before the given time
+	// for good, with everything they own, and returns how many it deleted
+	PurgeDeletedUsers(ctx context.Context, before time.Time) (int, error)
+}
+
+// Follows holds the social graph: follows, close friends, blocks and mutes.
+//
+// A follow goes through these transitions:
+//
+//	(none)   --FollowUser-->          pending | accepted
+//	pending  --AcceptFollow-->        accepted
+//	pending  --RejectFollow-->        (none)
+//	pending  --CancelFollowRequest--> (none)
+//	accepted --UnfollowUser-->        (none)
+//
+// Every transition only touches a follow in the expected state, anything
+// else returns ErrInvalidFollowTransition.
+type Follows interface {
+	GetUserFollows(ctx context.Context, follower_id int, followed_id int) (*model.Follows, error)
+	GetFollowers(ctx context.Context, followed_id int) (*[]model.Follows, error)
+	GetFollowing(ctx context.Context, follower_id int) (*[]model.Follows, error)
+	// GetIncomingFollowRequests and GetOutgoingFollowRequests are ordered
+	// by the id of the user on the other end, see FollowerKey and
+	// FollowedKey
+	GetIncomingFollowRequests(ctx context.Context, followed_id int, page Page) (*[]model.Follows, error)
+	GetOutgoingFollowRequests(ctx context.Context, follower_id int, page Page) (*[]model.Follows, error)
+	// GetFollowerUsers and the other *Users lists return the users on the
+	// other end of the follows above, ordered by id, see UserKey
+	GetFollowerUsers(ctx context.Context, followed_id int, page Page) (*[]model.User, error)
+	GetFollowingUsers(ctx context.Context, follower_id int, page Page) (*[]model.User, error)
+	GetIncomingRequestUsers(ctx context.Context, followed_id int, page Page) (*[]model.User, error)
+	GetOutgoingRequestUsers(ctx context.Context, follower_id int, page Page) (*[]model.User, error)
+	GetFollowSuggestions(ctx context.Context, user_id int, limit int) ([]*model.FollowSuggestion, error)
+	// GetMutualCounts returns how many of the people user_id follows follow
+	// each of ids, leaving out the ones with none
+	GetMutualCounts(ctx context.Context, user_id int, ids []int) (map[int]int, error)
+	FollowUser(ctx context.Context, follower_id int, followed_id int, status int) error
+	AcceptFollow(ctx context.Context, follower_id int, followed_id int) error
+	RejectFollow(ctx context.Context, follower_id int, followed_id int) error
+	CancelFollowRequest(ctx context.Context, follower_id int, followed_id int) error
+	UnfollowUser(ctx context.Context, follower_id int, followed_id int) error
+
+	AddCloseFriend(ctx context.Context, user_id int, friend_id int) error
+	RemoveCloseFriend(ctx context.Context, user_id int, friend_id int) error
+	IsCloseFriend(ctx context.Context, user_id int, friend_id int) (bool, error)
+	GetCloseFriends(ctx context.Context, user_id int) (*[]model.User, error)
+
+	// BlockUser also drops any follow or follow request between the two users
+	BlockUser(ctx context.Context, blocker_id int, blocked_id int) error
+	UnblockUser(ctx context.Context, blocker_id int, blocked_id int) error
+	IsBlocked(ctx context.Context, blocker_id int, blocked_id int) (bool, error)
+	MuteUser(ctx context.Context, muter_id int, muted_id int) error
+	UnmuteUser(ctx context.Context, muter_id int, muted_id int) error
+	IsMuted(ctx context.Context, muter_id int, muted_id int) (bool, error)
+	// GetRelations returns how user_id stands with each of other_ids, in
+	// the same few queries however many there are
+	GetRelations(ctx context.Context, user_id int, other_ids []int) (map[int]model.Relation, error)
+}
+
+type Posts interface {
+	CreatePost(ctx context.Context, p *model.Post) (int, error)
+	// GetFeed returns what user_id can see except unlisted posts and posts
+	// by users they muted or are blocked with, newest first, see PostKey
+	GetFeed(ctx context.Context, user_id int, page Page) (*[]model.Post, error)
+	GetPosts(ctx context.Context) (*[]model.Post, error)
+	// GetUserPosts and the other lists of an author's posts are newest
+	// first, see PostKey
+	GetUserPosts(ctx context.Context, user_id int, page Page) (*[]model.Post, error)
+	GetUserPublicPosts(ctx context.Context, user_id int, page Page) (*[]model.Post, error)
+	GetUserVisiblePosts(ctx context.Context, author_id int, viewer_id int, page Page) (*[]model.Post, error)
+	GetPostByGUID(ctx context.Context, guid string) (*model.Post, error)
+	GetPostByID(ctx context.Context, id int) (*model.Post, error)
+	// UpdatePost keeps the version it replaces as a revision
+	UpdatePost(ctx context.Context, post model.Post) error
+	// GetPostRevisions returns the replaced versions of a post, oldest
+	// first
+	GetPostRevisions(ctx context.Context, post_id int) (*[]model.PostRevision, error)
+	GetPostRevision(ctx context.Context, post_id int, revision int) (*model.PostRevision, error)
+	DisablePost(ctx context.Context, guid string) error
+	SetPostAudience(ctx context.Context, post_id int, user_ids []int) error
+	IsInPostAudience(ctx context.Context, post_id int, user_id int) (bool, error)
+}
+
+type Comments interface {
+	CreateComment(ctx context.Context, c *model.Comment) (int, error)
+	GetCommentById(ctx context.Context, id int) (*model.Comment, error)
+	// GetCommentsByPost returns the active comments on a post, see
+	// CommentKey
+	GetCommentsByPost(ctx context.Context, guid string, page Page) (*[]model.Comment, error)
+	// GetUserThreadComments returns the active comments user_id wrote or
+	// that are on their active posts, ordered by id
+	GetUserThreadComments(ctx context.Context, user_id int) (*[]model.Comment, error)
+	// UpdateCommentText keeps the version it replaces as a revision
+	UpdateCommentText(ctx context.Context, id int, text string) error
+	// GetCommentRevisions returns the replaced versions of a comment,
+	// oldest first
+	GetCommentRevisions(ctx context.Context, comment_id int) (*[]model.CommentRevision, error)
+	DisableComment(ctx context.Context, id int) error
+}
+
+// Ratings toggles votes: rating the same way twice removes the vote
+type Ratings interface {
+	RatePostUp(ctx context.Context, post_id int, user_id int) error
+	RatePostDown(ctx context.Context, post_id int, user_id int) error
+	// SetPostRating stores value, 1, -1 or 0, as the vote of user_id
+	// instead of toggling it like RatePostUp and RatePostDown, and returns
+	// the vote it replaced. Concurrent votes on a post are set one at a
+	// time, so each sees the vote the one before it stored
+	SetPostRating(ctx context.Context, post_id int, user_id int, value int) (previous int, err error)
+	GetPostUserRating(ctx context.Context, post_id int, user_id int) (*model.PostRatings, error)
+	// GetPostVotes returns the votes on each of post_ids as seen by
+	// user_id, leaving out the posts nobody voted on
+	GetPostVotes(ctx context.Context, user_id int, post_ids []int) (map[int]model.Votes, error)
+	// GetPostRatings returns the standing votes on a post by accounts that
+	// aren't deleted, ordered by user id
+	GetPostRatings(ctx context.Context, post_id int) (*[]model.PostRatings, error)
+	RateCommentUp(ctx context.Context, comment_id int, user_id int) error
+	RateCommentDown(ctx context.Context, comment_id int, user_id int) error
+	SetCommentRating(ctx context.Context, comment_id int, user_id int, value int) (previous int, err error)
+	GetCommentUserRating(ctx context.Context, comment_id int, user_id int) (*model.CommentRatings, error)
+	GetCommentVotes(ctx context.Context, user_id int, comment_ids []int) (map[int]model.Votes, error)
+}
+
+type Conversations interface {
+	CreateConversation(ctx context.Context, c *model.Conversation) (int, error)
+	GetConversationById(ctx context.Context, id int) (*model.Conversation, error)
+	GetConversationByUserIds(ctx context.Context, user1_id int, user2_id int) (*model.Conversation, error)
+	// GetConversationsByUserId leaves out conversations with deleted
+	// users and is newest first, see ConversationKey
+	GetConversationsByUserId(ctx context.Context, user_id int, page Page) ([]*model.Conversation, error)
+	UpdateConversationById(ctx context.Context, id int) error
+	CreateDMessage(ctx context.Context, d *model.DMessage) (int, error)
+	GetDMById(ctx context.Context, id int) (*model.DMessage, error)
+	GetLastDMBySenderInConversation(ctx context.Context, conversation_id int, sender_id int) (*model.DMessage, error)
+	// GetDMsByConversationId is oldest first, see DMKey
+	GetDMsByConversationId(ctx context.Context, conversation_id int, page Page) ([]*model.DMessage, error)
+	UpdateDMReadById(ctx context.Context, id int) error
+}
+
+type Notifications interface {
+	CreateNotification(ctx context.Context, n *model.Notification) (int, error)
+	GetFollowNotification(ctx context.Context, user_id int, from_user_id int) (*model.Notification, error)
+	GetNotificationById(ctx context.Context, id int) (*model.Notification, error)
+	GetNotificationsByUser(ctx context.Context, user_id int, limit int, offset int) ([]*model.Notification, error)
+	UpdateNotificationRead(ctx context.Context, id int) error
+	DeleteFollowNotification(ctx context.Context, user_id int, from_user_id int, notif_type string) error
+	DeleteNotificationByID(ctx context.Context, id int) error
+}
+
+type Tags interface {
+	CreateTag(ctx context.Context, t *model.Tag) error
+	GetTagByName(ctx context.Context, tag_name string) (*model.Tag, error)
+	CreateUserTag(ctx context.Context, t *model.UserTag) error
+	GetUserTagById(ctx context.Context, tag_id int) (*model.UserTag, error)
+	DeleteUserTagByID(ctx context.Context, id int) error
+	CreateReferenceTag(ctx context.Context, t *model.ReferenceTag) error
+	GetReferenceTagById(ctx context.Context, tag_id int) (*model.ReferenceTag, error)
+	DeleteReferenceTagByID(ctx context.Context, id int) error
+}
+
+type Tokens interface {
+	CreateToken(ctx context.Context, t *model.Token) error
+	GetTokenByUserId(ctx context.Context, id int) (*model.Token, error)
+	DeleteTokenByUserId(ctx context.Context, id int) error
+}
+
+type Sessions interface {
+	CreateSession(ctx context.Context, s *model.Session) error
+	GetSessionById(ctx context.Context, id int) (*model.Session, error)
+	GetSessionBySessionId(ctx context.Context, session_id string) (*model.Session, error)
+}
+
+// UnitOfWork runs f with repositories bound to one transaction, which
+// commits if f returns nil and rolls back otherwise. f runs again when the
+// database aborts the transaction to break a deadlock, so it must not have
+// effects outside the repositories it's given. InTx called on those
+// repositories joins the running transaction.
+type UnitOfWork interface {
+	InTx(ctx context.Context, f func(r Repositories) error) error
+}
+
+// Repositories bundles one implementation of every repository
+type Repositories struct {
+	Tx            UnitOfWork
+	Users         Users
+	Follows       Follows
+	Posts         Posts
+	Comments      Comments
+	Ratings       Ratings
+	Conversations Conversations
+	Notifications Notifications
+	Tags          Tags
+	Tokens        Tokens
+	Sessions      Sessions
+}
+
+// InTx runs f in a transaction, see UnitOfWork
+func (r Repositories) InTx(ctx context.Context, f func(r Repositories) error) error {
+	return r.Tx.InTx(ctx, f)
+}
@@ -0,0 +1,67 @@
+package endpoints
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+)
+
+func TestDeleteAndRestoreAccount(t *testing.T) {
+	f := newFixture(t)
+	f.users("ann", "bob")
+	f.follow("bob", "ann")
+	guid := f.post("ann", "hello", pb.Visibility_VISIBILITY_PUBLIC)
+	id := f.comment("bob", guid, "hi")
+
+	if _, err := f.s.DeleteUser(as("ann"), &pb.DeleteUserRequest{Username: "ann"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.s.GetUser(as("bob"), &pb.GetUserRequest{Username: "ann"}); reason(err) != "USER_NOT_FOUND" {
+		t.Errorf("GetUser of a deleted account returned %v, want USER_NOT_FOUND", err)
+	}
+	if got := f.feed("bob"); len(got) != 0 {
+		t.Errorf("feed of bob is %q, want the deleted account's posts gone", got)
+	}
+	bob, err := f.s.GetUser(as("bob"), &pb.GetUserRequest{Username: "bob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bob.UserFollowing != 0 {
+		t.Errorf("bob follows %d users, want the deleted account left out", bob.UserFollowing)
+	}
+
+	expired := NewApiService(f.repos, -time.Minute, nil)
+	if _, err := expired.RestoreAccount(context.Background(), &pb.RestoreAccountRequest{Username: "ann", Password: "password"}); reason(err) != "NOT_RESTORABLE" {
+		t.Errorf("RestoreAccount past the grace period returned %v, want NOT_RESTORABLE", err)
+	}
+	if _, err := f.s.RestoreAccount(context.Background(), &pb.RestoreAccountRequest{Username: "ann", Password: "wrong password"}); err == nil {
+		t.Error("RestoreAccount took a wrong password")
+	}
+	if _, err := f.s.RestoreAccount(context.Background(), &pb.RestoreAccountRequest{Username: "ann", Password: "password"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := f.feed("bob"); !slices.Equal(got, []string{"hello"}) {
+		t.Errorf("feed of bob is %q after the restore, want [hello]", got)
+	}
+	if _, err := f.s.GetComment(as("bob"), &pb.GetCommentRequest{Id: id}); err != nil {
+		t.Errorf("comment on the restored post: %v", err)
+	}
+	bob, err = f.s.GetUser(as("bob"), &pb.GetUserRequest{Username: "bob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bob.UserFollowing != 1 {
+		t.Errorf("bob follows %d users after the restore, want 1", bob.UserFollowing)
+	}
+	hits := newStream[pb.Post](as("bob"))
+	if err := f.s.SearchPosts(&pb.SearchPostsRequest{Query: "hello"}, hits); err != nil {
+		t.Fatal(err)
+	}
+	if len(hits.sent) != 1 {
+		t.Errorf("search found %d restored posts, want 1", len(hits.sent))
+	}
+}
@@ -4,59 +4,73 @@ import (
 	"context"
 	"database/sql"
 	"encoding/base64"
-	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/Anacardo89/lenic_api/internal/access"
+	"github.com/Anacardo89/lenic_api/internal/apperr"
 	"github.com/Anacardo89/lenic_api/internal/data/model"
-	"github.com/Anacardo89/lenic_api/internal/data/orm"
-	"github.com/Anacardo89/lenic_api/internal/pb"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"github.com/Anacardo89/lenic_api/internal/search"
 	"github.com/Anacardo89/lenic_api/pkg/auth"
 	"github.com/Anacardo89/lenic_api/pkg/logger"
 	"github.com/google/uuid"
-)
-
-var (
-	layout = "2006-01-02 15:04:05"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ApiService struct {
 	pb.UnimplementedLenicServer
+	repos       repo.Repositories
+	suggestions *suggestionsCache
+	// accountGrace is how long a deleted account can be restored
+	accountGrace time.Duration
+	search       *search.Index
+}
+
+func NewApiService(r repo.Repositories, accountGrace time.Duration, index *search.Index) *ApiService {
+	return &ApiService{
+		repos:        r,
+		suggestions:  newSuggestionsCache(),
+		accountGrace: accountGrace,
+		search:       index,
+	}
 }
 
 func (s *ApiService) Login(ctx context.Context, in *pb.LoginRequest) (*pb.LoginResponse, error) {
-	u, err := orm.Da.GetUserByName(in.Username)
+	u, err := s.repos.Users.GetUserByName(ctx, in.Username)
+	if err == sql.ErrNoRows {
+		logger.Error.Println("invalid credentials")
+		return nil, errInvalidCredentials
+	}
 	if err != nil {
 		logger.Error.Println("could not get user: ", err)
-		return nil, fmt.Errorf("could not get user: %v", err)
+		return nil, fmt.Errorf("could not get user: %w", err)
 	}
 
 	if !auth.CheckPasswordHash(in.Password, u.HashPass) {
 		logger.Error.Println("invalid credentials")
-		return nil, errors.New("invalid credentials")
+		return nil, errInvalidCredentials
 	}
 
-	following, err := orm.Da.GetFollowing(u.Id)
+	following, err := s.repos.Follows.GetFollowingUsers(ctx, u.Id, repo.Page{})
 	if err != nil {
 		logger.Error.Println("could not get following: ", err)
-		return nil, fmt.Errorf("could not get following: %v", err)
+		return nil, fmt.Errorf("could not get following: %w", err)
 	}
 
 	var followers []string
 
-	for _, f := range *following {
-		user, err := orm.Da.GetUserByID(f.FollowedId)
-		if err != nil {
-			logger.Error.Println("could not get user following: ", err)
-			return nil, fmt.Errorf("could not get user following: %v", err)
-		}
+	for _, user := range *following {
 		followers = append(followers, user.UserName)
 	}
 
 	token, err := auth.GenerateJWT(in.Username, followers)
 	if err != nil {
 		logger.Error.Println("could not create token: ", err)
-		return nil, fmt.Errorf("could not create token: %v", err)
+		return nil, fmt.Errorf("could not create token: %w", err)
 	}
 
 	res := &pb.LoginResponse{
@@ -68,164 +82,205 @@ func (s *ApiService) Login(ctx context.Context, in *pb.LoginRequest) (*pb.LoginR
 
 func (s *ApiService) CreateUser(ctx context.Context, in *pb.User) (*pb.CreateUserResponse, error) {
 
-	_, err := orm.Da.GetUserByName(in.Username)
-	if err != sql.ErrNoRows {
+	_, err := s.repos.Users.GetUserByName(ctx, in.Username)
+	if err == nil {
 		logger.Error.Println("user already exists")
-		return nil, errors.New("user already exists")
+		return nil, apperr.AlreadyExists("user")
 	}
-
-	_, err = orm.Da.GetUserByEmail(in.Email)
 	if err != sql.ErrNoRows {
+		logger.Error.Println("could not get user: ", err)
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+
+	_, err = s.repos.Users.GetUserByEmail(ctx, in.Email)
+	if err == nil {
 		logger.Error.Println("email already exists")
-		return nil, errors.New("email already exists")
+		return nil, apperr.AlreadyExists("email")
+	}
+	if err != sql.ErrNoRows {
+		logger.Error.Println("could not get user: ", err)
+		return nil, fmt.Errorf("could not get user: %w", err)
 	}
 
 	hashPass, err := auth.HashPassword(in.Pass)
 	if err != nil {
 		logger.Error.Println("could not hash password: ", err)
-		return nil, fmt.Errorf("could not hash password: %v", err)
+		return nil, fmt.Errorf("could not hash password: %w", err)
 	}
 
 	u := &model.User{
-		UserName: string(in.Username),
-		Email:    string(in.Email),
-		HashPass: hashPass,
-		Active:   1,
+		UserName:  string(in.Username),
+		Email:     string(in.Email),
+		HashPass:  hashPass,
+		Active:    1,
+		IsPrivate: in.IsPrivate,
 	}
 
-	res, err := orm.Da.CreateUser(u)
+	id, err := s.repos.Users.CreateUser(ctx, u)
 	if err != nil {
 		logger.Error.Println("error creating user: ", err)
-		return nil, fmt.Errorf("error creating user: %v", err)
-	}
-
-	id, err := res.LastInsertId()
-	if err != nil {
-		logger.Error.Println("error getting id: ", err)
-		return nil, fmt.Errorf("error getting id: %v", err)
+		return nil, fmt.Errorf("error creating user: %w", apperr.From(err, "user"))
 	}
 
 	resp := &pb.CreateUserResponse{
-		Id: int32(id),
+		Id: int64(id),
 	}
 
 	return resp, nil
 }
 
 func (s *ApiService) GetUser(ctx context.Context, in *pb.GetUserRequest) (*pb.User, error) {
-	u, err := orm.Da.GetUserByName(in.Username)
+	u, err := s.repos.Users.GetUserByName(ctx, in.Username)
 	if err != nil {
 		logger.Error.Println("could not get user: ", err)
-		return nil, fmt.Errorf("could not get user: %v", err)
+		return nil, fmt.Errorf("could not get user: %w", apperr.From(err, "user"))
 	}
 	user := pb.User{
-		Id:            int32(u.Id),
+		Id:            int64(u.Id),
 		Username:      u.UserName,
 		UserFollowers: int32(u.Followers),
 		UserFollowing: int32(u.Following),
-		CreatedAt:     u.CreatedAt.Format(layout),
-		UpdatedAt:     u.UpdatedAt.Format(layout),
+		CreatedAt:     timestamppb.New(u.CreatedAt),
+		UpdatedAt:     timestamppb.New(u.UpdatedAt),
 		Active:        int32(u.Active),
+		IsPrivate:     u.IsPrivate,
 	}
 	return &user, nil
 }
 
 func (s *ApiService) SearchUsers(in *pb.SearchUsersRequest, stream pb.Lenic_SearchUsersServer) error {
-	users, err := orm.Da.GetSearchUsers(in.Username)
+	ctx := stream.Context()
+	token, err := decodePageToken(in.PageToken)
+	if err != nil {
+		logger.Error.Println(err)
+		return err
+	}
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		logger.Error.Println("could not get caller: ", err)
+		return fmt.Errorf("could not get caller: %w", err)
+	}
+
+	candidates, err := s.repos.Users.SearchUsers(ctx, in.Username, caller.Id, userSearchCandidates)
 	if err != nil {
 		logger.Error.Println("could not get users: ", err)
-		return fmt.Errorf("could not get users: %v", err)
+		return fmt.Errorf("could not get users: %w", err)
 	}
-	for _, u := range *users {
+	ids := make([]int, 0, len(*candidates))
+	for _, u := range *candidates {
+		ids = append(ids, u.Id)
+	}
+	mutuals, err := s.repos.Follows.GetMutualCounts(ctx, caller.Id, ids)
+	if err != nil {
+		logger.Error.Println("could not get mutual follows: ", err)
+		return fmt.Errorf("could not get mutual follows: %w", err)
+	}
+
+	users := rankUsers(in.Username, *candidates, mutuals)
+	users = users[min(token.Offset, len(users)):]
+	if limit := userSearchLimit(in.PageSize); len(users) > limit {
+		users = users[:limit]
+		token.Offset += limit
+		stream.SetTrailer(metadata.Pairs(nextPageTokenKey, token.encode()))
+	}
+
+	for _, u := range users {
 		user := pb.User{
-			Id:            int32(u.Id),
+			Id:            int64(u.Id),
 			Username:      u.UserName,
 			UserFollowers: int32(u.Followers),
 			UserFollowing: int32(u.Following),
-			CreatedAt:     u.CreatedAt.Format(layout),
-			UpdatedAt:     u.UpdatedAt.Format(layout),
+			CreatedAt:     timestamppb.New(u.CreatedAt),
+			UpdatedAt:     timestamppb.New(u.UpdatedAt),
 			Active:        int32(u.Active),
+			IsPrivate:     u.IsPrivate,
 		}
 		err := stream.Send(&user)
 		if err != nil {
 			logger.Error.Println("error sending message to stream:  ", err)
-			return fmt.Errorf("error sending message to stream: %v", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
 		}
 	}
 	return nil
 }
 
 func (s *ApiService) GetUserFollowers(in *pb.GetUserFollowersRequest, stream pb.Lenic_GetUserFollowersServer) error {
+	ctx := stream.Context()
 
-	user, err := orm.Da.GetUserByName(in.Username)
+	user, err := s.repos.Users.GetUserByName(ctx, in.Username)
 	if err != nil {
 		logger.Error.Println("could not get user by name: ", err)
-		return fmt.Errorf("could not get user by name: %v", err)
+		return fmt.Errorf("could not get user by name: %w", apperr.From(err, "user"))
 	}
 
-	follows, err := orm.Da.GetFollowers(user.Id)
+	page, token, err := readPage(in.PageSize, in.PageToken)
+	if err != nil {
+		logger.Error.Println(err)
+		return err
+	}
+	users, err := s.repos.Follows.GetFollowerUsers(ctx, user.Id, page)
 	if err != nil {
 		logger.Error.Println("could not get users: ", err)
-		return fmt.Errorf("could not get users: %v", err)
+		return fmt.Errorf("could not get users: %w", err)
 	}
+	*users = nextPage(stream, *users, page, token, repo.UserKey)
 
-	for _, f := range *follows {
-		u, err := orm.Da.GetUserByID(f.FollowerId)
-		if err != nil {
-			logger.Error.Println("could not get Id from follower: ", err)
-			return fmt.Errorf("could not get Id from follower: %v", err)
-		}
+	for _, u := range *users {
 		u_out := pb.User{
-			Id:            int32(u.Id),
+			Id:            int64(u.Id),
 			Username:      u.UserName,
 			UserFollowers: int32(u.Followers),
 			UserFollowing: int32(u.Following),
-			CreatedAt:     u.CreatedAt.Format(layout),
-			UpdatedAt:     u.UpdatedAt.Format(layout),
+			CreatedAt:     timestamppb.New(u.CreatedAt),
+			UpdatedAt:     timestamppb.New(u.UpdatedAt),
 			Active:        int32(u.Active),
+			IsPrivate:     u.IsPrivate,
 		}
 		err = stream.Send(&u_out)
 		if err != nil {
 			logger.Error.Println("error sending message to stream: ", err)
-			return fmt.Errorf("error sending message to stream: %v", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
 		}
 	}
 	return nil
 }
 
 func (s *ApiService) GetUserFollowing(in *pb.GetUserFollowingRequest, stream pb.Lenic_GetUserFollowingServer) error {
+	ctx := stream.Context()
 
-	user, err := orm.Da.GetUserByName(in.Username)
+	user, err := s.repos.Users.GetUserByName(ctx, in.Username)
 	if err != nil {
 		logger.Error.Println("could not get user by name: ", err)
-		return fmt.Errorf("could not get user by name: %v", err)
+		return fmt.Errorf("could not get user by name: %w", apperr.From(err, "user"))
 	}
 
-	follows, err := orm.Da.GetFollowing(user.Id)
+	page, token, err := readPage(in.PageSize, in.PageToken)
+	if err != nil {
+		logger.Error.Println(err)
+		return err
+	}
+	users, err := s.repos.Follows.GetFollowingUsers(ctx, user.Id, page)
 	if err != nil {
 		logger.Error.Println("could not get users: ", err)
-		return fmt.Errorf("could not get users: %v", err)
+		return fmt.Errorf("could not get users: %w", err)
 	}
+	*users = nextPage(stream, *users, page, token, repo.UserKey)
 
-	for _, f := range *follows {
-		u, err := orm.Da.GetUserByID(f.FollowedId)
-		if err != nil {
-			logger.Error.Println("could not get Id from follower: ", err)
-			return fmt.Errorf("could not get Id from follower: %v", err)
-		}
+	for _, u := range *users {
 		u_out := pb.User{
-			Id:            int32(u.Id),
+			Id:            int64(u.Id),
 			Username:      u.UserName,
 			UserFollowers: int32(u.Followers),
 			UserFollowing: int32(u.Following),
-			CreatedAt:     u.CreatedAt.Format(layout),
-			UpdatedAt:     u.UpdatedAt.Format(layout),
+			CreatedAt:     timestamppb.New(u.CreatedAt),
+			UpdatedAt:     timestamppb.New(u.UpdatedAt),
 			Active:        int32(u.Active),
+			IsPrivate:     u.IsPrivate,
 		}
 		err = stream.Send(&u_out)
 		if err != nil {
 			logger.Error.Println("error sending message to stream: ", err)
-			return fmt.Errorf("error sending message to stream: %v", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
 		}
 	}
 	return nil
@@ -233,151 +288,463 @@ func (s *ApiService) GetUserFollowing(in *pb.GetUserFollowingRequest, stream pb.
 
 func (s *ApiService) FollowUser(ctx context.Context, in *pb.FollowUserRequest) (*pb.FollowUserResponse, error) {
 
-	res := &pb.FollowUserResponse{
-		Response: "NOK",
+	status := model.FollowAccepted
+	err := s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		followed, err := tx.Users.GetUserByID(ctx, int(in.FollowedId))
+		if err != nil {
+			return fmt.Errorf("error get user from db: %w", apperr.From(err, "user"))
+		}
+
+		// either one blocking the other keeps them apart
+		blocked, err := access.Blocked(ctx, tx, int(in.FollowedId), int(in.FollowerId))
+		if err != nil {
+			return fmt.Errorf("error checking block: %w", err)
+		}
+		if blocked {
+			return apperr.PermissionDenied("BLOCKED", "user is blocked")
+		}
+
+		status = model.FollowAccepted
+		if followed.IsPrivate {
+			status = model.FollowPending
+		}
+
+		err = tx.Follows.FollowUser(ctx, int(in.FollowerId), int(in.FollowedId), status)
+		if err != nil {
+			return fmt.Errorf("error following user: %w", apperr.From(err, "follow"))
+		}
+
+		dbuser, err := tx.Users.GetUserByID(ctx, int(in.FollowerId))
+		if err != nil {
+			return fmt.Errorf("error get user from db: %w", apperr.From(err, "user"))
+		}
+
+		encoded := base64.URLEncoding.EncodeToString([]byte(dbuser.UserName))
+
+		notif := model.Notification{
+			UserID:     int(in.FollowedId),
+			FromUserId: int(in.FollowerId),
+			NotifType:  "follow_request",
+			NotifMsg:   " has requested to follow you.",
+			ResourceId: encoded,
+			ParentId:   "",
+		}
+		if status == model.FollowAccepted {
+			notif.NotifType = "follow"
+			notif.NotifMsg = " started following you."
+		}
+
+		_, err = tx.Notifications.CreateNotification(ctx, &notif)
+		if err != nil {
+			return fmt.Errorf("error creating notif: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	_, err := orm.Da.FollowUser(int(in.FollowerId), int(in.FollowedId))
+	s.suggestions.invalidate(int(in.FollowerId))
+
+	return &pb.FollowUserResponse{Pending: status == model.FollowPending}, nil
+}
+
+func (s *ApiService) AcceptFollow(ctx context.Context, in *pb.AcceptFollowRequest) (*pb.AcceptFollowResponse, error) {
+
+	err := s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		err := tx.Follows.AcceptFollow(ctx, int(in.FollowerId), int(in.FollowedId))
+		if err != nil {
+			return fmt.Errorf("error accepting follow: %w", apperr.From(err, "follow"))
+		}
+
+		err = tx.Notifications.DeleteFollowNotification(ctx, int(in.FollowedId), int(in.FollowerId), "follow_request")
+		if err != nil {
+			return fmt.Errorf("error delete notif: %w", err)
+		}
+
+		dbuser, err := tx.Users.GetUserByID(ctx, int(in.FollowerId))
+		if err != nil {
+			return fmt.Errorf("error get user from db: %w", apperr.From(err, "user"))
+		}
+
+		encoded := base64.URLEncoding.EncodeToString([]byte(dbuser.UserName))
+
+		notif := model.Notification{
+			UserID:     int(in.FollowerId),
+			FromUserId: int(in.FollowedId),
+			NotifType:  "follow_accept",
+			NotifMsg:   " has accepted your follow request.",
+			ResourceId: encoded,
+			ParentId:   "",
+		}
+
+		_, err = tx.Notifications.CreateNotification(ctx, &notif)
+		if err != nil {
+			return fmt.Errorf("error creating notif: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error.Println("error following user: ", err)
-		return res, fmt.Errorf("error following user: %v", err)
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	dbuser, err := orm.Da.GetUserByID(int(in.FollowerId))
+	s.suggestions.invalidate(int(in.FollowerId))
+
+	return &pb.AcceptFollowResponse{}, nil
+}
+
+func (s *ApiService) RejectFollow(ctx context.Context, in *pb.RejectFollowRequest) (*pb.RejectFollowResponse, error) {
+
+	err := s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		err := tx.Follows.RejectFollow(ctx, int(in.FollowerId), int(in.FollowedId))
+		if err != nil {
+			return fmt.Errorf("error rejecting follow: %w", apperr.From(err, "follow"))
+		}
+
+		err = tx.Notifications.DeleteFollowNotification(ctx, int(in.FollowedId), int(in.FollowerId), "follow_request")
+		if err != nil {
+			return fmt.Errorf("error delete notif: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error.Println("error get user from db: ", err)
-		return res, fmt.Errorf("error get user from db: %v", err)
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	encoded := base64.URLEncoding.EncodeToString([]byte(dbuser.UserName))
+	s.suggestions.invalidate(int(in.FollowerId))
+
+	return &pb.RejectFollowResponse{}, nil
+}
+
+func (s *ApiService) CancelFollowRequest(ctx context.Context, in *pb.CancelFollowRequestRequest) (*pb.CancelFollowRequestResponse, error) {
+
+	err := s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		err := tx.Follows.CancelFollowRequest(ctx, int(in.FollowerId), int(in.FollowedId))
+		if err != nil {
+			return fmt.Errorf("error cancelling follow request: %w", apperr.From(err, "follow"))
+		}
 
-	notif := model.Notification{
-		UserID:     int(in.FollowedId),
-		FromUserId: int(in.FollowerId),
-		NotifType:  "follow_request",
-		NotifMsg:   " has requested to follow you.",
-		ResourceId: encoded,
-		ParentId:   "",
+		err = tx.Notifications.DeleteFollowNotification(ctx, int(in.FollowedId), int(in.FollowerId), "follow_request")
+		if err != nil {
+			return fmt.Errorf("error delete notif: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	_, err = orm.Da.CreateNotification(&notif)
+	s.suggestions.invalidate(int(in.FollowerId))
+
+	return &pb.CancelFollowRequestResponse{}, nil
+}
+
+func (s *ApiService) UnfollowUser(ctx context.Context, in *pb.UnfollowRequest) (*pb.UnfollowUserResponse, error) {
+
+	err := s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		err := tx.Follows.UnfollowUser(ctx, int(in.FollowerId), int(in.FollowedId))
+		if err != nil {
+			return fmt.Errorf("error unfollowing: %w", apperr.From(err, "follow"))
+		}
+
+		err = tx.Notifications.DeleteFollowNotification(ctx, int(in.FollowedId), int(in.FollowerId), "follow")
+		if err != nil {
+			return fmt.Errorf("error delete notif: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error.Println("error creating notif: ", err)
-		return res, fmt.Errorf("error creating notif: %v", err)
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	res.Response = "OK"
+	s.suggestions.invalidate(int(in.FollowerId))
 
-	return res, nil
+	return &pb.UnfollowUserResponse{}, nil
 }
 
-func (s *ApiService) AcceptFollow(ctx context.Context, in *pb.AcceptFollowRequest) (*pb.AcceptFollowResponse, error) {
+func (s *ApiService) ListIncomingFollowRequests(in *pb.ListFollowRequestsRequest, stream pb.Lenic_ListIncomingFollowRequestsServer) error {
+	ctx := stream.Context()
 
-	res := &pb.AcceptFollowResponse{
-		Response: "NOK",
+	user, err := s.repos.Users.GetUserByName(ctx, in.Username)
+	if err != nil {
+		logger.Error.Println("could not get user by name: ", err)
+		return fmt.Errorf("could not get user by name: %w", apperr.From(err, "user"))
 	}
 
-	err := orm.Da.AcceptFollow(int(in.FollowerId), int(in.FollowedId))
+	page, token, err := readPage(in.PageSize, in.PageToken)
+	if err != nil {
+		logger.Error.Println(err)
+		return err
+	}
+	users, err := s.repos.Follows.GetIncomingRequestUsers(ctx, user.Id, page)
 	if err != nil {
-		logger.Error.Println("error accepting follow: ", err)
-		return res, fmt.Errorf("error accepting follow: %v", err)
+		logger.Error.Println("could not get follow requests: ", err)
+		return fmt.Errorf("could not get follow requests: %w", err)
+	}
+	*users = nextPage(stream, *users, page, token, repo.UserKey)
+
+	for _, u := range *users {
+		u_out := pb.User{
+			Id:            int64(u.Id),
+			Username:      u.UserName,
+			UserFollowers: int32(u.Followers),
+			UserFollowing: int32(u.Following),
+			CreatedAt:     timestamppb.New(u.CreatedAt),
+			UpdatedAt:     timestamppb.New(u.UpdatedAt),
+			Active:        int32(u.Active),
+			IsPrivate:     u.IsPrivate,
+		}
+		err = stream.Send(&u_out)
+		if err != nil {
+			logger.Error.Println("error sending message to stream: ", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
+		}
 	}
+	return nil
+}
 
-	dbuser, err := orm.Da.GetUserByID(int(in.FollowerId))
+func (s *ApiService) ListOutgoingFollowRequests(in *pb.ListFollowRequestsRequest, stream pb.Lenic_ListOutgoingFollowRequestsServer) error {
+	ctx := stream.Context()
+
+	user, err := s.repos.Users.GetUserByName(ctx, in.Username)
 	if err != nil {
-		logger.Error.Println("error get user from db: ", err)
-		return res, fmt.Errorf("error get user from db: %v", err)
+		logger.Error.Println("could not get user by name: ", err)
+		return fmt.Errorf("could not get user by name: %w", apperr.From(err, "user"))
 	}
 
-	encoded := base64.URLEncoding.EncodeToString([]byte(dbuser.UserName))
+	page, token, err := readPage(in.PageSize, in.PageToken)
+	if err != nil {
+		logger.Error.Println(err)
+		return err
+	}
+	users, err := s.repos.Follows.GetOutgoingRequestUsers(ctx, user.Id, page)
+	if err != nil {
+		logger.Error.Println("could not get follow requests: ", err)
+		return fmt.Errorf("could not get follow requests: %w", err)
+	}
+	*users = nextPage(stream, *users, page, token, repo.UserKey)
 
-	notif := model.Notification{
-		UserID:     int(in.FollowerId),
-		FromUserId: int(in.FollowedId),
-		NotifType:  "follow_accept",
-		NotifMsg:   " has accepted your follow request.",
-		ResourceId: encoded,
-		ParentId:   "",
+	for _, u := range *users {
+		u_out := pb.User{
+			Id:            int64(u.Id),
+			Username:      u.UserName,
+			UserFollowers: int32(u.Followers),
+			UserFollowing: int32(u.Following),
+			CreatedAt:     timestamppb.New(u.CreatedAt),
+			UpdatedAt:     timestamppb.New(u.UpdatedAt),
+			Active:        int32(u.Active),
+			IsPrivate:     u.IsPrivate,
+		}
+		err = stream.Send(&u_out)
+		if err != nil {
+			logger.Error.Println("error sending message to stream: ", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
+		}
 	}
+	return nil
+}
+
+func (s *ApiService) UpdateUserPass(ctx context.Context, in *pb.User) (*pb.UpdateUserPassResponse, error) {
 
-	_, err = orm.Da.CreateNotification(&notif)
+	hash, err := auth.HashPassword(in.Pass)
 	if err != nil {
-		logger.Error.Println("error creating notif: ", err)
-		return res, fmt.Errorf("error creating notif: %v", err)
+		logger.Error.Println("could not hash password: ", err)
+		return nil, fmt.Errorf("could not hash password: %w", err)
 	}
 
-	res.Response = "OK"
+	err = s.repos.Users.SetNewPassword(ctx, in.Username, hash)
+	if err != nil {
+		logger.Error.Println("could not update password: ", err)
+		return nil, fmt.Errorf("could not update password: %w", err)
+	}
 
-	return res, nil
+	return &pb.UpdateUserPassResponse{}, nil
 }
 
-func (s *ApiService) UnfollowUser(ctx context.Context, in *pb.UnfollowRequest) (*pb.UnfollowUserResponse, error) {
+// UpdateUser writes the fields of the profile in the update mask and
+// returns the profile as it's now
+func (s *ApiService) UpdateUser(ctx context.Context, in *pb.UpdateUserRequest) (*pb.User, error) {
+	mask, err := readUpdateMask(in.UpdateMask, &pb.User{}, "email", "is_private", "pass")
+	if err != nil {
+		logger.Error.Println(err)
+		return nil, err
+	}
+	in_user := in.GetUser()
 
-	res := &pb.UnfollowUserResponse{
-		Response: "NOK",
+	hash := ""
+	if mask["pass"] {
+		hash, err = auth.HashPassword(in_user.Pass)
+		if err != nil {
+			logger.Error.Println("could not hash password: ", err)
+			return nil, fmt.Errorf("could not hash password: %w", err)
+		}
 	}
 
-	err := orm.Da.UnfollowUser(int(in.FollowerId), int(in.FollowedId))
+	err = s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		u, err := tx.Users.GetUserByName(ctx, in_user.GetUsername())
+		if err != nil {
+			return fmt.Errorf("could not get user: %w", apperr.From(err, "user"))
+		}
+		if mask["email"] && in_user.Email != u.Email {
+			err = tx.Users.SetUserEmail(ctx, u.UserName, in_user.Email)
+			if err != nil {
+				return fmt.Errorf("could not update email: %w", apperr.From(err, "email"))
+			}
+		}
+		if mask["is_private"] && in_user.IsPrivate != u.IsPrivate {
+			err = tx.Users.SetUserPrivacy(ctx, u.UserName, in_user.IsPrivate)
+			if err != nil {
+				return fmt.Errorf("could not update privacy: %w", err)
+			}
+		}
+		if mask["pass"] {
+			err = tx.Users.SetNewPassword(ctx, u.UserName, hash)
+			if err != nil {
+				return fmt.Errorf("could not update password: %w", err)
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error.Println("error unfollowing: ", err)
-		return res, fmt.Errorf("error unfollowing: %v", err)
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	notif, err := orm.Da.GetFollowNotification(int(in.FollowedId), int(in.FollowerId))
+	return s.GetUser(ctx, &pb.GetUserRequest{Username: in_user.GetUsername()})
+}
+
+func (s *ApiService) DeleteUser(ctx context.Context, in *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+
+	err := s.repos.Users.DeleteUser(ctx, in.Username)
 	if err != nil {
-		logger.Error.Println("error get notif: ", err)
-		return res, fmt.Errorf("error get notif: %v", err)
+		logger.Error.Println("could not delete user: ", err)
+		return nil, fmt.Errorf("could not delete user: %w", err)
 	}
 
-	err = orm.Da.DeleteNotificationByID(notif.Id)
+	return &pb.DeleteUserResponse{}, nil
+}
+
+func (s *ApiService) RestoreAccount(ctx context.Context, in *pb.RestoreAccountRequest) (*pb.RestoreAccountResponse, error) {
+
+	u, err := s.repos.Users.GetDeletedUserByName(ctx, in.Username)
+	if err == sql.ErrNoRows {
+		logger.Error.Println("invalid credentials")
+		return nil, errInvalidCredentials
+	}
 	if err != nil {
-		logger.Error.Println("error delete notif: ", err)
-		return res, fmt.Errorf("error delete notif: %v", err)
+		logger.Error.Println("could not get deleted user: ", err)
+		return nil, fmt.Errorf("could not get deleted user: %w", err)
+	}
+
+	if !auth.CheckPasswordHash(in.Password, u.HashPass) {
+		logger.Error.Println("invalid credentials")
+		return nil, errInvalidCredentials
 	}
 
-	res.Response = "OK"
+	err = s.repos.Users.RestoreUser(ctx, in.Username, time.Now().Add(-s.accountGrace))
+	if err != nil {
+		logger.Error.Println("could not restore user: ", err)
+		return nil, fmt.Errorf("could not restore user: %w", apperr.From(err, "user"))
+	}
 
-	return res, nil
+	return &pb.RestoreAccountResponse{}, nil
 }
 
-func (s *ApiService) UpdateUserPass(ctx context.Context, in *pb.User) (*pb.UpdateUserPassResponse, error) {
+func (s *ApiService) SetAccountPrivacy(ctx context.Context, in *pb.SetAccountPrivacyRequest) (*pb.SetAccountPrivacyResponse, error) {
 
-	res := &pb.UpdateUserPassResponse{
-		Response: "NOK",
+	err := s.repos.Users.SetUserPrivacy(ctx, in.Username, in.IsPrivate)
+	if err != nil {
+		logger.Error.Println("could not update privacy: ", err)
+		return nil, fmt.Errorf("could not update privacy: %w", err)
 	}
 
-	hash, err := auth.HashPassword(in.Pass)
+	return &pb.SetAccountPrivacyResponse{}, nil
+}
+
+func (s *ApiService) AddCloseFriend(ctx context.Context, in *pb.CloseFriendRequest) (*pb.CloseFriendResponse, error) {
+
+	u, err := s.repos.Users.GetUserByName(ctx, in.Username)
 	if err != nil {
-		logger.Error.Println("could not hash password: ", err)
-		return res, fmt.Errorf("could not hash password: %v", err)
+		logger.Error.Println("could not get user: ", err)
+		return nil, fmt.Errorf("could not get user: %w", apperr.From(err, "user"))
 	}
 
-	err = orm.Da.SetNewPassword(in.Username, hash)
+	friend, err := s.repos.Users.GetUserByName(ctx, in.FriendUsername)
 	if err != nil {
-		logger.Error.Println("could not update password: ", err)
-		return res, fmt.Errorf("could not update password: %v", err)
+		logger.Error.Println("could not get friend: ", err)
+		return nil, fmt.Errorf("could not get friend: %w", apperr.From(err, "user"))
 	}
 
-	res.Response = "OK"
+	err = s.repos.Follows.AddCloseFriend(ctx, u.Id, friend.Id)
+	if err != nil {
+		logger.Error.Println("could not add close friend: ", err)
+		return nil, fmt.Errorf("could not add close friend: %w", err)
+	}
 
-	return res, nil
+	return &pb.CloseFriendResponse{}, nil
 }
 
-func (s *ApiService) DeleteUser(ctx context.Context, in *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+func (s *ApiService) RemoveCloseFriend(ctx context.Context, in *pb.CloseFriendRequest) (*pb.CloseFriendResponse, error) {
 
-	res := &pb.DeleteUserResponse{
-		Response: "NOK",
+	u, err := s.repos.Users.GetUserByName(ctx, in.Username)
+	if err != nil {
+		logger.Error.Println("could not get user: ", err)
+		return nil, fmt.Errorf("could not get user: %w", apperr.From(err, "user"))
 	}
 
-	err := orm.Da.DeleteUser(in.Username)
+	friend, err := s.repos.Users.GetUserByName(ctx, in.FriendUsername)
 	if err != nil {
-		logger.Error.Println("could not delete user: ", err)
-		return res, fmt.Errorf("could not delete user: %v", err)
+		logger.Error.Println("could not get friend: ", err)
+		return nil, fmt.Errorf("could not get friend: %w", apperr.From(err, "user"))
 	}
 
-	res.Response = "OK"
+	err = s.repos.Follows.RemoveCloseFriend(ctx, u.Id, friend.Id)
+	if err != nil {
+		logger.Error.Println("could not remove close friend: ", err)
+		return nil, fmt.Errorf("could not remove close friend: %w", err)
+	}
 
-	return res, nil
+	return &pb.CloseFriendResponse{}, nil
+}
+
+func (s *ApiService) GetCloseFriends(in *pb.GetCloseFriendsRequest, stream pb.Lenic_GetCloseFriendsServer) error {
+	ctx := stream.Context()
+
+	u, err := s.repos.Users.GetUserByName(ctx, in.Username)
+	if err != nil {
+		logger.Error.Println("could not get user: ", err)
+		return fmt.Errorf("could not get user: %w", apperr.From(err, "user"))
+	}
+
+	friends, err := s.repos.Follows.GetCloseFriends(ctx, u.Id)
+	if err != nil {
+		logger.Error.Println("could not get close friends: ", err)
+		return fmt.Errorf("could not get close friends: %w", err)
+	}
+
+	for _, f := range *friends {
+		u_out := pb.User{
+			Id:            int64(f.Id),
+			Username:      f.UserName,
+			UserFollowers: int32(f.Followers),
+			UserFollowing: int32(f.Following),
+			CreatedAt:     timestamppb.New(f.CreatedAt),
+			UpdatedAt:     timestamppb.New(f.UpdatedAt),
+			Active:        int32(f.Active),
+			IsPrivate:     f.IsPrivate,
+		}
+		err = stream.Send(&u_out)
+		if err != nil {
+			logger.Error.Println("error sending message to stream: ", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
+		}
+	}
+	return nil
 }
 
 func (s *ApiService) StartConversation(ctx context.Context, in *pb.Conversation) (*pb.StartConversationResponse, error) {
@@ -387,19 +754,13 @@ func (s *ApiService) StartConversation(ctx context.Context, in *pb.Conversation)
 		User2Id: int(in.User2Id),
 	}
 
-	res, err := orm.Da.CreateConversation(&c)
+	convId, err := s.repos.Conversations.CreateConversation(ctx, &c)
 	if err != nil {
 		logger.Error.Println("could not create conversation: ", err)
-		return nil, fmt.Errorf("could not create conversation: %v", err)
+		return nil, fmt.Errorf("could not create conversation: %w", apperr.From(err, "conversation"))
 	}
 
-	id64, err := res.LastInsertId()
-	if err != nil {
-		logger.Error.Println("could not get get conversation id: ", err)
-		return nil, fmt.Errorf("could not get conversation id: %v", err)
-	}
-
-	id := int32(id64)
+	id := int64(convId)
 
 	resp := &pb.StartConversationResponse{
 		Id: id,
@@ -411,28 +772,36 @@ func (s *ApiService) StartConversation(ctx context.Context, in *pb.Conversation)
 // NEEDS LOGGING
 
 func (s *ApiService) GetUserConversations(in *pb.GetUserConversationsRequest, stream pb.Lenic_GetUserConversationsServer) error {
+	ctx := stream.Context()
 
-	u, err := orm.Da.GetUserByName(in.Username)
+	u, err := s.repos.Users.GetUserByName(ctx, in.Username)
 	if err != nil {
-		return fmt.Errorf("could not get user id: %v", err)
+		return fmt.Errorf("could not get user id: %w", apperr.From(err, "user"))
 	}
 
-	convos, err := orm.Da.GetConversationsByUserId(u.Id)
+	page, token, err := readPage(in.PageSize, in.PageToken)
+	if err != nil {
+		return err
+	}
+	convos, err := s.repos.Conversations.GetConversationsByUserId(ctx, u.Id, page)
 	if err != nil {
-		return fmt.Errorf("could not get user convos: %v", err)
+		return fmt.Errorf("could not get user convos: %w", err)
 	}
+	convos = nextPage(stream, convos, page, token, func(c *model.Conversation) repo.Key {
+		return repo.ConversationKey(*c)
+	})
 
 	for _, c := range convos {
 		convo := pb.Conversation{
-			Id:        int32(c.Id),
-			User1Id:   int32(c.User1Id),
-			User2Id:   int32(c.User2Id),
-			CreatedAt: c.CreatedAt.Format(layout),
-			UpdatedAt: c.UpdatedAt.Format(layout),
+			Id:        int64(c.Id),
+			User1Id:   int64(c.User1Id),
+			User2Id:   int64(c.User2Id),
+			CreatedAt: timestamppb.New(c.CreatedAt),
+			UpdatedAt: timestamppb.New(c.UpdatedAt),
 		}
 		err = stream.Send(&convo)
 		if err != nil {
-			return fmt.Errorf("error sending message to stream: %v", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
 		}
 	}
 	return nil
@@ -440,25 +809,19 @@ func (s *ApiService) GetUserConversations(in *pb.GetUserConversationsRequest, st
 
 func (s *ApiService) ReadConversation(ctx context.Context, in *pb.ReadConversationRequest) (*pb.ReadConversationResponse, error) {
 
-	res := &pb.ReadConversationResponse{
-		Response: "NOK",
-	}
-
-	dms, err := orm.Da.GetDMsByConversationId(int(in.Id))
+	dms, err := s.repos.Conversations.GetDMsByConversationId(ctx, int(in.Id), repo.Page{})
 	if err != nil {
-		return res, fmt.Errorf("could not gt dms: %v", err)
+		return nil, fmt.Errorf("could not gt dms: %w", err)
 	}
 
 	for _, dm := range dms {
-		err := orm.Da.UpdateDMReadById(dm.Id)
+		err := s.repos.Conversations.UpdateDMReadById(ctx, dm.Id)
 		if err != nil {
-			return res, fmt.Errorf("could not mark dm %v as read: %v", dm.Id, err)
+			return nil, fmt.Errorf("could not mark dm %v as read: %w", dm.Id, err)
 		}
 	}
 
-	res.Response = "OK"
-
-	return res, nil
+	return &pb.ReadConversationResponse{}, nil
 }
 
 func (s *ApiService) SendDM(ctx context.Context, in *pb.DM) (*pb.SendDMResponse, error) {
@@ -470,44 +833,47 @@ func (s *ApiService) SendDM(ctx context.Context, in *pb.DM) (*pb.SendDMResponse,
 		IsRead:         false,
 	}
 
-	res, err := orm.Da.CreateDMessage(&dm)
-	if err != nil {
-		return nil, fmt.Errorf("could not send DM: %v", err)
-	}
-
-	id64, err := res.LastInsertId()
-	if err != nil {
-		return nil, fmt.Errorf("could not get dm id: %v", err)
-	}
+	var dmId int
+	err := s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		var err error
+		dmId, err = tx.Conversations.CreateDMessage(ctx, &dm)
+		if err != nil {
+			return fmt.Errorf("could not send DM: %w", err)
+		}
 
-	conv, err := orm.Da.GetConversationById(int(in.ConversationId))
-	if err != nil {
-		return nil, fmt.Errorf("could not get conversation: %v", err)
-	}
+		conv, err := tx.Conversations.GetConversationById(ctx, int(in.ConversationId))
+		if err != nil {
+			return fmt.Errorf("could not get conversation: %w", apperr.From(err, "conversation"))
+		}
 
-	userid := conv.User1Id
-	if userid == int(in.SenderId) {
-		userid = conv.User2Id
-	}
+		userid := conv.User1Id
+		if userid == int(in.SenderId) {
+			userid = conv.User2Id
+		}
 
-	convo_id := strconv.Itoa(conv.Id)
+		convo_id := strconv.Itoa(conv.Id)
 
-	notif := model.Notification{
-		UserID:     userid,
-		FromUserId: int(in.SenderId),
-		NotifType:  "dm",
-		NotifMsg:   " sent you a message.",
-		ResourceId: convo_id,
-		ParentId:   "",
-	}
+		notif := model.Notification{
+			UserID:     userid,
+			FromUserId: int(in.SenderId),
+			NotifType:  "dm",
+			NotifMsg:   " sent you a message.",
+			ResourceId: convo_id,
+			ParentId:   "",
+		}
 
-	_, err = orm.Da.CreateNotification(&notif)
+		_, err = tx.Notifications.CreateNotification(ctx, &notif)
+		if err != nil {
+			return fmt.Errorf("error creating notif: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error.Println("error creating notif: ", err)
-		return nil, fmt.Errorf("error creating notif: %v", err)
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	id := int32(id64)
+	id := int64(dmId)
 
 	resp := &pb.SendDMResponse{
 		Id: id,
@@ -517,23 +883,31 @@ func (s *ApiService) SendDM(ctx context.Context, in *pb.DM) (*pb.SendDMResponse,
 }
 
 func (s *ApiService) GetConversationDMs(in *pb.GetConversationDMsRequest, stream pb.Lenic_GetConversationDMsServer) error {
-	dms, err := orm.Da.GetDMsByConversationId(int(in.Id))
+	ctx := stream.Context()
+	page, token, err := readPage(in.PageSize, in.PageToken)
+	if err != nil {
+		return err
+	}
+	dms, err := s.repos.Conversations.GetDMsByConversationId(ctx, int(in.Id), page)
 	if err != nil {
-		return fmt.Errorf("could not get DMs: %v", err)
+		return fmt.Errorf("could not get DMs: %w", err)
 	}
+	dms = nextPage(stream, dms, page, token, func(d *model.DMessage) repo.Key {
+		return repo.DMKey(*d)
+	})
 
 	for _, d := range dms {
 		dm := pb.DM{
-			Id:             int32(d.Id),
-			ConversationId: int32(d.ConversationId),
-			SenderId:       int32(d.SenderId),
+			Id:             int64(d.Id),
+			ConversationId: int64(d.ConversationId),
+			SenderId:       int64(d.SenderId),
 			Content:        d.Content,
 			IsRead:         d.IsRead,
-			CreatedAt:      d.CreatedAt.Format(layout),
+			CreatedAt:      timestamppb.New(d.CreatedAt),
 		}
 		err = stream.Send(&dm)
 		if err != nil {
-			return fmt.Errorf("error sending message to stream: %v", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
 		}
 	}
 	return nil
@@ -543,159 +917,244 @@ func (s *ApiService) CreatePost(ctx context.Context, in *pb.Post) (*pb.CreatePos
 
 	guid := uuid.New().String()
 	p := model.Post{
-		GUID:     guid,
-		AuthorId: int(in.AuthorId),
-		Title:    in.Title,
-		Content:  in.Content,
-		Image:    "",
-		ImageExt: "",
-		IsPublic: in.IsPublic,
-		Rating:   0,
-		Active:   1,
-	}
+		GUID:       guid,
+		AuthorId:   int(in.AuthorId),
+		Title:      in.Title,
+		Content:    in.Content,
+		Image:      "",
+		ImageExt:   "",
+		Rating:     0,
+		Active:     1,
+		Visibility: postVisibility(in.Visibility),
+	}
+
+	err := s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		id, err := tx.Posts.CreatePost(ctx, &p)
+		if err != nil {
+			return fmt.Errorf("could not create post: %w", err)
+		}
 
-	_, err := orm.Da.CreatePost(&p)
+		if p.Visibility == model.VisibilitySpecificUsers {
+			err = tx.Posts.SetPostAudience(ctx, id, audienceIds(in.AudienceIds))
+			if err != nil {
+				return fmt.Errorf("could not set post audience: %w", err)
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("could not create post: %v", err)
+		return nil, err
 	}
 
-	res := &pb.CreatePostResponse{
+	resp := &pb.CreatePostResponse{
 		Uuid: guid,
 	}
 
-	return res, nil
+	return resp, nil
 }
 
 func (s *ApiService) GetPost(ctx context.Context, in *pb.GetPostRequest) (*pb.Post, error) {
-	p, err := orm.Da.GetPostByGUID(in.Uuid)
+	p, err := s.repos.Posts.GetPostByGUID(ctx, in.Uuid)
 	if err != nil {
-		return nil, fmt.Errorf("could not get post: %v", err)
+		return nil, fmt.Errorf("could not get post: %w", apperr.From(err, "post"))
+	}
+
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get caller: %w", err)
+	}
+	votes, err := s.postVotes(ctx, caller.Id, []model.Post{*p})
+	if err != nil {
+		return nil, fmt.Errorf("could not get votes: %w", err)
 	}
 
 	active := false
-	if p.Active > 0 {
+	if p.Active == model.ContentActive {
 		active = true
 	}
 
 	post := pb.Post{
-		Id:        int32(p.Id),
-		PostGuid:  p.GUID,
-		AuthorId:  int32(p.AuthorId),
-		Title:     p.Title,
-		Content:   p.Content,
-		CreatedAt: p.CreatedAt.Format(layout),
-		UpdatedAt: p.UpdatedAt.Format(layout),
-		IsPublic:  p.IsPublic,
-		Rating:    int32(p.Rating),
-		Active:    active,
-	}
+		Id:         int64(p.Id),
+		PostGuid:   p.GUID,
+		AuthorId:   int64(p.AuthorId),
+		Title:      p.Title,
+		Content:    p.Content,
+		CreatedAt:  timestamppb.New(p.CreatedAt),
+		UpdatedAt:  timestamppb.New(p.UpdatedAt),
+		Rating:     int32(p.Rating),
+		Active:     active,
+		Visibility: pb.Visibility(p.Visibility),
+		EditCount:  int32(p.EditCount),
+		EditedAt:   optionalTime(p.EditedAt),
+	}
+	setPostVotes(&post, votes[p.Id])
 
 	return &post, nil
 }
 
 func (s *ApiService) GetUserPosts(in *pb.GetUserPostsRequest, stream pb.Lenic_GetUserPostsServer) error {
-	u, err := orm.Da.GetUserByName(in.Username)
+	ctx := stream.Context()
+	u, err := s.repos.Users.GetUserByName(ctx, in.Username)
+	if err != nil {
+		return fmt.Errorf("could not get user: %w", apperr.From(err, "user"))
+	}
+
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get caller: %w", err)
+	}
+
+	page, token, err := readPage(in.PageSize, in.PageToken)
+	if err != nil {
+		return err
+	}
+	var posts *[]model.Post
+	if caller.Id == u.Id {
+		posts, err = s.repos.Posts.GetUserPosts(ctx, u.Id, page)
+	} else {
+		posts, err = s.repos.Posts.GetUserVisiblePosts(ctx, u.Id, caller.Id, page)
+	}
 	if err != nil {
-		return fmt.Errorf("could not get user: %v", err)
+		return fmt.Errorf("could not get posts: %w", err)
 	}
+	*posts = nextPage(stream, *posts, page, token, repo.PostKey)
 
-	posts, err := orm.Da.GetUserPosts(u.Id)
+	votes, err := s.postVotes(ctx, caller.Id, *posts)
 	if err != nil {
-		return fmt.Errorf("could not get posts: %v", err)
+		return fmt.Errorf("could not get votes: %w", err)
 	}
 
 	for _, p := range *posts {
 		active := false
-		if p.Active > 0 {
+		if p.Active == model.ContentActive {
 			active = true
 		}
 		post := pb.Post{
-			Id:        int32(p.Id),
-			PostGuid:  p.GUID,
-			AuthorId:  int32(p.AuthorId),
-			Title:     p.Title,
-			Content:   p.Content,
-			CreatedAt: p.CreatedAt.Format(layout),
-			UpdatedAt: p.UpdatedAt.Format(layout),
-			IsPublic:  p.IsPublic,
-			Rating:    int32(p.Rating),
-			Active:    active,
+			Id:         int64(p.Id),
+			PostGuid:   p.GUID,
+			AuthorId:   int64(p.AuthorId),
+			Title:      p.Title,
+			Content:    p.Content,
+			CreatedAt:  timestamppb.New(p.CreatedAt),
+			UpdatedAt:  timestamppb.New(p.UpdatedAt),
+			Rating:     int32(p.Rating),
+			Active:     active,
+			Visibility: pb.Visibility(p.Visibility),
+			EditCount:  int32(p.EditCount),
+			EditedAt:   optionalTime(p.EditedAt),
 		}
+		setPostVotes(&post, votes[p.Id])
 		err = stream.Send(&post)
 		if err != nil {
-			return fmt.Errorf("error sending message to stream: %v", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
 		}
 	}
 	return nil
 }
 
 func (s *ApiService) GetUserPublicPosts(in *pb.GetUserPublicPostsRequest, stream pb.Lenic_GetUserPostsServer) error {
-	u, err := orm.Da.GetUserByName(in.Username)
+	ctx := stream.Context()
+	u, err := s.repos.Users.GetUserByName(ctx, in.Username)
 	if err != nil {
-		return fmt.Errorf("could not get user: %v", err)
+		return fmt.Errorf("could not get user: %w", apperr.From(err, "user"))
 	}
 
-	posts, err := orm.Da.GetUserPublicPosts(u.Id)
+	page, token, err := readPage(in.PageSize, in.PageToken)
+	if err != nil {
+		return err
+	}
+	posts, err := s.repos.Posts.GetUserPublicPosts(ctx, u.Id, page)
 	if err != nil {
-		return fmt.Errorf("could not get posts: %v", err)
+		return fmt.Errorf("could not get posts: %w", err)
+	}
+	*posts = nextPage(stream, *posts, page, token, repo.PostKey)
+
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get caller: %w", err)
+	}
+	votes, err := s.postVotes(ctx, caller.Id, *posts)
+	if err != nil {
+		return fmt.Errorf("could not get votes: %w", err)
 	}
 
 	for _, p := range *posts {
 		active := false
-		if p.Active > 0 {
+		if p.Active == model.ContentActive {
 			active = true
 		}
 		post := pb.Post{
-			Id:        int32(p.Id),
-			PostGuid:  p.GUID,
-			AuthorId:  int32(p.AuthorId),
-			Title:     p.Title,
-			Content:   p.Content,
-			CreatedAt: p.CreatedAt.Format(layout),
-			UpdatedAt: p.UpdatedAt.Format(layout),
-			IsPublic:  p.IsPublic,
-			Rating:    int32(p.Rating),
-			Active:    active,
+			Id:         int64(p.Id),
+			PostGuid:   p.GUID,
+			AuthorId:   int64(p.AuthorId),
+			Title:      p.Title,
+			Content:    p.Content,
+			CreatedAt:  timestamppb.New(p.CreatedAt),
+			UpdatedAt:  timestamppb.New(p.UpdatedAt),
+			Rating:     int32(p.Rating),
+			Active:     active,
+			Visibility: pb.Visibility(p.Visibility),
+			EditCount:  int32(p.EditCount),
+			EditedAt:   optionalTime(p.EditedAt),
 		}
+		setPostVotes(&post, votes[p.Id])
 		err = stream.Send(&post)
 		if err != nil {
-			return fmt.Errorf("error sending message to stream: %v", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
 		}
 	}
 	return nil
 }
 
 func (s *ApiService) GetFeed(in *pb.GetFeedRequest, stream pb.Lenic_GetFeedServer) error {
-	u, err := orm.Da.GetUserByName(in.Username)
+	ctx := stream.Context()
+	u, err := s.repos.Users.GetUserByName(ctx, in.Username)
 	if err != nil {
-		return fmt.Errorf("could not get user: %v", err)
+		return fmt.Errorf("could not get user: %w", apperr.From(err, "user"))
 	}
 
-	posts, err := orm.Da.GetFeed(u.Id)
+	page, token, err := readPage(in.PageSize, in.PageToken)
 	if err != nil {
-		return fmt.Errorf("could not get posts: %v", err)
+		return err
+	}
+	posts, err := s.repos.Posts.GetFeed(ctx, u.Id, page)
+	if err != nil {
+		return fmt.Errorf("could not get posts: %w", err)
+	}
+	*posts = nextPage(stream, *posts, page, token, repo.PostKey)
+
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get caller: %w", err)
+	}
+	votes, err := s.postVotes(ctx, caller.Id, *posts)
+	if err != nil {
+		return fmt.Errorf("could not get votes: %w", err)
 	}
 
 	for _, p := range *posts {
 		active := false
-		if p.Active > 0 {
+		if p.Active == model.ContentActive {
 			active = true
 		}
 		post := pb.Post{
-			Id:        int32(p.Id),
-			PostGuid:  p.GUID,
-			AuthorId:  int32(p.AuthorId),
-			Title:     p.Title,
-			Content:   p.Content,
-			CreatedAt: p.CreatedAt.Format(layout),
-			UpdatedAt: p.UpdatedAt.Format(layout),
-			IsPublic:  p.IsPublic,
-			Rating:    int32(p.Rating),
-			Active:    active,
+			Id:         int64(p.Id),
+			PostGuid:   p.GUID,
+			AuthorId:   int64(p.AuthorId),
+			Title:      p.Title,
+			Content:    p.Content,
+			CreatedAt:  timestamppb.New(p.CreatedAt),
+			UpdatedAt:  timestamppb.New(p.UpdatedAt),
+			Rating:     int32(p.Rating),
+			Active:     active,
+			Visibility: pb.Visibility(p.Visibility),
+			EditCount:  int32(p.EditCount),
+			EditedAt:   optionalTime(p.EditedAt),
 		}
+		setPostVotes(&post, votes[p.Id])
 		err = stream.Send(&post)
 		if err != nil {
-			return fmt.Errorf("error sending message to stream: %v", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
 		}
 	}
 	return nil
@@ -703,115 +1162,139 @@ func (s *ApiService) GetFeed(in *pb.GetFeedRequest, stream pb.Lenic_GetFeedServe
 
 func (s *ApiService) RatePostUp(ctx context.Context, in *pb.PostRating) (*pb.RatePostUpResponse, error) {
 
-	res := &pb.RatePostUpResponse{
-		Response: "NOK",
-	}
-
-	err := orm.Da.RatePostUp(int(in.PostId), int(in.UserId))
-	if err != nil {
-		return res, fmt.Errorf("could not rate post up: %v", err)
-	}
+	err := s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		err := tx.Ratings.RatePostUp(ctx, int(in.PostId), int(in.UserId))
+		if err != nil {
+			return fmt.Errorf("could not rate post up: %w", err)
+		}
 
-	post, err := orm.Da.GetPostByID(int(in.PostId))
-	if err != nil {
-		logger.Error.Println("error get post: ", err)
-		return nil, fmt.Errorf("error get post: %v", err)
-	}
+		post, err := tx.Posts.GetPostByID(ctx, int(in.PostId))
+		if err != nil {
+			return fmt.Errorf("error get post: %w", apperr.From(err, "post"))
+		}
 
-	notif := model.Notification{
-		UserID:     post.AuthorId,
-		FromUserId: int(in.UserId),
-		NotifType:  "rate_post",
-		NotifMsg:   " has rated your post.",
-		ResourceId: post.GUID,
-		ParentId:   "",
-	}
+		notif := model.Notification{
+			UserID:     post.AuthorId,
+			FromUserId: int(in.UserId),
+			NotifType:  "rate_post",
+			NotifMsg:   " has rated your post.",
+			ResourceId: post.GUID,
+			ParentId:   "",
+		}
 
-	_, err = orm.Da.CreateNotification(&notif)
+		_, err = tx.Notifications.CreateNotification(ctx, &notif)
+		if err != nil {
+			return fmt.Errorf("error creating notif: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error.Println("error creating notif: ", err)
-		return nil, fmt.Errorf("error creating notif: %v", err)
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	res.Response = "OK"
-
-	return res, nil
+	return &pb.RatePostUpResponse{}, nil
 }
 
 func (s *ApiService) RatePostDown(ctx context.Context, in *pb.PostRating) (*pb.RatePostDownResponse, error) {
 
-	res := &pb.RatePostDownResponse{
-		Response: "NOK",
-	}
-
-	err := orm.Da.RatePostDown(int(in.PostId), int(in.UserId))
-	if err != nil {
-		return res, fmt.Errorf("could not rate post down: %v", err)
-	}
+	err := s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		err := tx.Ratings.RatePostDown(ctx, int(in.PostId), int(in.UserId))
+		if err != nil {
+			return fmt.Errorf("could not rate post down: %w", err)
+		}
 
-	post, err := orm.Da.GetPostByID(int(in.PostId))
-	if err != nil {
-		logger.Error.Println("error get post: ", err)
-		return nil, fmt.Errorf("error get post: %v", err)
-	}
+		post, err := tx.Posts.GetPostByID(ctx, int(in.PostId))
+		if err != nil {
+			return fmt.Errorf("error get post: %w", apperr.From(err, "post"))
+		}
 
-	notif := model.Notification{
-		UserID:     post.AuthorId,
-		FromUserId: int(in.UserId),
-		NotifType:  "rate_post",
-		NotifMsg:   " has rated your post.",
-		ResourceId: post.GUID,
-		ParentId:   "",
-	}
+		notif := model.Notification{
+			UserID:     post.AuthorId,
+			FromUserId: int(in.UserId),
+			NotifType:  "rate_post",
+			NotifMsg:   " has rated your post.",
+			ResourceId: post.GUID,
+			ParentId:   "",
+		}
 
-	_, err = orm.Da.CreateNotification(&notif)
+		_, err = tx.Notifications.CreateNotification(ctx, &notif)
+		if err != nil {
+			return fmt.Errorf("error creating notif: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error.Println("error creating notif: ", err)
-		return nil, fmt.Errorf("error creating notif: %v", err)
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	res.Response = "OK"
-
-	return res, nil
+	return &pb.RatePostDownResponse{}, nil
 }
 
-func (s *ApiService) UpdatePost(ctx context.Context, in *pb.Post) (*pb.UpdatePostResponse, error) {
+// UpdatePost writes the fields of the post in the update mask. The audience
+// is only replaced when audience_ids is in it, and emptied when the post
+// stops being for specific users
+func (s *ApiService) UpdatePost(ctx context.Context, in *pb.UpdatePostRequest) (*pb.UpdatePostResponse, error) {
 
-	res := &pb.UpdatePostResponse{
-		Response: "NOK",
+	mask, err := readUpdateMask(in.UpdateMask, &pb.Post{}, "title", "content", "visibility", "audience_ids")
+	if err != nil {
+		logger.Error.Println(err)
+		return nil, err
 	}
+	in_post := in.GetPost()
 
-	p := model.Post{
-		GUID:     in.PostGuid,
-		Title:    in.Title,
-		Content:  in.Content,
-		IsPublic: in.IsPublic,
-	}
+	err = s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		p, err := tx.Posts.GetPostByGUID(ctx, in_post.GetPostGuid())
+		if err != nil {
+			return fmt.Errorf("could not get post: %w", apperr.From(err, "post"))
+		}
+		if mask["title"] {
+			p.Title = in_post.Title
+		}
+		if mask["content"] {
+			p.Content = in_post.Content
+		}
+		if mask["visibility"] {
+			p.Visibility = postVisibility(in_post.Visibility)
+		}
+
+		err = tx.Posts.UpdatePost(ctx, *p)
+		if err != nil {
+			return fmt.Errorf("could not update post: %w", err)
+		}
 
-	err := orm.Da.UpdatePost(p)
+		if !mask["visibility"] && !mask["audience_ids"] {
+			return nil
+		}
+		if p.Visibility == model.VisibilitySpecificUsers && !mask["audience_ids"] {
+			return nil
+		}
+		audience := []int{}
+		if p.Visibility == model.VisibilitySpecificUsers {
+			audience = audienceIds(in_post.AudienceIds)
+		}
+		err = tx.Posts.SetPostAudience(ctx, p.Id, audience)
+		if err != nil {
+			return fmt.Errorf("could not set post audience: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return res, fmt.Errorf("could not update post: %v", err)
+		return nil, err
 	}
 
-	res.Response = "OK"
-
-	return res, nil
+	return &pb.UpdatePostResponse{}, nil
 }
 
 func (s *ApiService) DeletePost(ctx context.Context, in *pb.DeletePostRequest) (*pb.DeletePostResponse, error) {
 
-	res := &pb.DeletePostResponse{
-		Response: "NOK",
-	}
-
-	err := orm.Da.DisablePost(in.Uuid)
+	err := s.repos.Posts.DisablePost(ctx, in.Uuid)
 	if err != nil {
-		return nil, fmt.Errorf("could not delete post: %v", err)
+		return nil, fmt.Errorf("could not delete post: %w", err)
 	}
 
-	res.Response = "OK"
-
-	return res, nil
+	return &pb.DeletePostResponse{}, nil
 }
 
 func (s *ApiService) CreateComment(ctx context.Context, in *pb.Comment) (*pb.CreateCommentResponse, error) {
@@ -824,40 +1307,42 @@ func (s *ApiService) CreateComment(ctx context.Context, in *pb.Comment) (*pb.Cre
 		Active:   1,
 	}
 
-	res, err := orm.Da.CreateComment(&c)
-	if err != nil {
-		return nil, fmt.Errorf("could not create comment: %v", err)
-	}
-
-	id64, err := res.LastInsertId()
-	if err != nil {
-		return nil, fmt.Errorf("could not get conversation id: %v", err)
-	}
+	var commentId int
+	err := s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		var err error
+		commentId, err = tx.Comments.CreateComment(ctx, &c)
+		if err != nil {
+			return fmt.Errorf("could not create comment: %w", err)
+		}
 
-	post, err := orm.Da.GetPostByGUID(in.PostGuid)
-	if err != nil {
-		logger.Error.Println("error get post: ", err)
-		return nil, fmt.Errorf("error get post: %v", err)
-	}
+		post, err := tx.Posts.GetPostByGUID(ctx, in.PostGuid)
+		if err != nil {
+			return fmt.Errorf("error get post: %w", apperr.From(err, "post"))
+		}
 
-	commentid := strconv.Itoa(int(id64))
+		commentid := strconv.Itoa(commentId)
 
-	notif := model.Notification{
-		UserID:     post.AuthorId,
-		FromUserId: int(in.AuthorId),
-		NotifType:  "comment_on_post",
-		NotifMsg:   " has commented on your post",
-		ResourceId: commentid,
-		ParentId:   post.GUID,
-	}
+		notif := model.Notification{
+			UserID:     post.AuthorId,
+			FromUserId: int(in.AuthorId),
+			NotifType:  "comment_on_post",
+			NotifMsg:   " has commented on your post",
+			ResourceId: commentid,
+			ParentId:   post.GUID,
+		}
 
-	_, err = orm.Da.CreateNotification(&notif)
+		_, err = tx.Notifications.CreateNotification(ctx, &notif)
+		if err != nil {
+			return fmt.Errorf("error creating notif: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error.Println("error creating notif: ", err)
-		return nil, fmt.Errorf("error creating notif: %v", err)
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	id := int32(id64)
+	id := int64(commentId)
 
 	resp := &pb.CreateCommentResponse{
 		Id: id,
@@ -867,53 +1352,86 @@ func (s *ApiService) CreateComment(ctx context.Context, in *pb.Comment) (*pb.Cre
 }
 
 func (s *ApiService) GetComment(ctx context.Context, in *pb.GetCommentRequest) (*pb.Comment, error) {
-	c, err := orm.Da.GetCommentById(int(in.Id))
+	c, err := s.repos.Comments.GetCommentById(ctx, int(in.Id))
 	if err != nil {
-		return nil, fmt.Errorf("could not get comment: %v", err)
+		return nil, fmt.Errorf("could not get comment: %w", apperr.From(err, "comment"))
+	}
+	if c.Active == model.ContentAuthorDeleted {
+		return nil, fmt.Errorf("could not get comment: %w", sql.ErrNoRows)
+	}
+
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get caller: %w", err)
+	}
+	votes, err := s.commentVotes(ctx, caller.Id, []model.Comment{*c})
+	if err != nil {
+		return nil, fmt.Errorf("could not get votes: %w", err)
 	}
 
 	active := false
-	if c.Active > 0 {
+	if c.Active == model.ContentActive {
 		active = true
 	}
 	comment := pb.Comment{
-		Id:        int32(c.Id),
+		Id:        int64(c.Id),
 		PostGuid:  c.PostGUID,
-		AuthorId:  int32(c.AuthorId),
+		AuthorId:  int64(c.AuthorId),
 		Content:   c.Content,
-		CreatedAt: c.CreatedAt.Format(layout),
-		UpdatedAt: c.UpdatedAt.Format(layout),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
 		Rating:    int32(c.Rating),
 		Active:    active,
+		Edited:    c.EditCount > 0,
+		EditCount: int32(c.EditCount),
 	}
+	setCommentVotes(&comment, votes[c.Id])
 
 	return &comment, nil
 }
 
 func (s *ApiService) GetCommentsFromPost(in *pb.GetCommentsFromPostRequest, stream pb.Lenic_GetCommentsFromPostServer) error {
-	comments, err := orm.Da.GetCommentsByPost(in.Uuid)
+	ctx := stream.Context()
+	page, token, err := readPage(in.PageSize, in.PageToken)
+	if err != nil {
+		return err
+	}
+	comments, err := s.repos.Comments.GetCommentsByPost(ctx, in.Uuid, page)
+	if err != nil {
+		return fmt.Errorf("could not get commentss: %w", err)
+	}
+	*comments = nextPage(stream, *comments, page, token, repo.CommentKey)
+
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get caller: %w", err)
+	}
+	votes, err := s.commentVotes(ctx, caller.Id, *comments)
 	if err != nil {
-		return fmt.Errorf("could not get commentss: %v", err)
+		return fmt.Errorf("could not get votes: %w", err)
 	}
 
 	for _, c := range *comments {
 		active := false
-		if c.Active > 0 {
+		if c.Active == model.ContentActive {
 			active = true
 		}
 		comment := pb.Comment{
-			Id:        int32(c.Id),
+			Id:        int64(c.Id),
 			PostGuid:  c.PostGUID,
-			AuthorId:  int32(c.AuthorId),
+			AuthorId:  int64(c.AuthorId),
 			Content:   c.Content,
-			CreatedAt: c.CreatedAt.Format(layout),
-			UpdatedAt: c.UpdatedAt.Format(layout),
+			CreatedAt: timestamppb.New(c.CreatedAt),
+			UpdatedAt: timestamppb.New(c.UpdatedAt),
 			Rating:    int32(c.Rating),
 			Active:    active,
+			Edited:    c.EditCount > 0,
+			EditCount: int32(c.EditCount),
 		}
+		setCommentVotes(&comment, votes[c.Id])
 		err = stream.Send(&comment)
 		if err != nil {
-			return fmt.Errorf("error sending message to stream: %v", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
 		}
 	}
 	return nil
@@ -921,110 +1439,102 @@ func (s *ApiService) GetCommentsFromPost(in *pb.GetCommentsFromPostRequest, stre
 
 func (s *ApiService) RateCommentUp(ctx context.Context, in *pb.CommentRating) (*pb.RateCommentUpResponse, error) {
 
-	res := &pb.RateCommentUpResponse{
-		Response: "NOK",
-	}
-
-	err := orm.Da.RateCommentUp(int(in.CommentId), int(in.UserId))
-	if err != nil {
-		return res, fmt.Errorf("could not rate comment up: %v", err)
-	}
+	err := s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		err := tx.Ratings.RateCommentUp(ctx, int(in.CommentId), int(in.UserId))
+		if err != nil {
+			return fmt.Errorf("could not rate comment up: %w", err)
+		}
 
-	comment, err := orm.Da.GetCommentById(int(in.CommentId))
-	if err != nil {
-		logger.Error.Println("error get comment: ", err)
-		return nil, fmt.Errorf("error get comment: %v", err)
-	}
+		comment, err := tx.Comments.GetCommentById(ctx, int(in.CommentId))
+		if err != nil {
+			return fmt.Errorf("error get comment: %w", apperr.From(err, "comment"))
+		}
 
-	commentid := strconv.Itoa(comment.Id)
+		commentid := strconv.Itoa(comment.Id)
 
-	notif := model.Notification{
-		UserID:     comment.AuthorId,
-		FromUserId: int(in.UserId),
-		NotifType:  "rate_comment",
-		NotifMsg:   " has rated your comment.",
-		ResourceId: commentid,
-		ParentId:   comment.PostGUID,
-	}
+		notif := model.Notification{
+			UserID:     comment.AuthorId,
+			FromUserId: int(in.UserId),
+			NotifType:  "rate_comment",
+			NotifMsg:   " has rated your comment.",
+			ResourceId: commentid,
+			ParentId:   comment.PostGUID,
+		}
 
-	_, err = orm.Da.CreateNotification(&notif)
+		_, err = tx.Notifications.CreateNotification(ctx, &notif)
+		if err != nil {
+			return fmt.Errorf("error creating notif: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error.Println("error creating notif: ", err)
-		return nil, fmt.Errorf("error creating notif: %v", err)
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	res.Response = "OK"
-
-	return res, nil
+	return &pb.RateCommentUpResponse{}, nil
 }
 
 func (s *ApiService) RateCommentDown(ctx context.Context, in *pb.CommentRating) (*pb.RateCommentDownResponse, error) {
 
-	res := &pb.RateCommentDownResponse{
-		Response: "NOK",
-	}
-
-	err := orm.Da.RateCommentDown(int(in.CommentId), int(in.UserId))
-	if err != nil {
-		return res, fmt.Errorf("could not rate comment down: %v", err)
-	}
+	err := s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		err := tx.Ratings.RateCommentDown(ctx, int(in.CommentId), int(in.UserId))
+		if err != nil {
+			return fmt.Errorf("could not rate comment down: %w", err)
+		}
 
-	comment, err := orm.Da.GetCommentById(int(in.CommentId))
-	if err != nil {
-		logger.Error.Println("error get comment: ", err)
-		return nil, fmt.Errorf("error get comment: %v", err)
-	}
+		comment, err := tx.Comments.GetCommentById(ctx, int(in.CommentId))
+		if err != nil {
+			return fmt.Errorf("error get comment: %w", apperr.From(err, "comment"))
+		}
 
-	commentid := strconv.Itoa(comment.Id)
+		commentid := strconv.Itoa(comment.Id)
 
-	notif := model.Notification{
-		UserID:     comment.AuthorId,
-		FromUserId: int(in.UserId),
-		NotifType:  "rate_comment",
-		NotifMsg:   " has rated your comment.",
-		ResourceId: commentid,
-		ParentId:   comment.PostGUID,
-	}
+		notif := model.Notification{
+			UserID:     comment.AuthorId,
+			FromUserId: int(in.UserId),
+			NotifType:  "rate_comment",
+			NotifMsg:   " has rated your comment.",
+			ResourceId: commentid,
+			ParentId:   comment.PostGUID,
+		}
 
-	_, err = orm.Da.CreateNotification(&notif)
+		_, err = tx.Notifications.CreateNotification(ctx, &notif)
+		if err != nil {
+			return fmt.Errorf("error creating notif: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error.Println("error creating notif: ", err)
-		return nil, fmt.Errorf("error creating notif: %v", err)
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	res.Response = "OK"
-
-	return res, nil
+	return &pb.RateCommentDownResponse{}, nil
 }
 
-func (s *ApiService) UpdateComment(ctx context.Context, in *pb.Comment) (*pb.UpdateCommentResponse, error) {
+func (s *ApiService) UpdateComment(ctx context.Context, in *pb.UpdateCommentRequest) (*pb.UpdateCommentResponse, error) {
 
-	res := &pb.UpdateCommentResponse{
-		Response: "NOK",
+	_, err := readUpdateMask(in.UpdateMask, &pb.Comment{}, "content")
+	if err != nil {
+		logger.Error.Println(err)
+		return nil, err
 	}
 
-	err := orm.Da.UpdateCommentText(int(in.Id), in.Content)
+	err = s.repos.Comments.UpdateCommentText(ctx, int(in.GetComment().GetId()), in.GetComment().GetContent())
 	if err != nil {
-		return res, fmt.Errorf("could not update comment: %v", err)
+		return nil, fmt.Errorf("could not update comment: %w", err)
 	}
 
-	res.Response = "OK"
-
-	return res, nil
+	return &pb.UpdateCommentResponse{}, nil
 }
 
 func (s *ApiService) DeleteComment(ctx context.Context, in *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
 
-	res := &pb.DeleteCommentResponse{
-		Response: "NOK",
-	}
-
-	err := orm.Da.DisableComment(int(in.Id))
+	err := s.repos.Comments.DisableComment(ctx, int(in.Id))
 	if err != nil {
-		return nil, fmt.Errorf("could not delete comment: %v", err)
+		return nil, fmt.Errorf("could not delete comment: %w", err)
 	}
 
-	res.Response = "OK"
-
-	return res, nil
+	return &pb.DeleteCommentResponse{}, nil
 }
@@ -0,0 +1,188 @@
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/apperr"
+	"github.com/Anacardo89/lenic_api/internal/data/memory"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"github.com/Anacardo89/lenic_api/internal/search"
+	"github.com/Anacardo89/lenic_api/pkg/auth"
+	"github.com/Anacardo89/lenic_api/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestMain(m *testing.M) {
+	quiet := log.New(io.Discard, "", 0)
+	logger.Debug, logger.Error, logger.Info, logger.Warn = quiet, quiet, quiet, quiet
+	os.Exit(m.Run())
+}
+
+// fixture is an ApiService on an empty memory.Store, with its search index
+type fixture struct {
+	t     *testing.T
+	s     *ApiService
+	repos repo.Repositories
+	ids   map[string]int64
+}
+
+func newFixture(t *testing.T) *fixture {
+	index := search.New()
+	repos := index.Wrap(memory.New().Repositories())
+	return &fixture{
+		t:     t,
+		s:     NewApiService(repos, time.Hour, index),
+		repos: repos,
+		ids:   map[string]int64{},
+	}
+}
+
+// as is the context of a call made by username
+func as(username string) context.Context {
+	return auth.NewContext(context.Background(), &auth.Claims{Username: username})
+}
+
+func (f *fixture) users(names ...string) {
+	f.t.Helper()
+	for _, name := range names {
+		res, err := f.s.CreateUser(context.Background(), &pb.User{Username: name, Email: name + "@example.com", Pass: "password"})
+		if err != nil {
+			f.t.Fatal(err)
+		}
+		f.ids[name] = res.Id
+	}
+}
+
+func (f *fixture) follow(follower string, followed string) {
+	f.t.Helper()
+	_, err := f.s.FollowUser(as(follower), &pb.FollowUserRequest{FollowerId: f.ids[follower], FollowedId: f.ids[followed]})
+	if err != nil {
+		f.t.Fatal(err)
+	}
+}
+
+// post creates a post by author titled title and returns its guid
+func (f *fixture) post(author string, title string, v pb.Visibility, audience ...string) string {
+	f.t.Helper()
+	p := &pb.Post{AuthorId: f.ids[author], Title: title, Content: title, Visibility: v}
+	for _, name := range audience {
+		p.AudienceIds = append(p.AudienceIds, f.ids[name])
+	}
+	res, err := f.s.CreatePost(as(author), p)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	return res.Uuid
+}
+
+func (f *fixture) comment(author string, guid string, content string) int64 {
+	f.t.Helper()
+	res, err := f.s.CreateComment(as(author), &pb.Comment{PostGuid: guid, AuthorId: f.ids[author], Content: content})
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	return res.Id
+}
+
+// feed returns the titles of the whole feed of username
+func (f *fixture) feed(username string) []string {
+	f.t.Helper()
+	stream := newStream[pb.Post](as(username))
+	if err := f.s.GetFeed(&pb.GetFeedRequest{Username: username}, stream); err != nil {
+		f.t.Fatal(err)
+	}
+	titles := []string{}
+	for _, p := range stream.sent {
+		titles = append(titles, p.Title)
+	}
+	return titles
+}
+
+// stream collects what a streaming handler sends
+type stream[T any] struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sent    []*T
+	trailer metadata.MD
+}
+
+func newStream[T any](ctx context.Context) *stream[T] {
+	return &stream[T]{ctx: ctx}
+}
+
+func (s *stream[T]) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stream[T]) Send(m *T) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func (s *stream[T]) SetTrailer(md metadata.MD) {
+	s.trailer = metadata.Join(s.trailer, md)
+}
+
+// nextToken is the page token the stream left in its trailer, if any
+func (s *stream[T]) nextToken() string {
+	if v := s.trailer.Get(nextPageTokenKey); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// reason is the ErrorInfo reason err reaches the client with
+func reason(err error) string {
+	var e *apperr.Error
+	if errors.As(err, &e) {
+		return e.Reason
+	}
+	return ""
+}
+
+func TestFeedVisibility(t *testing.T) {
+	f := newFixture(t)
+	f.users("ann", "bob", "cat", "dan")
+	f.follow("bob", "ann")
+	if _, err := f.s.AddCloseFriend(as("ann"), &pb.CloseFriendRequest{Username: "ann", FriendUsername: "cat"}); err != nil {
+		t.Fatal(err)
+	}
+	f.post("ann", "public", pb.Visibility_VISIBILITY_PUBLIC)
+	f.post("ann", "unlisted", pb.Visibility_VISIBILITY_UNLISTED)
+	f.post("ann", "followers", pb.Visibility_VISIBILITY_FOLLOWERS)
+	f.post("ann", "close friends", pb.Visibility_VISIBILITY_CLOSE_FRIENDS)
+	f.post("ann", "specific", pb.Visibility_VISIBILITY_SPECIFIC_USERS, "dan")
+
+	want := map[string][]string{
+		"ann": {"specific", "close friends", "followers", "unlisted", "public"},
+		"bob": {"followers", "public"},
+		"cat": {"close friends", "public"},
+		"dan": {"specific", "public"},
+	}
+	for name, titles := range want {
+		if got := f.feed(name); !slices.Equal(got, titles) {
+			t.Errorf("feed of %s is %q, want %q", name, got, titles)
+		}
+	}
+
+	if _, err := f.s.MuteUser(as("bob"), &pb.MuteRequest{Username: "bob", TargetUsername: "ann"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.s.BlockUser(as("ann"), &pb.BlockRequest{Username: "ann", TargetUsername: "dan"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"bob", "dan"} {
+		if got := f.feed(name); len(got) != 0 {
+			t.Errorf("feed of %s is %q, want it empty", name, got)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package endpoints
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+)
+
+func TestFollowBlocked(t *testing.T) {
+	f := newFixture(t)
+	f.users("ann", "bob")
+	if _, err := f.s.BlockUser(as("ann"), &pb.BlockRequest{Username: "ann", TargetUsername: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range [][2]string{{"bob", "ann"}, {"ann", "bob"}} {
+		_, err := f.s.FollowUser(as(c[0]), &pb.FollowUserRequest{FollowerId: f.ids[c[0]], FollowedId: f.ids[c[1]]})
+		if reason(err) != "BLOCKED" {
+			t.Errorf("%s following %s returned %v, want BLOCKED", c[0], c[1], err)
+		}
+	}
+}
+
+func TestFollowRequestPages(t *testing.T) {
+	f := newFixture(t)
+	f.users("ann", "bob", "cat", "dan", "eve")
+	if _, err := f.s.SetAccountPrivacy(as("ann"), &pb.SetAccountPrivacyRequest{Username: "ann", IsPrivate: true}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"bob", "cat", "dan", "eve"} {
+		f.follow(name, "ann")
+	}
+
+	names, token, pages := []string{}, "", 0
+	for {
+		s := newStream[pb.User](as("ann"))
+		if err := f.s.ListIncomingFollowRequests(&pb.ListFollowRequestsRequest{Username: "ann", PageSize: 3, PageToken: token}, s); err != nil {
+			t.Fatal(err)
+		}
+		for _, u := range s.sent {
+			names = append(names, u.Username)
+		}
+		pages++
+		if token = s.nextToken(); token == "" {
+			break
+		}
+	}
+	if want := []string{"bob", "cat", "dan", "eve"}; !slices.Equal(names, want) || pages != 2 {
+		t.Errorf("request pages gave %q in %d pages, want %q in 2", names, pages, want)
+	}
+
+	s := newStream[pb.User](as("bob"))
+	if err := f.s.ListOutgoingFollowRequests(&pb.ListFollowRequestsRequest{Username: "bob", PageSize: 1}, s); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.sent) != 1 || s.sent[0].Username != "ann" || s.nextToken() != "" {
+		t.Errorf("outgoing requests of bob are %v with token %q, want ann alone", s.sent, s.nextToken())
+	}
+}
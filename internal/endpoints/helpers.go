@@ -0,0 +1,53 @@
+package endpoints
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/apperr"
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"github.com/Anacardo89/lenic_api/pkg/auth"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errInvalidCredentials = apperr.Unauthenticated("INVALID_CREDENTIALS", "invalid credentials")
+
+// callerFromContext returns the user making the request, as set by the
+// auth interceptors. A token of an account that's gone is Unauthenticated
+func (s *ApiService) callerFromContext(ctx context.Context) (*model.User, error) {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("missing claims in context")
+	}
+	u, err := s.repos.Users.GetUserByName(ctx, claims.Username)
+	if err == sql.ErrNoRows {
+		return nil, apperr.Unauthenticated("UNKNOWN_USER", "the account of the token doesn't exist")
+	}
+	return u, err
+}
+
+func postVisibility(v pb.Visibility) model.PostVisibility {
+	if v == pb.Visibility_VISIBILITY_UNSPECIFIED {
+		return model.VisibilityFollowers
+	}
+	return model.PostVisibility(v)
+}
+
+func audienceIds(ids []int64) []int {
+	audience := make([]int, 0, len(ids))
+	for _, id := range ids {
+		audience = append(audience, int(id))
+	}
+	return audience
+}
+
+// optionalTime converts t, or returns nil if t is zero
+func optionalTime(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
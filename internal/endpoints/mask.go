@@ -0,0 +1,35 @@
+package endpoints
+
+import (
+	"slices"
+
+	"github.com/Anacardo89/lenic_api/internal/apperr"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// updateMask is the set of fields an update writes
+type updateMask map[string]bool
+
+// readUpdateMask checks the paths of mask against the fields of m, only
+// the ones in mutable may be written. The mask can't be empty, a field left
+// out of it is never written, see bridge for v1 clients
+func readUpdateMask(mask *fieldmaskpb.FieldMask, m proto.Message, mutable ...string) (updateMask, error) {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		return nil, apperr.InvalidField("update_mask", "can't be empty")
+	}
+	fields := m.ProtoReflect().Descriptor().Fields()
+	um := updateMask{}
+	for _, path := range paths {
+		if fields.ByName(protoreflect.Name(path)) == nil {
+			return nil, apperr.InvalidField("update_mask", "unknown field %q", path)
+		}
+		if !slices.Contains(mutable, path) {
+			return nil, apperr.InvalidField("update_mask", "field %q can't be updated", path)
+		}
+		um[path] = true
+	}
+	return um, nil
+}
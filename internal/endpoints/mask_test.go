@@ -0,0 +1,44 @@
+package endpoints
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdateMask(t *testing.T) {
+	f := newFixture(t)
+	f.users("ann", "bob")
+	guid := f.post("ann", "title", pb.Visibility_VISIBILITY_SPECIFIC_USERS, "bob")
+
+	update := func(p *pb.Post, paths ...string) error {
+		p.PostGuid = guid
+		_, err := f.s.UpdatePost(as("ann"), &pb.UpdatePostRequest{Post: p, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}})
+		return err
+	}
+	if err := update(&pb.Post{Title: "new title"}, "title"); err != nil {
+		t.Fatal(err)
+	}
+	p, err := f.s.GetPost(as("ann"), &pb.GetPostRequest{Uuid: guid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Title != "new title" || p.Content != "title" || p.Visibility != pb.Visibility_VISIBILITY_SPECIFIC_USERS {
+		t.Errorf("title update left %q, %q, %v, want the content and visibility unchanged", p.Title, p.Content, p.Visibility)
+	}
+	if got := f.feed("bob"); !slices.Equal(got, []string{"new title"}) {
+		t.Errorf("feed of bob is %q, want the audience kept", got)
+	}
+
+	for name, paths := range map[string][]string{
+		"empty":     nil,
+		"unknown":   {"nope"},
+		"immutable": {"post_guid"},
+	} {
+		if err := update(&pb.Post{}, paths...); reason(err) != "INVALID_ARGUMENT" {
+			t.Errorf("%s mask returned %v, want INVALID_ARGUMENT", name, err)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package endpoints
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/Anacardo89/lenic_api/internal/apperr"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+	// nextPageTokenKey is the trailer a list stream leaves the token of
+	// its next page in, it's missing on the last page
+	nextPageTokenKey = "next-page-token"
+)
+
+var errInvalidPageToken = apperr.InvalidField("page_token", "not a token of this list")
+
+// pageToken is what a page token carries, clients only pass it back
+type pageToken struct {
+	// After is the key of the last row of the previous page
+	After *repo.Key `json:"after,omitempty"`
+	// Offset is how far SearchUsers is into its ranking. It ranks by
+	// follower counts, which can change between pages, so unlike the
+	// keyset lists a user can show up twice or be skipped.
+	Offset int `json:"offset,omitempty"`
+}
+
+func (t pageToken) encode() string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(token string) (pageToken, error) {
+	t := pageToken{}
+	if token == "" {
+		return t, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return t, errInvalidPageToken
+	}
+	if err := json.Unmarshal(b, &t); err != nil || t.Offset < 0 {
+		return t, errInvalidPageToken
+	}
+	return t, nil
+}
+
+func pageSize(size int32) int {
+	if size <= 0 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return int(size)
+}
+
+// readPage returns the page a request asks for. It's one row longer
+// than the page sent, for nextPage to tell whether another one follows
+func readPage(size int32, token string) (repo.Page, pageToken, error) {
+	t, err := decodePageToken(token)
+	if err != nil {
+		return repo.Page{}, t, err
+	}
+	return repo.Page{Size: pageSize(size) + 1, After: t.After}, t, nil
+}
+
+// nextPage drops the extra row readPage asked for and, if it was there,
+// sets the token of the next page in the trailer of stream
+func nextPage[T any](stream grpc.ServerStream, rows []T, page repo.Page, t pageToken, key func(T) repo.Key) []T {
+	if len(rows) < page.Size {
+		return rows
+	}
+	rows = rows[:page.Size-1]
+	k := key(rows[len(rows)-1])
+	t.After = &k
+	stream.SetTrailer(metadata.Pairs(nextPageTokenKey, t.encode()))
+	return rows
+}
@@ -0,0 +1,70 @@
+package endpoints
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+)
+
+func TestPageTokens(t *testing.T) {
+	f := newFixture(t)
+	f.users("ann")
+	guid := f.post("ann", "p0", pb.Visibility_VISIBILITY_PUBLIC)
+	for _, title := range []string{"p1", "p2", "p3", "p4"} {
+		f.post("ann", title, pb.Visibility_VISIBILITY_PUBLIC)
+	}
+	ids := []int64{}
+	for _, content := range []string{"c0", "c1", "c2", "c3", "c4"} {
+		ids = append(ids, f.comment("ann", guid, content))
+	}
+
+	titles, token, pages := []string{}, "", 0
+	for {
+		s := newStream[pb.Post](as("ann"))
+		if err := f.s.GetFeed(&pb.GetFeedRequest{Username: "ann", PageSize: 2, PageToken: token}, s); err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range s.sent {
+			titles = append(titles, p.Title)
+		}
+		pages++
+		if token = s.nextToken(); token == "" {
+			break
+		}
+		// a new post doesn't shift the pages after the first
+		if pages == 1 {
+			f.post("ann", "p5", pb.Visibility_VISIBILITY_PUBLIC)
+		}
+	}
+	if want := []string{"p4", "p3", "p2", "p1", "p0"}; !slices.Equal(titles, want) || pages != 3 {
+		t.Errorf("feed pages gave %q in %d pages, want %q in 3", titles, pages, want)
+	}
+
+	got := []int64{}
+	token = ""
+	for {
+		s := newStream[pb.Comment](as("ann"))
+		if err := f.s.GetCommentsFromPost(&pb.GetCommentsFromPostRequest{Uuid: guid, PageSize: 2, PageToken: token}, s); err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range s.sent {
+			got = append(got, c.Id)
+			// votes don't move comments between pages
+			if _, err := f.s.SetCommentRating(as("ann"), &pb.SetCommentRatingRequest{CommentId: c.Id, Rating: -1}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if token = s.nextToken(); token == "" {
+			break
+		}
+	}
+	if !slices.Equal(got, ids) {
+		t.Errorf("comment pages gave %v, want %v", got, ids)
+	}
+
+	err := f.s.GetFeed(&pb.GetFeedRequest{Username: "ann", PageToken: "not a token"}, newStream[pb.Post](as("ann")))
+	if reason(err) != "INVALID_ARGUMENT" {
+		t.Errorf("a bad page token returned %v, want INVALID_ARGUMENT", err)
+	}
+}
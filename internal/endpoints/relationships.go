@@ -0,0 +1,187 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Anacardo89/lenic_api/internal/apperr"
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"github.com/Anacardo89/lenic_api/pkg/logger"
+)
+
+func (s *ApiService) GetRelationship(ctx context.Context, in *pb.GetRelationshipRequest) (*pb.Relationship, error) {
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		logger.Error.Println("could not get caller: ", err)
+		return nil, fmt.Errorf("could not get caller: %w", err)
+	}
+
+	other, err := s.repos.Users.GetUserByName(ctx, in.OtherUsername)
+	if err != nil {
+		logger.Error.Println("could not get user: ", err)
+		return nil, fmt.Errorf("could not get user: %w", apperr.From(err, "user"))
+	}
+
+	rel, err := s.getRelationship(ctx, caller, other)
+	if err != nil {
+		logger.Error.Println("could not get relationship: ", err)
+		return nil, fmt.Errorf("could not get relationship: %w", err)
+	}
+
+	return rel, nil
+}
+
+// GetRelationships reads the users and how the caller stands with them in
+// a handful of queries, whatever their number
+func (s *ApiService) GetRelationships(ctx context.Context, in *pb.GetRelationshipsRequest) (*pb.GetRelationshipsResponse, error) {
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		logger.Error.Println("could not get caller: ", err)
+		return nil, fmt.Errorf("could not get caller: %w", err)
+	}
+
+	others, err := s.repos.Users.GetUsersByNames(ctx, in.OtherUsernames)
+	if err != nil {
+		logger.Error.Println("could not get users: ", err)
+		return nil, fmt.Errorf("could not get users: %w", err)
+	}
+	byName := map[string]model.User{}
+	ids := []int{}
+	for _, u := range *others {
+		byName[u.UserName] = u
+		ids = append(ids, u.Id)
+	}
+
+	relations, err := s.repos.Follows.GetRelations(ctx, caller.Id, ids)
+	if err != nil {
+		logger.Error.Println("could not get relationships: ", err)
+		return nil, fmt.Errorf("could not get relationships: %w", err)
+	}
+
+	res := &pb.GetRelationshipsResponse{}
+	for _, name := range in.OtherUsernames {
+		other, ok := byName[name]
+		if !ok {
+			continue
+		}
+		res.Relationships = append(res.Relationships, relationship(other, relations[other.Id]))
+	}
+
+	return res, nil
+}
+
+func (s *ApiService) BlockUser(ctx context.Context, in *pb.BlockRequest) (*pb.BlockResponse, error) {
+
+	u, target, err := s.getUserPair(ctx, in.Username, in.TargetUsername)
+	if err != nil {
+		logger.Error.Println("could not get users: ", err)
+		return nil, fmt.Errorf("could not get users: %w", err)
+	}
+
+	err = s.repos.Follows.BlockUser(ctx, u.Id, target.Id)
+	if err != nil {
+		logger.Error.Println("could not block user: ", err)
+		return nil, fmt.Errorf("could not block user: %w", err)
+	}
+
+	s.suggestions.invalidate(u.Id)
+	s.suggestions.invalidate(target.Id)
+
+	return &pb.BlockResponse{}, nil
+}
+
+func (s *ApiService) UnblockUser(ctx context.Context, in *pb.BlockRequest) (*pb.BlockResponse, error) {
+
+	u, target, err := s.getUserPair(ctx, in.Username, in.TargetUsername)
+	if err != nil {
+		logger.Error.Println("could not get users: ", err)
+		return nil, fmt.Errorf("could not get users: %w", err)
+	}
+
+	err = s.repos.Follows.UnblockUser(ctx, u.Id, target.Id)
+	if err != nil {
+		logger.Error.Println("could not unblock user: ", err)
+		return nil, fmt.Errorf("could not unblock user: %w", err)
+	}
+
+	s.suggestions.invalidate(u.Id)
+	s.suggestions.invalidate(target.Id)
+
+	return &pb.BlockResponse{}, nil
+}
+
+func (s *ApiService) MuteUser(ctx context.Context, in *pb.MuteRequest) (*pb.MuteResponse, error) {
+
+	u, target, err := s.getUserPair(ctx, in.Username, in.TargetUsername)
+	if err != nil {
+		logger.Error.Println("could not get users: ", err)
+		return nil, fmt.Errorf("could not get users: %w", err)
+	}
+
+	err = s.repos.Follows.MuteUser(ctx, u.Id, target.Id)
+	if err != nil {
+		logger.Error.Println("could not mute user: ", err)
+		return nil, fmt.Errorf("could not mute user: %w", err)
+	}
+
+	return &pb.MuteResponse{}, nil
+}
+
+func (s *ApiService) UnmuteUser(ctx context.Context, in *pb.MuteRequest) (*pb.MuteResponse, error) {
+
+	u, target, err := s.getUserPair(ctx, in.Username, in.TargetUsername)
+	if err != nil {
+		logger.Error.Println("could not get users: ", err)
+		return nil, fmt.Errorf("could not get users: %w", err)
+	}
+
+	err = s.repos.Follows.UnmuteUser(ctx, u.Id, target.Id)
+	if err != nil {
+		logger.Error.Println("could not unmute user: ", err)
+		return nil, fmt.Errorf("could not unmute user: %w", err)
+	}
+
+	return &pb.MuteResponse{}, nil
+}
+
+func (s *ApiService) getUserPair(ctx context.Context, username string, target_username string) (*model.User, *model.User, error) {
+	if username == target_username {
+		return nil, nil, apperr.InvalidField("target_username", "can't be the caller")
+	}
+	u, err := s.repos.Users.GetUserByName(ctx, username)
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := s.repos.Users.GetUserByName(ctx, target_username)
+	if err != nil {
+		return nil, nil, err
+	}
+	return u, target, nil
+}
+
+func (s *ApiService) getRelationship(ctx context.Context, caller *model.User, other *model.User) (*pb.Relationship, error) {
+	relations, err := s.repos.Follows.GetRelations(ctx, caller.Id, []int{other.Id})
+	if err != nil {
+		return nil, err
+	}
+	return relationship(*other, relations[other.Id]), nil
+}
+
+func relationship(other model.User, r model.Relation) *pb.Relationship {
+	rel := &pb.Relationship{
+		Username:  other.UserName,
+		Blocking:  r.Blocking,
+		BlockedBy: r.BlockedBy,
+		Muting:    r.Muting,
+	}
+	if r.Out != nil {
+		rel.Following = r.Out.Status == model.FollowAccepted
+		rel.OutgoingRequest = r.Out.Status == model.FollowPending
+	}
+	if r.In != nil {
+		rel.FollowedBy = r.In.Status == model.FollowAccepted
+		rel.IncomingRequest = r.In.Status == model.FollowPending
+	}
+	return rel
+}
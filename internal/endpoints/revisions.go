@@ -0,0 +1,84 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Anacardo89/lenic_api/internal/apperr"
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetPostRevisions streams the versions of a post its edits replaced,
+// oldest first, the interceptor checks the caller can see the post
+func (s *ApiService) GetPostRevisions(in *pb.GetPostRevisionsRequest, stream pb.Lenic_GetPostRevisionsServer) error {
+	ctx := stream.Context()
+	p, err := s.repos.Posts.GetPostByGUID(ctx, in.Uuid)
+	if err != nil {
+		return fmt.Errorf("could not get post: %w", apperr.From(err, "post"))
+	}
+
+	revisions, err := s.repos.Posts.GetPostRevisions(ctx, p.Id)
+	if err != nil {
+		return fmt.Errorf("could not get post revisions: %w", err)
+	}
+
+	for _, r := range *revisions {
+		err = stream.Send(postRevision(p, &r))
+		if err != nil {
+			return fmt.Errorf("error sending message to stream: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *ApiService) GetPostRevision(ctx context.Context, in *pb.GetPostRevisionRequest) (*pb.PostRevision, error) {
+	p, err := s.repos.Posts.GetPostByGUID(ctx, in.Uuid)
+	if err != nil {
+		return nil, fmt.Errorf("could not get post: %w", apperr.From(err, "post"))
+	}
+
+	r, err := s.repos.Posts.GetPostRevision(ctx, p.Id, int(in.Revision))
+	if err != nil {
+		return nil, fmt.Errorf("could not get post revision: %w", apperr.From(err, "revision"))
+	}
+
+	return postRevision(p, r), nil
+}
+
+func postRevision(p *model.Post, r *model.PostRevision) *pb.PostRevision {
+	return &pb.PostRevision{
+		PostGuid:   p.GUID,
+		Revision:   int32(r.Revision),
+		Title:      r.Title,
+		Content:    r.Content,
+		Visibility: pb.Visibility(r.Visibility),
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+	}
+}
+
+// GetCommentRevisions streams the versions of a comment its edits
+// replaced, oldest first, the interceptor only lets the authors of the
+// comment and of its post through
+func (s *ApiService) GetCommentRevisions(in *pb.GetCommentRevisionsRequest, stream pb.Lenic_GetCommentRevisionsServer) error {
+	ctx := stream.Context()
+	revisions, err := s.repos.Comments.GetCommentRevisions(ctx, int(in.Id))
+	if err != nil {
+		return fmt.Errorf("could not get comment revisions: %w", err)
+	}
+
+	for _, r := range *revisions {
+		revision := pb.CommentRevision{
+			CommentId: int64(r.CommentId),
+			Revision:  int32(r.Revision),
+			Content:   r.Content,
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		}
+		err = stream.Send(&revision)
+		if err != nil {
+			return fmt.Errorf("error sending message to stream: %w", err)
+		}
+	}
+	return nil
+}
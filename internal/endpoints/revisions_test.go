@@ -0,0 +1,90 @@
+package endpoints
+
+import (
+	"testing"
+
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestPostRevisions(t *testing.T) {
+	f := newFixture(t)
+	f.users("ann")
+	guid := f.post("ann", "v1", pb.Visibility_VISIBILITY_PUBLIC)
+	for _, title := range []string{"v2", "v3"} {
+		_, err := f.s.UpdatePost(as("ann"), &pb.UpdatePostRequest{
+			Post:       &pb.Post{PostGuid: guid, Title: title},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := newStream[pb.PostRevision](as("ann"))
+	if err := f.s.GetPostRevisions(&pb.GetPostRevisionsRequest{Uuid: guid}, s); err != nil {
+		t.Fatal(err)
+	}
+	titles := []string{}
+	for _, r := range s.sent {
+		titles = append(titles, r.Title)
+	}
+	if len(titles) != 2 || titles[0] != "v1" || titles[1] != "v2" {
+		t.Errorf("revisions are %q, want the replaced [v1 v2]", titles)
+	}
+
+	first, err := f.s.GetPostRevision(as("ann"), &pb.GetPostRevisionRequest{Uuid: guid, Revision: s.sent[0].Revision})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Title != "v1" {
+		t.Errorf("revision %d is %q, want v1", first.Revision, first.Title)
+	}
+	if _, err := f.s.GetPostRevision(as("ann"), &pb.GetPostRevisionRequest{Uuid: guid, Revision: 99}); reason(err) != "REVISION_NOT_FOUND" {
+		t.Errorf("missing revision returned %v, want REVISION_NOT_FOUND", err)
+	}
+
+	p, err := f.s.GetPost(as("ann"), &pb.GetPostRequest{Uuid: guid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Title != "v3" || p.EditCount != 2 || p.EditedAt == nil {
+		t.Errorf("post is %q edited %d times at %v, want v3 edited twice", p.Title, p.EditCount, p.EditedAt)
+	}
+}
+
+func TestCommentRevisions(t *testing.T) {
+	f := newFixture(t)
+	f.users("ann")
+	guid := f.post("ann", "post", pb.Visibility_VISIBILITY_PUBLIC)
+	id := f.comment("ann", guid, "v1")
+	for _, content := range []string{"v2", "v3"} {
+		_, err := f.s.UpdateComment(as("ann"), &pb.UpdateCommentRequest{
+			Comment:    &pb.Comment{Id: id, Content: content},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := newStream[pb.CommentRevision](as("ann"))
+	if err := f.s.GetCommentRevisions(&pb.GetCommentRevisionsRequest{Id: id}, s); err != nil {
+		t.Fatal(err)
+	}
+	contents := []string{}
+	for _, r := range s.sent {
+		contents = append(contents, r.Content)
+	}
+	if len(contents) != 2 || contents[0] != "v1" || contents[1] != "v2" {
+		t.Errorf("revisions are %q, want the replaced [v1 v2]", contents)
+	}
+
+	c, err := f.s.GetComment(as("ann"), &pb.GetCommentRequest{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Content != "v3" || !c.Edited || c.EditCount != 2 {
+		t.Errorf("comment is %q edited %d times, want v3 edited twice", c.Content, c.EditCount)
+	}
+}
@@ -0,0 +1,342 @@
+package endpoints
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/access"
+	"github.com/Anacardo89/lenic_api/internal/apperr"
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"github.com/Anacardo89/lenic_api/internal/search"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// SearchPosts streams the best matches of the query among the active posts
+// the caller may see. Unlisted posts are only matched for their author
+func (s *ApiService) SearchPosts(in *pb.SearchPostsRequest, stream pb.Lenic_SearchPostsServer) error {
+	ctx := stream.Context()
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get caller: %w", err)
+	}
+
+	q, err := s.searchQuery(ctx, in.Query, in.Author, in.Since, in.Until)
+	if err != nil {
+		return err
+	}
+
+	limit, sent := searchLimit(in.Limit), 0
+	for _, id := range s.search.SearchPosts(q) {
+		if sent == limit {
+			break
+		}
+		p, err := s.repos.Posts.GetPostByID(ctx, id)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not get post: %w", apperr.From(err, "post"))
+		}
+		ok, err := s.canFindPost(ctx, caller.Id, p)
+		if err != nil {
+			return fmt.Errorf("could not check post access: %w", err)
+		}
+		if !ok {
+			continue
+		}
+
+		post := pb.Post{
+			Id:         int64(p.Id),
+			PostGuid:   p.GUID,
+			AuthorId:   int64(p.AuthorId),
+			Title:      p.Title,
+			Content:    p.Content,
+			CreatedAt:  timestamppb.New(p.CreatedAt),
+			UpdatedAt:  timestamppb.New(p.UpdatedAt),
+			Rating:     int32(p.Rating),
+			Active:     true,
+			Visibility: pb.Visibility(p.Visibility),
+			EditCount:  int32(p.EditCount),
+			EditedAt:   optionalTime(p.EditedAt),
+		}
+		votes, err := s.postVotes(ctx, caller.Id, []model.Post{*p})
+		if err != nil {
+			return fmt.Errorf("could not get votes: %w", err)
+		}
+		setPostVotes(&post, votes[p.Id])
+		err = stream.Send(&post)
+		if err != nil {
+			return fmt.Errorf("error sending message to stream: %w", err)
+		}
+		sent++
+	}
+	return nil
+}
+
+// SearchComments streams the best matches of the query among the active
+// comments whose post the caller may find with SearchPosts, leaving out
+// comments by users who blocked the caller or whom the caller blocked
+func (s *ApiService) SearchComments(in *pb.SearchCommentsRequest, stream pb.Lenic_SearchCommentsServer) error {
+	ctx := stream.Context()
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get caller: %w", err)
+	}
+
+	q, err := s.searchQuery(ctx, in.Query, in.Author, in.Since, in.Until)
+	if err != nil {
+		return err
+	}
+
+	limit, sent := searchLimit(in.Limit), 0
+	for _, id := range s.search.SearchComments(q) {
+		if sent == limit {
+			break
+		}
+		c, err := s.repos.Comments.GetCommentById(ctx, id)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not get comment: %w", apperr.From(err, "comment"))
+		}
+		if c.Active != model.ContentActive {
+			continue
+		}
+		p, err := s.repos.Posts.GetPostByGUID(ctx, c.PostGUID)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not get post: %w", apperr.From(err, "post"))
+		}
+		ok, err := s.canFindPost(ctx, caller.Id, p)
+		if err != nil {
+			return fmt.Errorf("could not check post access: %w", err)
+		}
+		if !ok {
+			continue
+		}
+		blocked, err := access.Blocked(ctx, s.repos, c.AuthorId, caller.Id)
+		if err != nil {
+			return fmt.Errorf("could not check block: %w", err)
+		}
+		if blocked {
+			continue
+		}
+
+		comment := pb.Comment{
+			Id:        int64(c.Id),
+			PostGuid:  c.PostGUID,
+			AuthorId:  int64(c.AuthorId),
+			Content:   c.Content,
+			CreatedAt: timestamppb.New(c.CreatedAt),
+			UpdatedAt: timestamppb.New(c.UpdatedAt),
+			Rating:    int32(c.Rating),
+			Active:    true,
+			Edited:    c.EditCount > 0,
+			EditCount: int32(c.EditCount),
+		}
+		votes, err := s.commentVotes(ctx, caller.Id, []model.Comment{*c})
+		if err != nil {
+			return fmt.Errorf("could not get votes: %w", err)
+		}
+		setCommentVotes(&comment, votes[c.Id])
+		err = stream.Send(&comment)
+		if err != nil {
+			return fmt.Errorf("error sending message to stream: %w", err)
+		}
+		sent++
+	}
+	return nil
+}
+
+// searchQuery parses the query and resolves its filters
+func (s *ApiService) searchQuery(ctx context.Context, query string, author string, since *timestamppb.Timestamp, until *timestamppb.Timestamp) (search.Query, error) {
+	q := search.ParseQuery(query)
+	if q.Empty() {
+		return q, apperr.InvalidField("query", "has no words")
+	}
+	if author != "" {
+		u, err := s.repos.Users.GetUserByName(ctx, author)
+		if err != nil {
+			return q, fmt.Errorf("could not get author: %w", apperr.From(err, "user"))
+		}
+		q.AuthorId = u.Id
+	}
+	var err error
+	q.Since, err = searchTime(since)
+	if err != nil {
+		return q, apperr.InvalidField("since", "%v", err)
+	}
+	q.Until, err = searchTime(until)
+	if err != nil {
+		return q, apperr.InvalidField("until", "%v", err)
+	}
+	return q, nil
+}
+
+// searchTime returns the time of ts, or the zero time if it isn't set
+func searchTime(ts *timestamppb.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, err
+	}
+	return ts.AsTime(), nil
+}
+
+func searchLimit(limit int32) int {
+	if limit <= 0 {
+		return defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		return maxSearchLimit
+	}
+	return int(limit)
+}
+
+// canFindPost is access.CanViewPost for active posts that aren't unlisted,
+// an unlisted post is only found by its author
+func (s *ApiService) canFindPost(ctx context.Context, viewer_id int, p *model.Post) (bool, error) {
+	if p.Active != model.ContentActive {
+		return false, nil
+	}
+	if p.Visibility == model.VisibilityUnlisted && p.AuthorId != viewer_id {
+		return false, nil
+	}
+	return access.CanViewPost(ctx, s.repos, viewer_id, p)
+}
+
+const (
+	// userSearchCandidates bounds the users ranked by SearchUsers, and so
+	// how far its pages go
+	userSearchCandidates   = 200
+	userSearchDefaultLimit = 20
+	userSearchMaxLimit     = 50
+)
+
+func userSearchLimit(limit int32) int {
+	if limit <= 0 {
+		return userSearchDefaultLimit
+	}
+	if limit > userSearchMaxLimit {
+		return userSearchMaxLimit
+	}
+	return int(limit)
+}
+
+// userMatch is how a username matches a search, lower is better
+type userMatch struct {
+	// tier is 0 for the same name, 1 for a prefix, 2 for a substring and
+	// 3 for a name within a few typos
+	tier  int
+	typos int
+}
+
+// matchUser compares a username with a search term, ok is false if they
+// don't match at all
+func matchUser(name string, term string) (m userMatch, ok bool) {
+	name, term = strings.ToLower(name), strings.ToLower(term)
+	switch {
+	case name == term:
+		return userMatch{tier: 0}, true
+	case strings.HasPrefix(name, term):
+		return userMatch{tier: 1}, true
+	case strings.Contains(name, term):
+		return userMatch{tier: 2}, true
+	}
+	letters := []rune(term)
+	allowed := 0
+	switch {
+	case len(letters) >= 8:
+		allowed = 2
+	case len(letters) >= 3:
+		allowed = 1
+	}
+	// a name being typed is compared up to the length of the term too
+	typos := editDistance(letters, []rune(name))
+	if runes := []rune(name); len(runes) > len(letters) {
+		typos = min(typos, editDistance(letters, runes[:len(letters)]))
+	}
+	if allowed == 0 || typos > allowed {
+		return userMatch{}, false
+	}
+	return userMatch{tier: 3, typos: typos}, true
+}
+
+// rankUsers keeps the users matching term, ordered by how well they match
+// and then by mutual follows and followers
+func rankUsers(term string, users []model.User, mutuals map[int]int) []model.User {
+	type ranked struct {
+		user  model.User
+		match userMatch
+		score float64
+	}
+	matches := []ranked{}
+	for _, u := range users {
+		m, ok := matchUser(u.UserName, term)
+		if !ok {
+			continue
+		}
+		score := 2*math.Log1p(float64(mutuals[u.Id])) + math.Log1p(float64(u.Followers))
+		matches = append(matches, ranked{user: u, match: m, score: score})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.match != b.match {
+			if a.match.tier != b.match.tier {
+				return a.match.tier < b.match.tier
+			}
+			return a.match.typos < b.match.typos
+		}
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		return a.user.UserName < b.user.UserName
+	})
+	out := make([]model.User, 0, len(matches))
+	for _, m := range matches {
+		out = append(out, m.user)
+	}
+	return out
+}
+
+// editDistance counts the letters inserted, deleted, replaced or swapped
+// with their neighbour to turn a into b
+func editDistance(a []rune, b []rune) int {
+	// rows i-2, i-1 and i of the table
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
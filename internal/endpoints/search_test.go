@@ -0,0 +1,64 @@
+package endpoints
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/Anacardo89/lenic_api/internal/access"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+)
+
+func TestSearchUsersOrder(t *testing.T) {
+	f := newFixture(t)
+	f.users("xbob0", "bobby", "bob", "ann")
+	s := newStream[pb.User](as("ann"))
+	if err := f.s.SearchUsers(&pb.SearchUsersRequest{Username: "bob"}, s); err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, u := range s.sent {
+		names = append(names, u.Username)
+	}
+	if want := []string{"bob", "bobby", "xbob0"}; !slices.Equal(names, want) {
+		t.Errorf("search found %q, want %q", names, want)
+	}
+}
+
+// TestBlockedEitherWay checks that posts and comments of ann, on a post by
+// cat, stay hidden from bob whichever of them blocked the other
+func TestBlockedEitherWay(t *testing.T) {
+	for _, c := range [][2]string{{"ann", "bob"}, {"bob", "ann"}} {
+		f := newFixture(t)
+		f.users("ann", "bob", "cat")
+		guid := f.post("ann", "hello", pb.Visibility_VISIBILITY_PUBLIC)
+		f.comment("ann", f.post("cat", "post", pb.Visibility_VISIBILITY_PUBLIC), "hello")
+		f.follow("bob", "ann")
+		if _, err := f.s.BlockUser(as(c[0]), &pb.BlockRequest{Username: c[0], TargetUsername: c[1]}); err != nil {
+			t.Fatal(err)
+		}
+
+		if got := f.feed("bob"); slices.Contains(got, "hello") {
+			t.Errorf("%s blocked %s, feed of bob is %q", c[0], c[1], got)
+		}
+		// GetPost is checked by the interceptor with access.CanViewPost
+		p, err := f.repos.Posts.GetPostByGUID(context.Background(), guid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := access.CanViewPost(context.Background(), f.repos, int(f.ids["bob"]), p); ok || err != nil {
+			t.Errorf("%s blocked %s, bob can view the post: %v, %v", c[0], c[1], ok, err)
+		}
+		posts := newStream[pb.Post](as("bob"))
+		if err := f.s.SearchPosts(&pb.SearchPostsRequest{Query: "hello"}, posts); err != nil {
+			t.Fatal(err)
+		}
+		comments := newStream[pb.Comment](as("bob"))
+		if err := f.s.SearchComments(&pb.SearchCommentsRequest{Query: "hello"}, comments); err != nil {
+			t.Fatal(err)
+		}
+		if len(posts.sent) != 0 || len(comments.sent) != 0 {
+			t.Errorf("%s blocked %s, bob found %d posts and %d comments", c[0], c[1], len(posts.sent), len(comments.sent))
+		}
+	}
+}
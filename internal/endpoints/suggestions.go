@@ -0,0 +1,176 @@
+package endpoints
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/apperr"
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"github.com/Anacardo89/lenic_api/pkg/logger"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	suggestionsTTL          = 10 * time.Minute
+	suggestionsCandidates   = 200
+	suggestionsDefaultLimit = 20
+	suggestionsMaxLimit     = 50
+	// a post older than this no longer adds to the activity bonus
+	suggestionsActivityWindow = 30 * 24 * time.Hour
+)
+
+type suggestionsEntry struct {
+	ranked    []*model.FollowSuggestion
+	expiresAt time.Time
+}
+
+// suggestionsCache keeps the ranked suggestions of each user so that
+// asking again within suggestionsTTL, with the same or another limit,
+// doesn't recompute the graph query, see invalidate.
+// Expired entries are swept on set, at most once per suggestionsTTL, so
+// it only holds the users who asked in the last two TTLs
+type suggestionsCache struct {
+	mu      sync.Mutex
+	entries map[int]suggestionsEntry
+	swept   time.Time
+}
+
+func newSuggestionsCache() *suggestionsCache {
+	return &suggestionsCache{entries: map[int]suggestionsEntry{}}
+}
+
+func (c *suggestionsCache) get(user_id int) ([]*model.FollowSuggestion, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[user_id]
+	if !ok || time.Now().After(e.expiresAt) {
+		delete(c.entries, user_id)
+		return nil, false
+	}
+	return e.ranked, true
+}
+
+func (c *suggestionsCache) set(user_id int, ranked []*model.FollowSuggestion) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if now.Sub(c.swept) > suggestionsTTL {
+		for id, e := range c.entries {
+			if now.After(e.expiresAt) {
+				delete(c.entries, id)
+			}
+		}
+		c.swept = now
+	}
+	c.entries[user_id] = suggestionsEntry{
+		ranked:    ranked,
+		expiresAt: now.Add(suggestionsTTL),
+	}
+}
+
+// invalidate drops the cached suggestions of user_id, it must be called
+// whenever their follows or blocks change
+func (c *suggestionsCache) invalidate(user_id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, user_id)
+}
+
+// rankSuggestions orders candidates by mutual follows, boosted by how
+// recently they posted
+func rankSuggestions(candidates []*model.FollowSuggestion) []*model.FollowSuggestion {
+	now := time.Now()
+	score := func(fs *model.FollowSuggestion) float64 {
+		activity := 0.0
+		if !fs.LastPostAt.IsZero() {
+			age := now.Sub(fs.LastPostAt)
+			if age < suggestionsActivityWindow {
+				activity = 1 - float64(age)/float64(suggestionsActivityWindow)
+			}
+		}
+		return float64(fs.MutualCount) * (1 + activity)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		si, sj := score(candidates[i]), score(candidates[j])
+		if si != sj {
+			return si > sj
+		}
+		return candidates[i].MutualCount > candidates[j].MutualCount
+	})
+	return candidates
+}
+
+func (s *ApiService) GetFollowSuggestions(in *pb.GetFollowSuggestionsRequest, stream pb.Lenic_GetFollowSuggestionsServer) error {
+	ctx := stream.Context()
+	u, err := s.repos.Users.GetUserByName(ctx, in.Username)
+	if err != nil {
+		logger.Error.Println("could not get user: ", err)
+		return fmt.Errorf("could not get user: %w", apperr.From(err, "user"))
+	}
+
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = suggestionsDefaultLimit
+	}
+	if limit > suggestionsMaxLimit {
+		limit = suggestionsMaxLimit
+	}
+
+	ranked, ok := s.suggestions.get(u.Id)
+	if !ok {
+		candidates, err := s.repos.Follows.GetFollowSuggestions(ctx, u.Id, suggestionsCandidates)
+		if err != nil {
+			logger.Error.Println("could not get follow suggestions: ", err)
+			return fmt.Errorf("could not get follow suggestions: %w", err)
+		}
+		ranked = rankSuggestions(candidates)
+		s.suggestions.set(u.Id, ranked)
+	}
+
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	ids := make([]int, 0, len(ranked))
+	for _, fs := range ranked {
+		ids = append(ids, fs.UserId)
+	}
+	found, err := s.repos.Users.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		logger.Error.Println("could not get suggested users: ", err)
+		return fmt.Errorf("could not get suggested users: %w", err)
+	}
+	users := make(map[int]model.User, len(*found))
+	for _, su := range *found {
+		users[su.Id] = su
+	}
+
+	for _, fs := range ranked {
+		su, ok := users[fs.UserId]
+		if !ok {
+			continue
+		}
+		suggestion := pb.FollowSuggestion{
+			User: &pb.User{
+				Id:            int64(su.Id),
+				Username:      su.UserName,
+				UserFollowers: int32(su.Followers),
+				UserFollowing: int32(su.Following),
+				CreatedAt:     timestamppb.New(su.CreatedAt),
+				UpdatedAt:     timestamppb.New(su.UpdatedAt),
+				Active:        int32(su.Active),
+				IsPrivate:     su.IsPrivate,
+			},
+			MutualCount: int32(fs.MutualCount),
+		}
+		err = stream.Send(&suggestion)
+		if err != nil {
+			logger.Error.Println("error sending message to stream: ", err)
+			return fmt.Errorf("error sending message to stream: %w", err)
+		}
+	}
+	return nil
+}
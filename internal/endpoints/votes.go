@@ -0,0 +1,234 @@
+package endpoints
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/Anacardo89/lenic_api/internal/access"
+	"github.com/Anacardo89/lenic_api/internal/apperr"
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"github.com/Anacardo89/lenic_api/pkg/logger"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// postVotes returns the votes on posts as seen by the caller
+func (s *ApiService) postVotes(ctx context.Context, caller_id int, posts []model.Post) (map[int]model.Votes, error) {
+	ids := make([]int, 0, len(posts))
+	for _, p := range posts {
+		ids = append(ids, p.Id)
+	}
+	return s.repos.Ratings.GetPostVotes(ctx, caller_id, ids)
+}
+
+func (s *ApiService) commentVotes(ctx context.Context, caller_id int, comments []model.Comment) (map[int]model.Votes, error) {
+	ids := make([]int, 0, len(comments))
+	for _, c := range comments {
+		ids = append(ids, c.Id)
+	}
+	return s.repos.Ratings.GetCommentVotes(ctx, caller_id, ids)
+}
+
+func setPostVotes(post *pb.Post, v model.Votes) {
+	post.MyRating = int32(v.Mine)
+	post.Upvotes = int32(v.Up)
+	post.Downvotes = int32(v.Down)
+}
+
+func setCommentVotes(comment *pb.Comment, v model.Votes) {
+	comment.MyRating = int32(v.Mine)
+	comment.Upvotes = int32(v.Up)
+	comment.Downvotes = int32(v.Down)
+}
+
+// GetPostRatings streams the votes on a post the caller may see, the
+// interceptor checks they can see the post itself. A voter is left out if
+// either of them blocked the other, or if their account is private and the
+// caller isn't one of their followers
+func (s *ApiService) GetPostRatings(in *pb.GetPostRatingsRequest, stream pb.Lenic_GetPostRatingsServer) error {
+	ctx := stream.Context()
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get caller: %w", err)
+	}
+
+	p, err := s.repos.Posts.GetPostByGUID(ctx, in.Uuid)
+	if err != nil {
+		return fmt.Errorf("could not get post: %w", apperr.From(err, "post"))
+	}
+
+	ratings, err := s.repos.Ratings.GetPostRatings(ctx, p.Id)
+	if err != nil {
+		return fmt.Errorf("could not get post ratings: %w", err)
+	}
+	ids := make([]int, 0, len(*ratings))
+	for _, r := range *ratings {
+		ids = append(ids, r.UserId)
+	}
+	found, err := s.repos.Users.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("could not get voters: %w", err)
+	}
+	voters := make(map[int]model.User, len(*found))
+	for _, u := range *found {
+		voters[u.Id] = u
+	}
+
+	for _, r := range *ratings {
+		u, ok := voters[r.UserId]
+		if !ok {
+			continue
+		}
+		ok, err = s.canSeeVoter(ctx, caller.Id, &u)
+		if err != nil {
+			return fmt.Errorf("could not check voter: %w", err)
+		}
+		if !ok {
+			continue
+		}
+		vote := pb.PostVote{
+			User: &pb.User{
+				Id:            int64(u.Id),
+				Username:      u.UserName,
+				UserFollowers: int32(u.Followers),
+				UserFollowing: int32(u.Following),
+				CreatedAt:     timestamppb.New(u.CreatedAt),
+				UpdatedAt:     timestamppb.New(u.UpdatedAt),
+				Active:        int32(u.Active),
+				IsPrivate:     u.IsPrivate,
+			},
+			Rating: int32(r.RatingValue),
+		}
+		err = stream.Send(&vote)
+		if err != nil {
+			return fmt.Errorf("error sending message to stream: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *ApiService) canSeeVoter(ctx context.Context, caller_id int, voter *model.User) (bool, error) {
+	if voter.Id == caller_id {
+		return true, nil
+	}
+	blocked, err := access.Blocked(ctx, s.repos, voter.Id, caller_id)
+	if err != nil || blocked {
+		return false, err
+	}
+	if !voter.IsPrivate {
+		return true, nil
+	}
+	f, err := s.repos.Follows.GetUserFollows(ctx, caller_id, voter.Id)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return f.Status == model.FollowAccepted, nil
+}
+
+// SetPostRating stores the vote of the caller as given, so a retried
+// request changes nothing. The author is only notified when the vote
+// changes to an up or down vote
+func (s *ApiService) SetPostRating(ctx context.Context, in *pb.SetPostRatingRequest) (*pb.SetPostRatingResponse, error) {
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		logger.Error.Println("could not get caller: ", err)
+		return nil, fmt.Errorf("could not get caller: %w", err)
+	}
+
+	res := &pb.SetPostRatingResponse{
+		MyRating: in.Rating,
+	}
+
+	err = s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		previous, err := tx.Ratings.SetPostRating(ctx, int(in.PostId), caller.Id, int(in.Rating))
+		if err != nil {
+			return fmt.Errorf("could not set post rating: %w", apperr.From(err, "post"))
+		}
+
+		post, err := tx.Posts.GetPostByID(ctx, int(in.PostId))
+		if err != nil {
+			return fmt.Errorf("error get post: %w", apperr.From(err, "post"))
+		}
+		res.Rating = int32(post.Rating)
+
+		if in.Rating == 0 || int(in.Rating) == previous {
+			return nil
+		}
+		notif := model.Notification{
+			UserID:     post.AuthorId,
+			FromUserId: caller.Id,
+			NotifType:  "rate_post",
+			NotifMsg:   " has rated your post.",
+			ResourceId: post.GUID,
+			ParentId:   "",
+		}
+
+		_, err = tx.Notifications.CreateNotification(ctx, &notif)
+		if err != nil {
+			return fmt.Errorf("error creating notif: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error.Println(err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetCommentRating is SetPostRating for comments
+func (s *ApiService) SetCommentRating(ctx context.Context, in *pb.SetCommentRatingRequest) (*pb.SetCommentRatingResponse, error) {
+	caller, err := s.callerFromContext(ctx)
+	if err != nil {
+		logger.Error.Println("could not get caller: ", err)
+		return nil, fmt.Errorf("could not get caller: %w", err)
+	}
+
+	res := &pb.SetCommentRatingResponse{
+		MyRating: in.Rating,
+	}
+
+	err = s.repos.InTx(ctx, func(tx repo.Repositories) error {
+		previous, err := tx.Ratings.SetCommentRating(ctx, int(in.CommentId), caller.Id, int(in.Rating))
+		if err != nil {
+			return fmt.Errorf("could not set comment rating: %w", apperr.From(err, "comment"))
+		}
+
+		comment, err := tx.Comments.GetCommentById(ctx, int(in.CommentId))
+		if err != nil {
+			return fmt.Errorf("error get comment: %w", apperr.From(err, "comment"))
+		}
+		res.Rating = int32(comment.Rating)
+
+		if in.Rating == 0 || int(in.Rating) == previous {
+			return nil
+		}
+		notif := model.Notification{
+			UserID:     comment.AuthorId,
+			FromUserId: caller.Id,
+			NotifType:  "rate_comment",
+			NotifMsg:   " has rated your comment.",
+			ResourceId: strconv.Itoa(comment.Id),
+			ParentId:   comment.PostGUID,
+		}
+
+		_, err = tx.Notifications.CreateNotification(ctx, &notif)
+		if err != nil {
+			return fmt.Errorf("error creating notif: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error.Println(err)
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,92 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+)
+
+func TestSetPostRating(t *testing.T) {
+	f := newFixture(t)
+	f.users("ann", "bob")
+	guid := f.post("ann", "post", pb.Visibility_VISIBILITY_PUBLIC)
+	p, err := f.s.GetPost(as("ann"), &pb.GetPostRequest{Uuid: guid})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, step := range []struct {
+		vote    int32
+		rating  int32
+		notices int
+	}{
+		{vote: 1, rating: 1, notices: 1},
+		// a retry changes nothing
+		{vote: 1, rating: 1, notices: 1},
+		{vote: -1, rating: -1, notices: 2},
+		// taking the vote back doesn't notify
+		{vote: 0, rating: 0, notices: 2},
+	} {
+		res, err := f.s.SetPostRating(as("bob"), &pb.SetPostRatingRequest{PostId: p.Id, Rating: step.vote})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Rating != step.rating || res.MyRating != step.vote {
+			t.Errorf("vote %d left rating %d and mine %d, want %d and %d", step.vote, res.Rating, res.MyRating, step.rating, step.vote)
+		}
+		if n := f.notices("ann"); n != step.notices {
+			t.Errorf("vote %d left %d notifications, want %d", step.vote, n, step.notices)
+		}
+	}
+
+	if _, err := f.s.SetPostRating(as("bob"), &pb.SetPostRatingRequest{PostId: p.Id, Rating: 1}); err != nil {
+		t.Fatal(err)
+	}
+	p, err = f.s.GetPost(as("bob"), &pb.GetPostRequest{Uuid: guid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.MyRating != 1 || p.Upvotes != 1 || p.Downvotes != 0 {
+		t.Errorf("post has my rating %d, %d up and %d down, want 1, 1 and 0", p.MyRating, p.Upvotes, p.Downvotes)
+	}
+
+	if _, err := f.s.SetPostRating(as("bob"), &pb.SetPostRatingRequest{PostId: 99, Rating: 1}); reason(err) != "POST_NOT_FOUND" {
+		t.Errorf("vote on a missing post returned %v, want POST_NOT_FOUND", err)
+	}
+}
+
+func TestSetCommentRating(t *testing.T) {
+	f := newFixture(t)
+	f.users("ann", "bob")
+	guid := f.post("ann", "post", pb.Visibility_VISIBILITY_PUBLIC)
+	id := f.comment("ann", guid, "comment")
+	before := f.notices("ann")
+
+	for _, vote := range []int32{1, 1} {
+		res, err := f.s.SetCommentRating(as("bob"), &pb.SetCommentRatingRequest{CommentId: id, Rating: vote})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Rating != 1 {
+			t.Errorf("vote %d left rating %d, want 1", vote, res.Rating)
+		}
+	}
+	if n := f.notices("ann") - before; n != 1 {
+		t.Errorf("two equal votes sent %d notifications, want 1", n)
+	}
+
+	if _, err := f.s.SetCommentRating(as("bob"), &pb.SetCommentRatingRequest{CommentId: 99, Rating: 1}); reason(err) != "COMMENT_NOT_FOUND" {
+		t.Errorf("vote on a missing comment returned %v, want COMMENT_NOT_FOUND", err)
+	}
+}
+
+// notices counts the notifications of username
+func (f *fixture) notices(username string) int {
+	f.t.Helper()
+	n, err := f.repos.Notifications.GetNotificationsByUser(context.Background(), int(f.ids[username]), 100, 0)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	return len(n)
+}
@@ -0,0 +1,46 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/Anacardo89/lenic_api/internal/apperr"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"github.com/Anacardo89/lenic_api/pkg/logger"
+)
+
+// Interceptor authenticates requests and checks access against repos
+type Interceptor struct {
+	repos repo.Repositories
+}
+
+func New(r repo.Repositories) *Interceptor {
+	return &Interceptor{repos: r}
+}
+
+// statusError turns err into the status the client gets, see
+// apperr.Status. Internal errors are logged here, the client doesn't get
+// their message
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, internal := apperr.Status(err)
+	if internal {
+		logger.Error.Println("internal error: ", err)
+	}
+	return st.Err()
+}
+
+var (
+	errMissingToken = apperr.Unauthenticated("MISSING_TOKEN", "missing token")
+	errInvalidToken = apperr.Unauthenticated("INVALID_TOKEN", "invalid token")
+)
+
+// deniedError reports a failed access check, unless the check failed
+// because ctx ended
+func deniedError(ctx context.Context, msg string) error {
+	if err := ctx.Err(); err != nil {
+		return statusError(err)
+	}
+	return statusError(apperr.PermissionDenied("ACCESS_DENIED", msg))
+}
@@ -2,80 +2,73 @@ package interceptor
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"reflect"
 
-	"github.com/Anacardo89/lenic_api/internal/pb"
+	"github.com/Anacardo89/lenic_api/internal/bridge"
+	"github.com/Anacardo89/lenic_api/internal/data/loader"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
 	"github.com/Anacardo89/lenic_api/pkg/auth"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
-func AuthStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+func (i *Interceptor) AuthStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 
-	ctx := ss.Context()
+	method := info.FullMethod
+	// v1 streams run the v2 handlers, read their request as v2 too
+	if v2, ok := bridge.Method(method); ok {
+		method, ss = v2, bridge.Stream(ss)
+	}
+
+	ctx := loader.NewContext(ss.Context())
 
 	claims, err := extractClaimsFromContext(ctx)
 	if err != nil {
-		return status.Errorf(codes.Unauthenticated, "failed to extract claims: %v", err)
+		return statusError(err)
 	}
 
-	method := info.FullMethod
-
-	bs := &BufferedStream{ServerStream: ss}
+	bs := &BufferedStream{ServerStream: ss, ctx: auth.NewContext(ctx, claims)}
 
 	req, err := extractRequestFromStream(bs, method)
 	if err != nil {
-		return fmt.Errorf("coul not get request from stream: %v", err)
+		return statusError(fmt.Errorf("could not get request from stream: %w", err))
 	}
 
-	if canBePublic(method) {
-		uuid := getUUIDFromRequest(req)
-
-		if getIsPublic(uuid) {
-			return handler(srv, bs)
-		} else {
-			if !isSelfRequest(claims.Username, req) && !isFollowerRequest(claims.FollowingIDs, req) {
-				return status.Errorf(codes.PermissionDenied, "access denied for private post")
-			}
+	if needsPostAccess(method) {
+		if !i.canViewRequestedPost(ctx, claims.Username, req) {
+			return deniedError(ctx, "access denied for post")
 		}
 	}
 
 	if isUserOnlyAccess(method) {
-		if !isSelfRequest(claims.Username, req) {
-			return status.Errorf(codes.PermissionDenied, "access denied")
-		}
-	} else if isFollowerAccess(method) {
-		if !isSelfRequest(claims.Username, req) && !isFollowerRequest(claims.FollowingIDs, req) {
-			return status.Errorf(codes.PermissionDenied, "access restricted to followers")
+		if !i.isSelfRequest(ctx, claims.Username, req) {
+			return deniedError(ctx, "access denied")
 		}
 	}
-	return handler(srv, bs)
+	return statusError(handler(srv, bs))
 }
 
 func extractClaimsFromContext(ctx context.Context) (*auth.Claims, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, errors.New("metadata not found in context")
+		return nil, errMissingToken
 	}
 
 	authHeaders, exists := md["authorization"]
 	if !exists || len(authHeaders) == 0 {
-		return nil, errors.New("authorization header not provided")
+		return nil, errMissingToken
 	}
 
 	token := extractToken(md)
 	if token == "" {
-		return nil, errors.New("missing token")
+		return nil, errMissingToken
 	}
 
 	claims, err := parseJWT(token)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "invalid token")
+		return nil, errInvalidToken
 	}
 
 	return claims, nil
@@ -90,7 +83,7 @@ func extractRequestFromStream(bs *BufferedStream, method string) (proto.Message,
 
 	err = bs.RecvMsg(req)
 	if err != nil {
-		return nil, fmt.Errorf("could not read message from stream: %v", err)
+		return nil, fmt.Errorf("could not read message from stream: %w", err)
 	}
 
 	return req, nil
@@ -98,24 +91,42 @@ func extractRequestFromStream(bs *BufferedStream, method string) (proto.Message,
 
 func extractRequestType(method string) (proto.Message, error) {
 	switch method {
-	case "/lenic.Lenic/SearchUsers":
+	case "/lenic.v2.Lenic/SearchUsers":
 		return &pb.SearchUsersRequest{}, nil
-	case "/lenic.Lenic/GetUserFollowers":
+	case "/lenic.v2.Lenic/GetUserFollowers":
 		return &pb.GetUserFollowersRequest{}, nil
-	case "/lenic.Lenic/GetUserFollowing":
+	case "/lenic.v2.Lenic/GetUserFollowing":
 		return &pb.GetUserFollowingRequest{}, nil
-	case "/lenic.Lenic/GetUserConversations":
+	case "/lenic.v2.Lenic/ListIncomingFollowRequests":
+		return &pb.ListFollowRequestsRequest{}, nil
+	case "/lenic.v2.Lenic/ListOutgoingFollowRequests":
+		return &pb.ListFollowRequestsRequest{}, nil
+	case "/lenic.v2.Lenic/GetFollowSuggestions":
+		return &pb.GetFollowSuggestionsRequest{}, nil
+	case "/lenic.v2.Lenic/GetCloseFriends":
+		return &pb.GetCloseFriendsRequest{}, nil
+	case "/lenic.v2.Lenic/GetUserConversations":
 		return &pb.GetUserConversationsRequest{}, nil
-	case "/lenic.Lenic/GetConversationDMs":
+	case "/lenic.v2.Lenic/GetConversationDMs":
 		return &pb.GetConversationDMsRequest{}, nil
-	case "/lenic.Lenic/GetUserPosts":
+	case "/lenic.v2.Lenic/GetUserPosts":
 		return &pb.GetUserPostsRequest{}, nil
-	case "/lenic.Lenic/GetUserPublicPosts":
+	case "/lenic.v2.Lenic/GetUserPublicPosts":
 		return &pb.GetUserPublicPostsRequest{}, nil
-	case "/lenic.Lenic/GetFeed":
+	case "/lenic.v2.Lenic/GetFeed":
 		return &pb.GetFeedRequest{}, nil
-	case "/lenic.Lenic/GetCommentsFromPost":
+	case "/lenic.v2.Lenic/SearchPosts":
+		return &pb.SearchPostsRequest{}, nil
+	case "/lenic.v2.Lenic/GetCommentsFromPost":
 		return &pb.GetCommentsFromPostRequest{}, nil
+	case "/lenic.v2.Lenic/SearchComments":
+		return &pb.SearchCommentsRequest{}, nil
+	case "/lenic.v2.Lenic/GetCommentRevisions":
+		return &pb.GetCommentRevisionsRequest{}, nil
+	case "/lenic.v2.Lenic/GetPostRevisions":
+		return &pb.GetPostRevisionsRequest{}, nil
+	case "/lenic.v2.Lenic/GetPostRatings":
+		return &pb.GetPostRatingsRequest{}, nil
 	default:
 		return nil, fmt.Errorf("unknown method: %s", method)
 	}
@@ -123,9 +134,15 @@ func extractRequestType(method string) (proto.Message, error) {
 
 type BufferedStream struct {
 	grpc.ServerStream
+	ctx             context.Context
 	bufferedRequest proto.Message
 }
 
+// Context returns the stream context carrying the caller's claims
+func (bs *BufferedStream) Context() context.Context {
+	return bs.ctx
+}
+
 func (bs *BufferedStream) RecvMsg(m interface{}) error {
 	// If the message is already buffered, return it without consuming the stream
 	if bs.bufferedRequest != nil {
@@ -5,67 +5,65 @@ import (
 	"errors"
 	"strings"
 
-	"github.com/Anacardo89/lenic_api/internal/data/orm"
-	"github.com/Anacardo89/lenic_api/internal/pb"
+	"github.com/Anacardo89/lenic_api/internal/access"
+	"github.com/Anacardo89/lenic_api/internal/data/loader"
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
 	"github.com/Anacardo89/lenic_api/pkg/auth"
 	"github.com/Anacardo89/lenic_api/pkg/logger"
 	"github.com/dgrijalva/jwt-go"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
 )
 
-func AuthUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+func (i *Interceptor) AuthUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	ctx = loader.NewContext(ctx)
 
 	method := info.FullMethod
-	if method == "/lenic.Lenic/Login" || method == "/lenic.Lenic/CreateUser" {
-		return handler(ctx, req)
+	if method == "/lenic.v2.Lenic/Login" || method == "/lenic.v2.Lenic/CreateUser" || method == "/lenic.v2.Lenic/RestoreAccount" {
+		return handle(ctx, req, handler)
 	}
 
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		logger.Error.Println("missing metadata")
-		return nil, errors.New("missing metadata")
+		return nil, statusError(errMissingToken)
 	}
 
 	token := extractToken(md)
 	if token == "" {
 		logger.Error.Println("missing token")
-		return nil, errors.New("missing token")
+		return nil, statusError(errMissingToken)
 	}
 
 	claims, err := parseJWT(token)
 	if err != nil {
 		logger.Error.Println("invalid token")
-		return nil, status.Errorf(codes.Unauthenticated, "invalid token")
+		return nil, statusError(errInvalidToken)
 	}
 
-	if canBePublic(method) {
-		uuid := getUUIDFromRequest(req)
+	ctx = auth.NewContext(ctx, claims)
 
-		if getIsPublic(uuid) {
-			return handler(ctx, req)
-		} else {
-			if !isSelfRequest(claims.Username, req) && !isFollowerRequest(claims.FollowingIDs, req) {
-				logger.Error.Println("access denied for private post")
-				return nil, status.Errorf(codes.PermissionDenied, "access denied for private post")
-			}
+	if needsPostAccess(method) {
+		if !i.canViewRequestedPost(ctx, claims.Username, req) {
+			logger.Error.Println("access denied for post")
+			return nil, deniedError(ctx, "access denied for post")
 		}
 	}
 
 	if isUserOnlyAccess(method) {
-		if !isSelfRequest(claims.Username, req) {
+		if !i.isSelfRequest(ctx, claims.Username, req) {
 			logger.Error.Println("access denied")
-			return nil, status.Errorf(codes.PermissionDenied, "access denied")
-		}
-	} else if isFollowerAccess(method) {
-		if !isSelfRequest(claims.Username, req) && !isFollowerRequest(claims.FollowingIDs, req) {
-			logger.Error.Println("access restricted to followers")
-			return nil, status.Errorf(codes.PermissionDenied, "access restricted to followers")
+			return nil, deniedError(ctx, "access denied")
 		}
 	}
-	return handler(ctx, req)
+	return handle(ctx, req, handler)
+}
+
+func handle(ctx context.Context, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, statusError(err)
 }
 
 func extractToken(md metadata.MD) string {
@@ -90,245 +88,230 @@ func parseJWT(tokenString string) (*auth.Claims, error) {
 	return claims, nil
 }
 
-func isFollowerAccess(method string) bool {
+func needsPostAccess(method string) bool {
 	switch method {
-	case "/lenic.Lenic/GetUserPosts":
+	case "/lenic.v2.Lenic/GetPost":
 		return true
-	default:
-		return false
-	}
-
-}
-
-func canBePublic(method string) bool {
-	switch method {
-	case "/lenic.Lenic/GetPost":
+	case "/lenic.v2.Lenic/GetPostRevisions": //Stream
+		return true
+	case "/lenic.v2.Lenic/GetPostRevision":
+		return true
+	case "/lenic.v2.Lenic/GetPostRatings": //Stream
+		return true
+	case "/lenic.v2.Lenic/GetComment":
+		return true
+	case "/lenic.v2.Lenic/GetCommentsFromPost": //Stream
 		return true
-	case "/lenic.Lenic/GetComment":
+	case "/lenic.v2.Lenic/CreateComment":
 		return true
-	case "/lenic.Lenic/GetCommentsFromPost": //Stream
+	case "/lenic.v2.Lenic/RatePostUp":
 		return true
-	case "/lenic.Lenic/RatePostUp":
+	case "/lenic.v2.Lenic/RatePostDown":
 		return true
-	case "/lenic.Lenic/RatePostDown":
+	case "/lenic.v2.Lenic/RateCommentUp":
 		return true
-	case "/lenic.Lenic/RateCommentUp":
+	case "/lenic.v2.Lenic/RateCommentDown":
 		return true
-	case "/lenic.Lenic/RateCommentDown":
+	case "/lenic.v2.Lenic/SetPostRating":
+		return true
+	case "/lenic.v2.Lenic/SetCommentRating":
 		return true
 	default:
 		return false
 	}
 }
 
-func isFollowerRequest(following []string, request interface{}) bool {
-	switch req := request.(type) {
-	case *pb.GetUserPostsRequest:
-		for _, f := range following {
-			if f == req.Username {
-				return true
-			}
-		}
-		return false
-	case *pb.GetPostRequest:
-		p, err := orm.Da.GetPostByGUID(req.Uuid)
-		if err != nil {
-			return false
-		}
-		u, err := orm.Da.GetUserByID(p.AuthorId)
-		if err != nil {
-			return false
-		}
-		for _, f := range following {
-			if f == u.UserName {
-				return true
-			}
-		}
-		return false
-	case *pb.GetCommentRequest:
-		c, err := orm.Da.GetCommentById(int(req.Id))
-		if err != nil {
-			return false
-		}
-		p, err := orm.Da.GetPostByGUID(c.PostGUID)
-		if err != nil {
-			return false
-		}
-		u, err := orm.Da.GetUserByID(p.AuthorId)
-		if err != nil {
-			return false
-		}
-		for _, f := range following {
-			if f == u.UserName {
-				return true
-			}
-		}
-		return false
-	case *pb.GetCommentsFromPostRequest:
-		p, err := orm.Da.GetPostByGUID(req.Uuid)
-		if err != nil {
-			return false
-		}
-		u, err := orm.Da.GetUserByID(p.AuthorId)
-		if err != nil {
-			return false
-		}
-		for _, f := range following {
-			if f == u.UserName {
-				return true
-			}
-		}
-		return false
-	case *pb.PostRating:
-		p, err := orm.Da.GetPostByID(int(req.PostId))
-		if err != nil {
-			return false
-		}
-		u, err := orm.Da.GetUserByID(p.AuthorId)
-		if err != nil {
-			return false
-		}
-		for _, f := range following {
-			if f == u.UserName {
-				return true
-			}
-		}
+func (i *Interceptor) canViewRequestedPost(ctx context.Context, username string, request interface{}) bool {
+	u, err := i.repos.Users.GetUserByName(ctx, username)
+	if err != nil {
+		logger.Error.Println("could not get user: ", err)
 		return false
-	case *pb.CommentRating:
-		c, err := orm.Da.GetCommentById(int(req.CommentId))
-		if err != nil {
-			return false
-		}
-		u, err := orm.Da.GetUserByID(c.AuthorId)
-		if err != nil {
-			return false
-		}
-		for _, f := range following {
-			if f == u.UserName {
-				return true
-			}
-		}
+	}
+	p, err := i.getPostFromRequest(ctx, request)
+	if err != nil {
+		logger.Error.Println("could not get post from request: ", err)
 		return false
-	default:
+	}
+	ok, err := access.CanViewPost(ctx, i.repos, u.Id, p)
+	if err != nil {
+		logger.Error.Println("could not check post visibility: ", err)
 		return false
 	}
+	return ok
 }
 
-func getUUIDFromRequest(request interface{}) string {
+func (i *Interceptor) getPostFromRequest(ctx context.Context, request interface{}) (*model.Post, error) {
 	switch req := request.(type) {
 	case *pb.GetPostRequest:
-		return req.Uuid
+		return i.repos.Posts.GetPostByGUID(ctx, req.Uuid)
 	case *pb.GetCommentRequest:
-		c, err := orm.Da.GetCommentById(int(req.Id))
+		c, err := i.repos.Comments.GetCommentById(ctx, int(req.Id))
 		if err != nil {
-			return ""
+			return nil, err
 		}
-		return c.PostGUID
+		return i.repos.Posts.GetPostByGUID(ctx, c.PostGUID)
 	case *pb.GetCommentsFromPostRequest:
-		return req.Uuid
+		return i.repos.Posts.GetPostByGUID(ctx, req.Uuid)
+	case *pb.GetPostRevisionsRequest:
+		return i.repos.Posts.GetPostByGUID(ctx, req.Uuid)
+	case *pb.GetPostRevisionRequest:
+		return i.repos.Posts.GetPostByGUID(ctx, req.Uuid)
+	case *pb.GetPostRatingsRequest:
+		return i.repos.Posts.GetPostByGUID(ctx, req.Uuid)
+	case *pb.Comment:
+		return i.repos.Posts.GetPostByGUID(ctx, req.PostGuid)
 	case *pb.PostRating:
-		logger.Debug.Println(req.PostId)
-		logger.Debug.Println(int(req.PostId))
-		p, err := orm.Da.GetPostByID(int(req.PostId))
+		return i.repos.Posts.GetPostByID(ctx, int(req.PostId))
+	case *pb.CommentRating:
+		c, err := i.repos.Comments.GetCommentById(ctx, int(req.CommentId))
 		if err != nil {
-			logger.Error.Println("could not get post from id: ", err)
-			return ""
+			return nil, err
 		}
-		return p.GUID
-	case *pb.CommentRating:
-		c, err := orm.Da.GetCommentById(int(req.CommentId))
+		return i.repos.Posts.GetPostByGUID(ctx, c.PostGUID)
+	case *pb.SetPostRatingRequest:
+		return i.repos.Posts.GetPostByID(ctx, int(req.PostId))
+	case *pb.SetCommentRatingRequest:
+		c, err := i.repos.Comments.GetCommentById(ctx, int(req.CommentId))
 		if err != nil {
-			return ""
+			return nil, err
 		}
-		return c.PostGUID
+		return i.repos.Posts.GetPostByGUID(ctx, c.PostGUID)
 	default:
-		return ""
-	}
-}
-
-func getIsPublic(uuid string) bool {
-	logger.Debug.Println("UUID: ", uuid)
-	p, err := orm.Da.GetPostByGUID(uuid)
-	if err != nil {
-		logger.Error.Println("could not get post by UUID", err)
-		return false
+		return nil, errors.New("request does not reference a post")
 	}
-	return p.IsPublic
 }
 
 func isUserOnlyAccess(method string) bool {
 	switch method {
-	case "/lenic.Lenic/ActivateUser":
+	case "/lenic.v2.Lenic/ActivateUser":
+		return true
+	case "/lenic.v2.Lenic/UpdateUserPass":
+		return true
+	case "/lenic.v2.Lenic/UpdateUser":
+		return true
+	case "/lenic.v2.Lenic/DeleteUser":
+		return true
+	case "/lenic.v2.Lenic/SetAccountPrivacy":
+		return true
+	case "/lenic.v2.Lenic/AddCloseFriend":
+		return true
+	case "/lenic.v2.Lenic/RemoveCloseFriend":
+		return true
+	case "/lenic.v2.Lenic/GetCloseFriends": // stream
+		return true
+	case "/lenic.v2.Lenic/FollowUser":
+		return true
+	case "/lenic.v2.Lenic/AcceptFollow":
+		return true
+	case "/lenic.v2.Lenic/UnfollowUser":
 		return true
-	case "/lenic.Lenic/UpdateUserPass":
+	case "/lenic.v2.Lenic/RejectFollow":
 		return true
-	case "/lenic.Lenic/DeleteUser":
+	case "/lenic.v2.Lenic/CancelFollowRequest":
 		return true
-	case "/lenic.Lenic/FollowUser":
+	case "/lenic.v2.Lenic/ListIncomingFollowRequests": // stream
 		return true
-	case "/lenic.Lenic/AcceptFollowUser":
+	case "/lenic.v2.Lenic/ListOutgoingFollowRequests": // stream
 		return true
-	case "/lenic.Lenic/UnfollowUser":
+	case "/lenic.v2.Lenic/GetFollowSuggestions": // stream
 		return true
-	case "/lenic.Lenic/StartConversation":
+	case "/lenic.v2.Lenic/BlockUser":
 		return true
-	case "/lenic.Lenic/GetUserConversations": // stream
+	case "/lenic.v2.Lenic/UnblockUser":
 		return true
-	case "/lenic.Lenic/ReadConversation":
+	case "/lenic.v2.Lenic/MuteUser":
 		return true
-	case "/lenic.Lenic/SendDM":
+	case "/lenic.v2.Lenic/UnmuteUser":
 		return true
-	case "/lenic.Lenic/GetConversationDMs": // stream
+	case "/lenic.v2.Lenic/StartConversation":
 		return true
-	case "/lenic.Lenic/CreatePost":
+	case "/lenic.v2.Lenic/GetUserConversations": // stream
 		return true
-	case "/lenic.Lenic/GetFeed": // stream
+	case "/lenic.v2.Lenic/ReadConversation":
 		return true
-	case "/lenic.Lenic/UpdatePost":
+	case "/lenic.v2.Lenic/SendDM":
 		return true
-	case "/lenic.Lenic/DeletePost":
+	case "/lenic.v2.Lenic/GetConversationDMs": // stream
 		return true
-	case "/lenic.Lenic/CreateComment":
+	case "/lenic.v2.Lenic/CreatePost":
 		return true
-	case "/lenic.Lenic/UpdateComment":
+	case "/lenic.v2.Lenic/GetFeed": // stream
 		return true
-	case "/lenic.Lenic/DeleteComment":
+	case "/lenic.v2.Lenic/UpdatePost":
+		return true
+	case "/lenic.v2.Lenic/DeletePost":
+		return true
+	case "/lenic.v2.Lenic/CreateComment":
+		return true
+	case "/lenic.v2.Lenic/UpdateComment":
+		return true
+	case "/lenic.v2.Lenic/DeleteComment":
+		return true
+	case "/lenic.v2.Lenic/GetCommentRevisions": // stream
 		return true
 	default:
 		return false
 	}
 }
 
-func isSelfRequest(username string, request interface{}) bool {
+func (i *Interceptor) isSelfRequest(ctx context.Context, username string, request interface{}) bool {
 	switch req := request.(type) {
 	case *pb.User:
 		return req.Username == username
+	case *pb.UpdateUserRequest:
+		return req.GetUser().GetUsername() == username
 	case *pb.DeleteUserRequest:
 		return req.Username == username
+	case *pb.SetAccountPrivacyRequest:
+		return req.Username == username
+	case *pb.CloseFriendRequest:
+		return req.Username == username
+	case *pb.GetCloseFriendsRequest:
+		return req.Username == username
 	case *pb.FollowUserRequest:
-		u, err := orm.Da.GetUserByID(int(req.FollowerId))
+		u, err := i.repos.Users.GetUserByID(ctx, int(req.FollowerId))
 		if err != nil {
 			return false
 		}
 		return u.UserName == username
 	case *pb.AcceptFollowRequest:
-		u, err := orm.Da.GetUserByID(int(req.FollowedId))
+		u, err := i.repos.Users.GetUserByID(ctx, int(req.FollowedId))
 		if err != nil {
 			return false
 		}
 		return u.UserName == username
 	case *pb.UnfollowRequest:
-		u, err := orm.Da.GetUserByID(int(req.FollowerId))
+		u, err := i.repos.Users.GetUserByID(ctx, int(req.FollowerId))
 		if err != nil {
 			return false
 		}
 		return u.UserName == username
+	case *pb.RejectFollowRequest:
+		u, err := i.repos.Users.GetUserByID(ctx, int(req.FollowedId))
+		if err != nil {
+			return false
+		}
+		return u.UserName == username
+	case *pb.CancelFollowRequestRequest:
+		u, err := i.repos.Users.GetUserByID(ctx, int(req.FollowerId))
+		if err != nil {
+			return false
+		}
+		return u.UserName == username
+	case *pb.ListFollowRequestsRequest:
+		return req.Username == username
+	case *pb.GetFollowSuggestionsRequest:
+		return req.Username == username
+	case *pb.BlockRequest:
+		return req.Username == username
+	case *pb.MuteRequest:
+		return req.Username == username
 	case *pb.Conversation:
-		u1, err := orm.Da.GetUserByID(int(req.User1Id))
+		u1, err := i.repos.Users.GetUserByID(ctx, int(req.User1Id))
 		if err != nil {
 			return false
 		}
-		u2, err := orm.Da.GetUserByID(int(req.User1Id))
+		u2, err := i.repos.Users.GetUserByID(ctx, int(req.User2Id))
 		if err != nil {
 			return false
 		}
@@ -336,56 +319,58 @@ func isSelfRequest(username string, request interface{}) bool {
 	case *pb.GetUserConversationsRequest:
 		return req.Username == username
 	case *pb.ReadConversationRequest:
-		c, err := orm.Da.GetConversationById(int(req.Id))
+		c, err := i.repos.Conversations.GetConversationById(ctx, int(req.Id))
 		if err != nil {
 			return false
 		}
-		u1, err := orm.Da.GetUserByID(int(c.User1Id))
+		u1, err := i.repos.Users.GetUserByID(ctx, int(c.User1Id))
 		if err != nil {
 			return false
 		}
-		u2, err := orm.Da.GetUserByID(int(c.User2Id))
+		u2, err := i.repos.Users.GetUserByID(ctx, int(c.User2Id))
 		if err != nil {
 			return false
 		}
 		return u1.UserName == username || u2.UserName == username
 	case *pb.DM:
-		u, err := orm.Da.GetUserByID(int(req.SenderId))
+		u, err := i.repos.Users.GetUserByID(ctx, int(req.SenderId))
 		if err != nil {
 			return false
 		}
 		return u.UserName == username
 	case *pb.GetConversationDMsRequest:
-		c, err := orm.Da.GetConversationById(int(req.Id))
+		c, err := i.repos.Conversations.GetConversationById(ctx, int(req.Id))
 		if err != nil {
 			return false
 		}
-		u1, err := orm.Da.GetUserByID(int(c.User1Id))
+		u1, err := i.repos.Users.GetUserByID(ctx, int(c.User1Id))
 		if err != nil {
 			return false
 		}
-		u2, err := orm.Da.GetUserByID(int(c.User2Id))
+		u2, err := i.repos.Users.GetUserByID(ctx, int(c.User2Id))
 		if err != nil {
 			return false
 		}
 		return u1.UserName == username || u2.UserName == username
 	case *pb.Post:
-		p, err := orm.Da.GetPostByGUID(req.PostGuid)
+		p, err := i.repos.Posts.GetPostByGUID(ctx, req.PostGuid)
 		if err != nil {
 			return false
 		}
-		u, err := orm.Da.GetUserByID(p.AuthorId)
+		u, err := i.repos.Users.GetUserByID(ctx, p.AuthorId)
 		if err != nil {
 			return false
 		}
 		return u.UserName == username
+	case *pb.UpdatePostRequest:
+		return req.Post != nil && i.isSelfRequest(ctx, username, req.Post)
 	case *pb.DeletePostRequest:
-		p, err := orm.Da.GetPostByGUID(req.Uuid)
+		p, err := i.repos.Posts.GetPostByGUID(ctx, req.Uuid)
 		if err != nil {
 			logger.Error.Println("could not get post: ", err)
 			return false
 		}
-		u, err := orm.Da.GetUserByID(p.AuthorId)
+		u, err := i.repos.Users.GetUserByID(ctx, p.AuthorId)
 		if err != nil {
 			logger.Error.Println("could not get user: ", err)
 			return false
@@ -395,33 +380,31 @@ func isSelfRequest(username string, request interface{}) bool {
 		return req.Username == username
 	case *pb.Comment:
 		if req.Id > 0 {
-			c, err := orm.Da.GetCommentById(int(req.Id))
+			c, err := i.repos.Comments.GetCommentById(ctx, int(req.Id))
 			if err != nil {
 				return false
 			}
-			u, err := orm.Da.GetUserByID(c.AuthorId)
+			u, err := i.repos.Users.GetUserByID(ctx, c.AuthorId)
 			if err != nil {
 				return false
 			}
 			return u.UserName == username
 
 		} else {
-			p, err := orm.Da.GetPostByGUID(req.PostGuid)
-			if err != nil {
-				return false
-			}
-			u, err := orm.Da.GetUserByID(p.AuthorId)
+			u, err := i.repos.Users.GetUserByID(ctx, int(req.AuthorId))
 			if err != nil {
 				return false
 			}
 			return u.UserName == username
 		}
+	case *pb.UpdateCommentRequest:
+		return req.Comment != nil && i.isSelfRequest(ctx, username, req.Comment)
 	case *pb.DeleteCommentRequest:
-		c, err := orm.Da.GetCommentById(int(req.Id))
+		c, err := i.repos.Comments.GetCommentById(ctx, int(req.Id))
 		if err != nil {
 			return false
 		}
-		u, err := orm.Da.GetUserByID(c.AuthorId)
+		u, err := i.repos.Users.GetUserByID(ctx, c.AuthorId)
 		if err != nil {
 			return false
 		}
@@ -429,55 +412,69 @@ func isSelfRequest(username string, request interface{}) bool {
 	case *pb.GetUserPostsRequest:
 		return req.Username == username
 	case *pb.GetPostRequest:
-		p, err := orm.Da.GetPostByGUID(req.Uuid)
+		p, err := i.repos.Posts.GetPostByGUID(ctx, req.Uuid)
 		if err != nil {
 			return false
 		}
-		u, err := orm.Da.GetUserByID(p.AuthorId)
+		u, err := i.repos.Users.GetUserByID(ctx, p.AuthorId)
 		if err != nil {
 			return false
 		}
 		return u.UserName == username
 	case *pb.GetCommentRequest:
-		c, err := orm.Da.GetCommentById(int(req.Id))
+		c, err := i.repos.Comments.GetCommentById(ctx, int(req.Id))
 		if err != nil {
 			return false
 		}
-		p, err := orm.Da.GetPostByGUID(c.PostGUID)
+		p, err := i.repos.Posts.GetPostByGUID(ctx, c.PostGUID)
 		if err != nil {
 			return false
 		}
-		u, err := orm.Da.GetUserByID(p.AuthorId)
+		u, err := i.repos.Users.GetUserByID(ctx, p.AuthorId)
 		if err != nil {
 			return false
 		}
 		return u.UserName == username
 	case *pb.GetCommentsFromPostRequest:
-		p, err := orm.Da.GetPostByGUID(req.Uuid)
+		p, err := i.repos.Posts.GetPostByGUID(ctx, req.Uuid)
 		if err != nil {
 			return false
 		}
-		u, err := orm.Da.GetUserByID(p.AuthorId)
+		u, err := i.repos.Users.GetUserByID(ctx, p.AuthorId)
 		if err != nil {
 			return false
 		}
 		return u.UserName == username
+	case *pb.GetCommentRevisionsRequest:
+		u, err := i.repos.Users.GetUserByName(ctx, username)
+		if err != nil {
+			return false
+		}
+		c, err := i.repos.Comments.GetCommentById(ctx, int(req.Id))
+		if err != nil {
+			return false
+		}
+		p, err := i.repos.Posts.GetPostByGUID(ctx, c.PostGUID)
+		if err != nil {
+			return false
+		}
+		return u.Id == c.AuthorId || u.Id == p.AuthorId
 	case *pb.PostRating:
-		p, err := orm.Da.GetPostByID(int(req.PostId))
+		p, err := i.repos.Posts.GetPostByID(ctx, int(req.PostId))
 		if err != nil {
 			return false
 		}
-		u, err := orm.Da.GetUserByID(p.AuthorId)
+		u, err := i.repos.Users.GetUserByID(ctx, p.AuthorId)
 		if err != nil {
 			return false
 		}
 		return u.UserName == username
 	case *pb.CommentRating:
-		c, err := orm.Da.GetCommentById(int(req.CommentId))
+		c, err := i.repos.Comments.GetCommentById(ctx, int(req.CommentId))
 		if err != nil {
 			return false
 		}
-		u, err := orm.Da.GetUserByID(c.AuthorId)
+		u, err := i.repos.Users.GetUserByID(ctx, c.AuthorId)
 		if err != nil {
 			return false
 		}
@@ -486,60 +483,3 @@ func isSelfRequest(username string, request interface{}) bool {
 		return false
 	}
 }
-
-// func getAuthorFromRequest(request interface{}) string {
-// 	switch req := request.(type) {
-// 	case *pb.GetPostRequest:
-// 		p, err := orm.Da.GetPostByGUID(req.Uuid)
-// 		if err != nil {
-// 			return ""
-// 		}
-// 		u, err := orm.Da.GetUserByID(p.AuthorId)
-// 		if err != nil {
-// 			return ""
-// 		}
-// 		return u.UserName
-// 	case *pb.GetCommentRequest:
-// 		c, err := orm.Da.GetCommentById(int(req.Id))
-// 		if err != nil {
-// 			return ""
-// 		}
-// 		u, err := orm.Da.GetUserByID(c.AuthorId)
-// 		if err != nil {
-// 			return ""
-// 		}
-// 		return u.UserName
-// 	case *pb.GetCommentsFromPostRequest:
-// 		p, err := orm.Da.GetPostByGUID(req.Uuid)
-// 		if err != nil {
-// 			return ""
-// 		}
-// 		u, err := orm.Da.GetUserByID(p.AuthorId)
-// 		if err != nil {
-// 			return ""
-// 		}
-// 		return u.UserName
-// 	case *pb.PostRating:
-// 		p, err := orm.Da.GetPostByID(int(req.PostId))
-// 		if err != nil {
-// 			return ""
-// 		}
-// 		u, err := orm.Da.GetUserByID(p.AuthorId)
-// 		if err != nil {
-// 			return ""
-// 		}
-// 		return u.UserName
-// 	case *pb.CommentRating:
-// 		c, err := orm.Da.GetCommentById(int(req.CommentId))
-// 		if err != nil {
-// 			return ""
-// 		}
-// 		u, err := orm.Da.GetUserByID(c.AuthorId)
-// 		if err != nil {
-// 			return ""
-// 		}
-// 		return u.UserName
-// 	default:
-// 		return ""
-// 	}
-// }
@@ -0,0 +1,48 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/Anacardo89/lenic_api/internal/bridge"
+	"github.com/Anacardo89/lenic_api/internal/validate"
+	"github.com/Anacardo89/lenic_api/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidateUnaryInterceptor fails requests that break the rules of their
+// method, see package validate. It goes before the auth interceptors, so
+// their access checks only ever see valid requests
+func ValidateUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := validate.Request(info.FullMethod, req.(proto.Message)); err != nil {
+		logger.Error.Println(err)
+		return nil, statusError(err)
+	}
+	return handler(ctx, req)
+}
+
+// ValidateStreamInterceptor is ValidateUnaryInterceptor for streams, the
+// request is checked when it's read
+func ValidateStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	method := info.FullMethod
+	if v2, ok := bridge.Method(method); ok {
+		method, ss = v2, bridge.Stream(ss)
+	}
+	return handler(srv, &validatedStream{ServerStream: ss, method: method})
+}
+
+type validatedStream struct {
+	grpc.ServerStream
+	method string
+}
+
+func (s *validatedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if err := validate.Request(s.method, m.(proto.Message)); err != nil {
+		logger.Error.Println(err)
+		return err
+	}
+	return nil
+}
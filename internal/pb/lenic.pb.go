@@ -20,6 +20,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Post
+type Visibility int32
+
+const (
+	// treated as VISIBILITY_FOLLOWERS
+	Visibility_VISIBILITY_UNSPECIFIED Visibility = 0
+	Visibility_VISIBILITY_PUBLIC      Visibility = 1
+	// anyone with the uuid, left out of feeds and profiles
+	Visibility_VISIBILITY_UNLISTED      Visibility = 2
+	Visibility_VISIBILITY_FOLLOWERS     Visibility = 3
+	Visibility_VISIBILITY_CLOSE_FRIENDS Visibility = 4
+	// only the users in audience_ids
+	Visibility_VISIBILITY_SPECIFIC_USERS Visibility = 5
+)
+
+// Enum value maps for Visibility.
+var (
+	Visibility_name = map[int32]string{
+		0: "VISIBILITY_UNSPECIFIED",
+		1: "VISIBILITY_PUBLIC",
+		2: "VISIBILITY_UNLISTED",
+		3: "VISIBILITY_FOLLOWERS",
+		4: "VISIBILITY_CLOSE_FRIENDS",
+		5: "VISIBILITY_SPECIFIC_USERS",
+	}
+	Visibility_value = map[string]int32{
+		"VISIBILITY_UNSPECIFIED":    0,
+		"VISIBILITY_PUBLIC":         1,
+		"VISIBILITY_UNLISTED":       2,
+		"VISIBILITY_FOLLOWERS":      3,
+		"VISIBILITY_CLOSE_FRIENDS":  4,
+		"VISIBILITY_SPECIFIC_USERS": 5,
+	}
+)
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
+}
+
+func (x Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_lenic_proto_enumTypes[0].Descriptor()
+}
+
+func (Visibility) Type() protoreflect.EnumType {
+	return &file_lenic_proto_enumTypes[0]
+}
+
+func (x Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Visibility.Descriptor instead.
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{0}
+}
+
+// Login
 type LoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -118,6 +181,7 @@ func (x *LoginResponse) GetToken() string {
 	return ""
 }
 
+// User
 type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -133,6 +197,7 @@ type User struct {
 	CreatedAt     string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	Active        int32  `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
+	IsPrivate     bool   `protobuf:"varint,11,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
 }
 
 func (x *User) Reset() {
@@ -235,6 +300,13 @@ func (x *User) GetActive() int32 {
 	return 0
 }
 
+func (x *User) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
 type CreateUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -325,12 +397,20 @@ func (x *GetUserRequest) GetUsername() string {
 	return ""
 }
 
+// SearchUsersRequest ranks exact matches of username first, then names
+// starting with it, then names holding it and last names a typo or two
+// away. Within each, users followed by people the caller follows and
+// users with more followers come first
 type SearchUsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// defaults to 20, at most 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the next-page-token trailer of the previous page, empty for the first
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *SearchUsersRequest) Reset() {
@@ -370,12 +450,30 @@ func (x *SearchUsersRequest) GetUsername() string {
 	return ""
 }
 
+func (x *SearchUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetUserFollowersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// at most 200, defaults to 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the next-page-token trailer of the previous page, empty for the first
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *GetUserFollowersRequest) Reset() {
@@ -415,12 +513,30 @@ func (x *GetUserFollowersRequest) GetUsername() string {
 	return ""
 }
 
+func (x *GetUserFollowersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserFollowersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetUserFollowingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// at most 200, defaults to 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the next-page-token trailer of the previous page, empty for the first
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *GetUserFollowingRequest) Reset() {
@@ -460,11 +576,26 @@ func (x *GetUserFollowingRequest) GetUsername() string {
 	return ""
 }
 
+func (x *GetUserFollowingRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserFollowingRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type UpdateUserPassResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// OK/NOK
 	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
@@ -555,6 +686,7 @@ type DeleteUserResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// OK/NOK
 	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
@@ -595,29 +727,29 @@ func (x *DeleteUserResponse) GetResponse() string {
 	return ""
 }
 
-type FollowUserRequest struct {
+type RestoreAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FollowerId int32 `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
-	FollowedId int32 `protobuf:"varint,2,opt,name=followed_id,json=followedId,proto3" json:"followed_id,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *FollowUserRequest) Reset() {
-	*x = FollowUserRequest{}
+func (x *RestoreAccountRequest) Reset() {
+	*x = RestoreAccountRequest{}
 	mi := &file_lenic_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowUserRequest) String() string {
+func (x *RestoreAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowUserRequest) ProtoMessage() {}
+func (*RestoreAccountRequest) ProtoMessage() {}
 
-func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -629,47 +761,48 @@ func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
-func (*FollowUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreAccountRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAccountRequest) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *FollowUserRequest) GetFollowerId() int32 {
+func (x *RestoreAccountRequest) GetUsername() string {
 	if x != nil {
-		return x.FollowerId
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *FollowUserRequest) GetFollowedId() int32 {
+func (x *RestoreAccountRequest) GetPassword() string {
 	if x != nil {
-		return x.FollowedId
+		return x.Password
 	}
-	return 0
+	return ""
 }
 
-type FollowUserResponse struct {
+type RestoreAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// OK/NOK
 	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *FollowUserResponse) Reset() {
-	*x = FollowUserResponse{}
+func (x *RestoreAccountResponse) Reset() {
+	*x = RestoreAccountResponse{}
 	mi := &file_lenic_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowUserResponse) String() string {
+func (x *RestoreAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowUserResponse) ProtoMessage() {}
+func (*RestoreAccountResponse) ProtoMessage() {}
 
-func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
+func (x *RestoreAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,41 +814,41 @@ func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
-func (*FollowUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreAccountResponse.ProtoReflect.Descriptor instead.
+func (*RestoreAccountResponse) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *FollowUserResponse) GetResponse() string {
+func (x *RestoreAccountResponse) GetResponse() string {
 	if x != nil {
 		return x.Response
 	}
 	return ""
 }
 
-type AcceptFollowRequest struct {
+type SetAccountPrivacyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FollowerId int32 `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
-	FollowedId int32 `protobuf:"varint,2,opt,name=followed_id,json=followedId,proto3" json:"followed_id,omitempty"`
+	Username  string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	IsPrivate bool   `protobuf:"varint,2,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
 }
 
-func (x *AcceptFollowRequest) Reset() {
-	*x = AcceptFollowRequest{}
+func (x *SetAccountPrivacyRequest) Reset() {
+	*x = SetAccountPrivacyRequest{}
 	mi := &file_lenic_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptFollowRequest) String() string {
+func (x *SetAccountPrivacyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptFollowRequest) ProtoMessage() {}
+func (*SetAccountPrivacyRequest) ProtoMessage() {}
 
-func (x *AcceptFollowRequest) ProtoReflect() protoreflect.Message {
+func (x *SetAccountPrivacyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -727,47 +860,48 @@ func (x *AcceptFollowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptFollowRequest.ProtoReflect.Descriptor instead.
-func (*AcceptFollowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAccountPrivacyRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountPrivacyRequest) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *AcceptFollowRequest) GetFollowerId() int32 {
+func (x *SetAccountPrivacyRequest) GetUsername() string {
 	if x != nil {
-		return x.FollowerId
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *AcceptFollowRequest) GetFollowedId() int32 {
+func (x *SetAccountPrivacyRequest) GetIsPrivate() bool {
 	if x != nil {
-		return x.FollowedId
+		return x.IsPrivate
 	}
-	return 0
+	return false
 }
 
-type AcceptFollowResponse struct {
+type SetAccountPrivacyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// OK/NOK
 	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *AcceptFollowResponse) Reset() {
-	*x = AcceptFollowResponse{}
+func (x *SetAccountPrivacyResponse) Reset() {
+	*x = SetAccountPrivacyResponse{}
 	mi := &file_lenic_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptFollowResponse) String() string {
+func (x *SetAccountPrivacyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptFollowResponse) ProtoMessage() {}
+func (*SetAccountPrivacyResponse) ProtoMessage() {}
 
-func (x *AcceptFollowResponse) ProtoReflect() protoreflect.Message {
+func (x *SetAccountPrivacyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -779,41 +913,42 @@ func (x *AcceptFollowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptFollowResponse.ProtoReflect.Descriptor instead.
-func (*AcceptFollowResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAccountPrivacyResponse.ProtoReflect.Descriptor instead.
+func (*SetAccountPrivacyResponse) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *AcceptFollowResponse) GetResponse() string {
+func (x *SetAccountPrivacyResponse) GetResponse() string {
 	if x != nil {
 		return x.Response
 	}
 	return ""
 }
 
-type UnfollowRequest struct {
+// Close Friends
+type CloseFriendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FollowerId int32 `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
-	FollowedId int32 `protobuf:"varint,2,opt,name=followed_id,json=followedId,proto3" json:"followed_id,omitempty"`
+	Username       string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	FriendUsername string `protobuf:"bytes,2,opt,name=friend_username,json=friendUsername,proto3" json:"friend_username,omitempty"`
 }
 
-func (x *UnfollowRequest) Reset() {
-	*x = UnfollowRequest{}
+func (x *CloseFriendRequest) Reset() {
+	*x = CloseFriendRequest{}
 	mi := &file_lenic_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowRequest) String() string {
+func (x *CloseFriendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowRequest) ProtoMessage() {}
+func (*CloseFriendRequest) ProtoMessage() {}
 
-func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
+func (x *CloseFriendRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -825,47 +960,48 @@ func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowRequest.ProtoReflect.Descriptor instead.
-func (*UnfollowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseFriendRequest.ProtoReflect.Descriptor instead.
+func (*CloseFriendRequest) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UnfollowRequest) GetFollowerId() int32 {
+func (x *CloseFriendRequest) GetUsername() string {
 	if x != nil {
-		return x.FollowerId
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *UnfollowRequest) GetFollowedId() int32 {
+func (x *CloseFriendRequest) GetFriendUsername() string {
 	if x != nil {
-		return x.FollowedId
+		return x.FriendUsername
 	}
-	return 0
+	return ""
 }
 
-type UnfollowUserResponse struct {
+type CloseFriendResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// OK/NOK
 	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *UnfollowUserResponse) Reset() {
-	*x = UnfollowUserResponse{}
+func (x *CloseFriendResponse) Reset() {
+	*x = CloseFriendResponse{}
 	mi := &file_lenic_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowUserResponse) String() string {
+func (x *CloseFriendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowUserResponse) ProtoMessage() {}
+func (*CloseFriendResponse) ProtoMessage() {}
 
-func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
+func (x *CloseFriendResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -877,44 +1013,40 @@ func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
-func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseFriendResponse.ProtoReflect.Descriptor instead.
+func (*CloseFriendResponse) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UnfollowUserResponse) GetResponse() string {
+func (x *CloseFriendResponse) GetResponse() string {
 	if x != nil {
 		return x.Response
 	}
 	return ""
 }
 
-type Conversation struct {
+type GetCloseFriendsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	User1Id   int32  `protobuf:"varint,2,opt,name=user1_id,json=user1Id,proto3" json:"user1_id,omitempty"`
-	User2Id   int32  `protobuf:"varint,3,opt,name=user2_id,json=user2Id,proto3" json:"user2_id,omitempty"`
-	CreatedAt string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt string `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *Conversation) Reset() {
-	*x = Conversation{}
+func (x *GetCloseFriendsRequest) Reset() {
+	*x = GetCloseFriendsRequest{}
 	mi := &file_lenic_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Conversation) String() string {
+func (x *GetCloseFriendsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Conversation) ProtoMessage() {}
+func (*GetCloseFriendsRequest) ProtoMessage() {}
 
-func (x *Conversation) ProtoReflect() protoreflect.Message {
+func (x *GetCloseFriendsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -926,68 +1058,42 @@ func (x *Conversation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
-func (*Conversation) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCloseFriendsRequest.ProtoReflect.Descriptor instead.
+func (*GetCloseFriendsRequest) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Conversation) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Conversation) GetUser1Id() int32 {
-	if x != nil {
-		return x.User1Id
-	}
-	return 0
-}
-
-func (x *Conversation) GetUser2Id() int32 {
-	if x != nil {
-		return x.User2Id
-	}
-	return 0
-}
-
-func (x *Conversation) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *Conversation) GetUpdatedAt() string {
+func (x *GetCloseFriendsRequest) GetUsername() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Username
 	}
 	return ""
 }
 
-type StartConversationResponse struct {
+// Follow
+type FollowUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FollowerId int32 `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FollowedId int32 `protobuf:"varint,2,opt,name=followed_id,json=followedId,proto3" json:"followed_id,omitempty"`
 }
 
-func (x *StartConversationResponse) Reset() {
-	*x = StartConversationResponse{}
+func (x *FollowUserRequest) Reset() {
+	*x = FollowUserRequest{}
 	mi := &file_lenic_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartConversationResponse) String() string {
+func (x *FollowUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartConversationResponse) ProtoMessage() {}
+func (*FollowUserRequest) ProtoMessage() {}
 
-func (x *StartConversationResponse) ProtoReflect() protoreflect.Message {
+func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -999,40 +1105,48 @@ func (x *StartConversationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartConversationResponse.ProtoReflect.Descriptor instead.
-func (*StartConversationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
+func (*FollowUserRequest) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *StartConversationResponse) GetId() int32 {
+func (x *FollowUserRequest) GetFollowerId() int32 {
 	if x != nil {
-		return x.Id
+		return x.FollowerId
 	}
 	return 0
 }
 
-type GetUserConversationsRequest struct {
+func (x *FollowUserRequest) GetFollowedId() int32 {
+	if x != nil {
+		return x.FollowedId
+	}
+	return 0
+}
+
+type FollowUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// OK/NOK
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *GetUserConversationsRequest) Reset() {
-	*x = GetUserConversationsRequest{}
+func (x *FollowUserResponse) Reset() {
+	*x = FollowUserResponse{}
 	mi := &file_lenic_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserConversationsRequest) String() string {
+func (x *FollowUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserConversationsRequest) ProtoMessage() {}
+func (*FollowUserResponse) ProtoMessage() {}
 
-func (x *GetUserConversationsRequest) ProtoReflect() protoreflect.Message {
+func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1044,40 +1158,41 @@ func (x *GetUserConversationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserConversationsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserConversationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
+func (*FollowUserResponse) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetUserConversationsRequest) GetUsername() string {
+func (x *FollowUserResponse) GetResponse() string {
 	if x != nil {
-		return x.Username
+		return x.Response
 	}
 	return ""
 }
 
-type ReadConversationRequest struct {
+type AcceptFollowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FollowerId int32 `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FollowedId int32 `protobuf:"varint,2,opt,name=followed_id,json=followedId,proto3" json:"followed_id,omitempty"`
 }
 
-func (x *ReadConversationRequest) Reset() {
-	*x = ReadConversationRequest{}
+func (x *AcceptFollowRequest) Reset() {
+	*x = AcceptFollowRequest{}
 	mi := &file_lenic_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadConversationRequest) String() string {
+func (x *AcceptFollowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadConversationRequest) ProtoMessage() {}
+func (*AcceptFollowRequest) ProtoMessage() {}
 
-func (x *ReadConversationRequest) ProtoReflect() protoreflect.Message {
+func (x *AcceptFollowRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1089,40 +1204,48 @@ func (x *ReadConversationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadConversationRequest.ProtoReflect.Descriptor instead.
-func (*ReadConversationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptFollowRequest.ProtoReflect.Descriptor instead.
+func (*AcceptFollowRequest) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ReadConversationRequest) GetId() int32 {
+func (x *AcceptFollowRequest) GetFollowerId() int32 {
 	if x != nil {
-		return x.Id
+		return x.FollowerId
 	}
 	return 0
 }
 
-type ReadConversationResponse struct {
+func (x *AcceptFollowRequest) GetFollowedId() int32 {
+	if x != nil {
+		return x.FollowedId
+	}
+	return 0
+}
+
+type AcceptFollowResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// OK/NOK
 	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *ReadConversationResponse) Reset() {
-	*x = ReadConversationResponse{}
+func (x *AcceptFollowResponse) Reset() {
+	*x = AcceptFollowResponse{}
 	mi := &file_lenic_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadConversationResponse) String() string {
+func (x *AcceptFollowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadConversationResponse) ProtoMessage() {}
+func (*AcceptFollowResponse) ProtoMessage() {}
 
-func (x *ReadConversationResponse) ProtoReflect() protoreflect.Message {
+func (x *AcceptFollowResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1134,45 +1257,41 @@ func (x *ReadConversationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadConversationResponse.ProtoReflect.Descriptor instead.
-func (*ReadConversationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptFollowResponse.ProtoReflect.Descriptor instead.
+func (*AcceptFollowResponse) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ReadConversationResponse) GetResponse() string {
+func (x *AcceptFollowResponse) GetResponse() string {
 	if x != nil {
 		return x.Response
 	}
 	return ""
 }
 
-type DM struct {
+type UnfollowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ConversationId int32  `protobuf:"varint,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	SenderId       int32  `protobuf:"varint,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	Content        string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	IsRead         bool   `protobuf:"varint,5,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
-	CreatedAt      string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FollowerId int32 `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FollowedId int32 `protobuf:"varint,2,opt,name=followed_id,json=followedId,proto3" json:"followed_id,omitempty"`
 }
 
-func (x *DM) Reset() {
-	*x = DM{}
+func (x *UnfollowRequest) Reset() {
+	*x = UnfollowRequest{}
 	mi := &file_lenic_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DM) String() string {
+func (x *UnfollowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DM) ProtoMessage() {}
+func (*UnfollowRequest) ProtoMessage() {}
 
-func (x *DM) ProtoReflect() protoreflect.Message {
+func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1184,75 +1303,48 @@ func (x *DM) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DM.ProtoReflect.Descriptor instead.
-func (*DM) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnfollowRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowRequest) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DM) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *DM) GetConversationId() int32 {
+func (x *UnfollowRequest) GetFollowerId() int32 {
 	if x != nil {
-		return x.ConversationId
+		return x.FollowerId
 	}
 	return 0
 }
 
-func (x *DM) GetSenderId() int32 {
+func (x *UnfollowRequest) GetFollowedId() int32 {
 	if x != nil {
-		return x.SenderId
+		return x.FollowedId
 	}
 	return 0
 }
 
-func (x *DM) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
-}
-
-func (x *DM) GetIsRead() bool {
-	if x != nil {
-		return x.IsRead
-	}
-	return false
-}
-
-func (x *DM) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-type SendDMResponse struct {
+type UnfollowUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// OK/NOK
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *SendDMResponse) Reset() {
-	*x = SendDMResponse{}
+func (x *UnfollowUserResponse) Reset() {
+	*x = UnfollowUserResponse{}
 	mi := &file_lenic_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendDMResponse) String() string {
+func (x *UnfollowUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendDMResponse) ProtoMessage() {}
+func (*UnfollowUserResponse) ProtoMessage() {}
 
-func (x *SendDMResponse) ProtoReflect() protoreflect.Message {
+func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1264,40 +1356,41 @@ func (x *SendDMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendDMResponse.ProtoReflect.Descriptor instead.
-func (*SendDMResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SendDMResponse) GetId() int32 {
+func (x *UnfollowUserResponse) GetResponse() string {
 	if x != nil {
-		return x.Id
+		return x.Response
 	}
-	return 0
+	return ""
 }
 
-type GetConversationDMsRequest struct {
+type RejectFollowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FollowerId int32 `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FollowedId int32 `protobuf:"varint,2,opt,name=followed_id,json=followedId,proto3" json:"followed_id,omitempty"`
 }
 
-func (x *GetConversationDMsRequest) Reset() {
-	*x = GetConversationDMsRequest{}
+func (x *RejectFollowRequest) Reset() {
+	*x = RejectFollowRequest{}
 	mi := &file_lenic_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConversationDMsRequest) String() string {
+func (x *RejectFollowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConversationDMsRequest) ProtoMessage() {}
+func (*RejectFollowRequest) ProtoMessage() {}
 
-func (x *GetConversationDMsRequest) ProtoReflect() protoreflect.Message {
+func (x *RejectFollowRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1309,49 +1402,48 @@ func (x *GetConversationDMsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConversationDMsRequest.ProtoReflect.Descriptor instead.
-func (*GetConversationDMsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RejectFollowRequest.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequest) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetConversationDMsRequest) GetId() int32 {
+func (x *RejectFollowRequest) GetFollowerId() int32 {
 	if x != nil {
-		return x.Id
+		return x.FollowerId
 	}
 	return 0
 }
 
-type Post struct {
+func (x *RejectFollowRequest) GetFollowedId() int32 {
+	if x != nil {
+		return x.FollowedId
+	}
+	return 0
+}
+
+type RejectFollowResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	PostGuid  string `protobuf:"bytes,2,opt,name=post_guid,json=postGuid,proto3" json:"post_guid,omitempty"`
-	AuthorId  int32  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Title     string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Content   string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	IsPublic  bool   `protobuf:"varint,8,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
-	Rating    int32  `protobuf:"varint,9,opt,name=rating,proto3" json:"rating,omitempty"`
-	Active    bool   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
+	// OK/NOK
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
+func (x *RejectFollowResponse) Reset() {
+	*x = RejectFollowResponse{}
 	mi := &file_lenic_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) String() string {
+func (x *RejectFollowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*RejectFollowResponse) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
+func (x *RejectFollowResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_lenic_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1363,104 +1455,95 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
+// Deprecated: Use RejectFollowResponse.ProtoReflect.Descriptor instead.
+func (*RejectFollowResponse) Descriptor() ([]byte, []int) {
 	return file_lenic_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Post) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Post) GetPostGuid() string {
+func (x *RejectFollowResponse) GetResponse() string {
 	if x != nil {
-		return x.PostGuid
+		return x.Response
 	}
 	return ""
 }
 
-func (x *Post) GetAuthorId() int32 {
-	if x != nil {
-		return x.AuthorId
-	}
-	return 0
-}
+type CancelFollowRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Post) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+	FollowerId int32 `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FollowedId int32 `protobuf:"varint,2,opt,name=followed_id,json=followedId,proto3" json:"followed_id,omitempty"`
 }
 
-func (x *Post) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+func (x *CancelFollowRequestRequest) Reset() {
+	*x = CancelFollowRequestRequest{}
+	mi := &file_lenic_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+func (x *CancelFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetUpdatedAt() string {
+func (*CancelFollowRequestRequest) ProtoMessage() {}
+
+func (x *CancelFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[26]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetIsPublic() bool {
-	if x != nil {
-		return x.IsPublic
-	}
-	return false
+// Deprecated: Use CancelFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Post) GetRating() int32 {
+func (x *CancelFollowRequestRequest) GetFollowerId() int32 {
 	if x != nil {
-		return x.Rating
+		return x.FollowerId
 	}
 	return 0
 }
 
-func (x *Post) GetActive() bool {
+func (x *CancelFollowRequestRequest) GetFollowedId() int32 {
 	if x != nil {
-		return x.Active
+		return x.FollowedId
 	}
-	return false
+	return 0
 }
 
-type CreatePostResponse struct {
+type CancelFollowRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// OK/NOK
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *CreatePostResponse) Reset() {
-	*x = CreatePostResponse{}
-	mi := &file_lenic_proto_msgTypes[26]
+func (x *CancelFollowRequestResponse) Reset() {
+	*x = CancelFollowRequestResponse{}
+	mi := &file_lenic_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostResponse) String() string {
+func (x *CancelFollowRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostResponse) ProtoMessage() {}
+func (*CancelFollowRequestResponse) ProtoMessage() {}
 
-func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[26]
+func (x *CancelFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1471,41 +1554,45 @@ func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
-func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CancelFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CreatePostResponse) GetUuid() string {
+func (x *CancelFollowRequestResponse) GetResponse() string {
 	if x != nil {
-		return x.Uuid
+		return x.Response
 	}
 	return ""
 }
 
-type GetPostRequest struct {
+type ListFollowRequestsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// at most 200, defaults to 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the next-page-token trailer of the previous page, empty for the first
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *GetPostRequest) Reset() {
-	*x = GetPostRequest{}
-	mi := &file_lenic_proto_msgTypes[27]
+func (x *ListFollowRequestsRequest) Reset() {
+	*x = ListFollowRequestsRequest{}
+	mi := &file_lenic_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostRequest) String() string {
+func (x *ListFollowRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostRequest) ProtoMessage() {}
+func (*ListFollowRequestsRequest) ProtoMessage() {}
 
-func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[27]
+func (x *ListFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1516,41 +1603,57 @@ func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
-func (*GetPostRequest) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetPostRequest) GetUuid() string {
+func (x *ListFollowRequestsRequest) GetUsername() string {
 	if x != nil {
-		return x.Uuid
+		return x.Username
 	}
 	return ""
 }
 
-type GetUserPostsRequest struct {
+func (x *ListFollowRequestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFollowRequestsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetFollowSuggestionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// defaults to 20, at most 50
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *GetUserPostsRequest) Reset() {
-	*x = GetUserPostsRequest{}
-	mi := &file_lenic_proto_msgTypes[28]
+func (x *GetFollowSuggestionsRequest) Reset() {
+	*x = GetFollowSuggestionsRequest{}
+	mi := &file_lenic_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserPostsRequest) String() string {
+func (x *GetFollowSuggestionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPostsRequest) ProtoMessage() {}
+func (*GetFollowSuggestionsRequest) ProtoMessage() {}
 
-func (x *GetUserPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[28]
+func (x *GetFollowSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1561,41 +1664,50 @@ func (x *GetUserPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPostsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserPostsRequest) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetFollowSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetUserPostsRequest) GetUsername() string {
+func (x *GetFollowSuggestionsRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-type GetUserPublicPostsRequest struct {
+func (x *GetFollowSuggestionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FollowSuggestion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// how many of the people you follow follow this user
+	MutualCount int32 `protobuf:"varint,2,opt,name=mutual_count,json=mutualCount,proto3" json:"mutual_count,omitempty"`
 }
 
-func (x *GetUserPublicPostsRequest) Reset() {
-	*x = GetUserPublicPostsRequest{}
-	mi := &file_lenic_proto_msgTypes[29]
+func (x *FollowSuggestion) Reset() {
+	*x = FollowSuggestion{}
+	mi := &file_lenic_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserPublicPostsRequest) String() string {
+func (x *FollowSuggestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPublicPostsRequest) ProtoMessage() {}
+func (*FollowSuggestion) ProtoMessage() {}
 
-func (x *GetUserPublicPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[29]
+func (x *FollowSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1606,41 +1718,59 @@ func (x *GetUserPublicPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPublicPostsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserPublicPostsRequest) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use FollowSuggestion.ProtoReflect.Descriptor instead.
+func (*FollowSuggestion) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetUserPublicPostsRequest) GetUsername() string {
+func (x *FollowSuggestion) GetUser() *User {
 	if x != nil {
-		return x.Username
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-type GetFeedRequest struct {
+func (x *FollowSuggestion) GetMutualCount() int32 {
+	if x != nil {
+		return x.MutualCount
+	}
+	return 0
+}
+
+// Relationship
+// Relationship is always seen from the caller's side
+type Relationship struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Username   string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Following  bool   `protobuf:"varint,2,opt,name=following,proto3" json:"following,omitempty"`
+	FollowedBy bool   `protobuf:"varint,3,opt,name=followed_by,json=followedBy,proto3" json:"followed_by,omitempty"`
+	// the caller asked to follow username and is waiting for acceptance
+	OutgoingRequest bool `protobuf:"varint,4,opt,name=outgoing_request,json=outgoingRequest,proto3" json:"outgoing_request,omitempty"`
+	// username asked to follow the caller
+	IncomingRequest bool `protobuf:"varint,5,opt,name=incoming_request,json=incomingRequest,proto3" json:"incoming_request,omitempty"`
+	Blocking        bool `protobuf:"varint,6,opt,name=blocking,proto3" json:"blocking,omitempty"`
+	BlockedBy       bool `protobuf:"varint,7,opt,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty"`
+	Muting          bool `protobuf:"varint,8,opt,name=muting,proto3" json:"muting,omitempty"`
 }
 
-func (x *GetFeedRequest) Reset() {
-	*x = GetFeedRequest{}
-	mi := &file_lenic_proto_msgTypes[30]
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	mi := &file_lenic_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFeedRequest) String() string {
+func (x *Relationship) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeedRequest) ProtoMessage() {}
+func (*Relationship) ProtoMessage() {}
 
-func (x *GetFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[30]
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,41 +1781,90 @@ func (x *GetFeedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeedRequest.ProtoReflect.Descriptor instead.
-func (*GetFeedRequest) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetFeedRequest) GetUsername() string {
+func (x *Relationship) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-type UpdatePostResponse struct {
+func (x *Relationship) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *Relationship) GetFollowedBy() bool {
+	if x != nil {
+		return x.FollowedBy
+	}
+	return false
+}
+
+func (x *Relationship) GetOutgoingRequest() bool {
+	if x != nil {
+		return x.OutgoingRequest
+	}
+	return false
+}
+
+func (x *Relationship) GetIncomingRequest() bool {
+	if x != nil {
+		return x.IncomingRequest
+	}
+	return false
+}
+
+func (x *Relationship) GetBlocking() bool {
+	if x != nil {
+		return x.Blocking
+	}
+	return false
+}
+
+func (x *Relationship) GetBlockedBy() bool {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return false
+}
+
+func (x *Relationship) GetMuting() bool {
+	if x != nil {
+		return x.Muting
+	}
+	return false
+}
+
+type GetRelationshipRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	OtherUsername string `protobuf:"bytes,1,opt,name=other_username,json=otherUsername,proto3" json:"other_username,omitempty"`
 }
 
-func (x *UpdatePostResponse) Reset() {
-	*x = UpdatePostResponse{}
-	mi := &file_lenic_proto_msgTypes[31]
+func (x *GetRelationshipRequest) Reset() {
+	*x = GetRelationshipRequest{}
+	mi := &file_lenic_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostResponse) String() string {
+func (x *GetRelationshipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostResponse) ProtoMessage() {}
+func (*GetRelationshipRequest) ProtoMessage() {}
 
-func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[31]
+func (x *GetRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1696,41 +1875,41 @@ func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UpdatePostResponse) GetResponse() string {
+func (x *GetRelationshipRequest) GetOtherUsername() string {
 	if x != nil {
-		return x.Response
+		return x.OtherUsername
 	}
 	return ""
 }
 
-type DeletePostRequest struct {
+type GetRelationshipsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	OtherUsernames []string `protobuf:"bytes,1,rep,name=other_usernames,json=otherUsernames,proto3" json:"other_usernames,omitempty"`
 }
 
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
-	mi := &file_lenic_proto_msgTypes[32]
+func (x *GetRelationshipsRequest) Reset() {
+	*x = GetRelationshipsRequest{}
+	mi := &file_lenic_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostRequest) String() string {
+func (x *GetRelationshipsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
+func (*GetRelationshipsRequest) ProtoMessage() {}
 
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[32]
+func (x *GetRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1741,41 +1920,140 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use GetRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DeletePostRequest) GetUuid() string {
+func (x *GetRelationshipsRequest) GetOtherUsernames() []string {
 	if x != nil {
-		return x.Uuid
+		return x.OtherUsernames
+	}
+	return nil
+}
+
+type GetRelationshipsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Relationships []*Relationship `protobuf:"bytes,1,rep,name=relationships,proto3" json:"relationships,omitempty"`
+}
+
+func (x *GetRelationshipsResponse) Reset() {
+	*x = GetRelationshipsResponse{}
+	mi := &file_lenic_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsResponse) ProtoMessage() {}
+
+func (x *GetRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetRelationshipsResponse) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+type BlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username       string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	TargetUsername string `protobuf:"bytes,2,opt,name=target_username,json=targetUsername,proto3" json:"target_username,omitempty"`
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	mi := &file_lenic_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BlockRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
 	return ""
 }
 
-type DeletePostResponse struct {
+func (x *BlockRequest) GetTargetUsername() string {
+	if x != nil {
+		return x.TargetUsername
+	}
+	return ""
+}
+
+type BlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// OK/NOK
 	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *DeletePostResponse) Reset() {
-	*x = DeletePostResponse{}
-	mi := &file_lenic_proto_msgTypes[33]
+func (x *BlockResponse) Reset() {
+	*x = BlockResponse{}
+	mi := &file_lenic_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostResponse) String() string {
+func (x *BlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostResponse) ProtoMessage() {}
+func (*BlockResponse) ProtoMessage() {}
 
-func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[33]
+func (x *BlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1786,42 +2064,42 @@ func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
-func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DeletePostResponse) GetResponse() string {
+func (x *BlockResponse) GetResponse() string {
 	if x != nil {
 		return x.Response
 	}
 	return ""
 }
 
-type PostRating struct {
+type MuteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId int32 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username       string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	TargetUsername string `protobuf:"bytes,2,opt,name=target_username,json=targetUsername,proto3" json:"target_username,omitempty"`
 }
 
-func (x *PostRating) Reset() {
-	*x = PostRating{}
-	mi := &file_lenic_proto_msgTypes[34]
+func (x *MuteRequest) Reset() {
+	*x = MuteRequest{}
+	mi := &file_lenic_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PostRating) String() string {
+func (x *MuteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostRating) ProtoMessage() {}
+func (*MuteRequest) ProtoMessage() {}
 
-func (x *PostRating) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[34]
+func (x *MuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1832,48 +2110,1930 @@ func (x *PostRating) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostRating.ProtoReflect.Descriptor instead.
-func (*PostRating) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use MuteRequest.ProtoReflect.Descriptor instead.
+func (*MuteRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MuteRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MuteRequest) GetTargetUsername() string {
+	if x != nil {
+		return x.TargetUsername
+	}
+	return ""
+}
+
+type MuteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OK/NOK
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *MuteResponse) Reset() {
+	*x = MuteResponse{}
+	mi := &file_lenic_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteResponse) ProtoMessage() {}
+
+func (x *MuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteResponse.ProtoReflect.Descriptor instead.
+func (*MuteResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MuteResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+// Conversation
+type Conversation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	User1Id   int32  `protobuf:"varint,2,opt,name=user1_id,json=user1Id,proto3" json:"user1_id,omitempty"`
+	User2Id   int32  `protobuf:"varint,3,opt,name=user2_id,json=user2Id,proto3" json:"user2_id,omitempty"`
+	CreatedAt string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Conversation) Reset() {
+	*x = Conversation{}
+	mi := &file_lenic_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conversation) ProtoMessage() {}
+
+func (x *Conversation) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
+func (*Conversation) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Conversation) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Conversation) GetUser1Id() int32 {
+	if x != nil {
+		return x.User1Id
+	}
+	return 0
+}
+
+func (x *Conversation) GetUser2Id() int32 {
+	if x != nil {
+		return x.User2Id
+	}
+	return 0
+}
+
+func (x *Conversation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Conversation) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type StartConversationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *StartConversationResponse) Reset() {
+	*x = StartConversationResponse{}
+	mi := &file_lenic_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartConversationResponse) ProtoMessage() {}
+
+func (x *StartConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartConversationResponse.ProtoReflect.Descriptor instead.
+func (*StartConversationResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StartConversationResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetUserConversationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// at most 200, defaults to 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the next-page-token trailer of the previous page, empty for the first
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetUserConversationsRequest) Reset() {
+	*x = GetUserConversationsRequest{}
+	mi := &file_lenic_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserConversationsRequest) ProtoMessage() {}
+
+func (x *GetUserConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserConversationsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetUserConversationsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetUserConversationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserConversationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ReadConversationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReadConversationRequest) Reset() {
+	*x = ReadConversationRequest{}
+	mi := &file_lenic_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadConversationRequest) ProtoMessage() {}
+
+func (x *ReadConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadConversationRequest.ProtoReflect.Descriptor instead.
+func (*ReadConversationRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReadConversationRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReadConversationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OK/NOK
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *ReadConversationResponse) Reset() {
+	*x = ReadConversationResponse{}
+	mi := &file_lenic_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadConversationResponse) ProtoMessage() {}
+
+func (x *ReadConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadConversationResponse.ProtoReflect.Descriptor instead.
+func (*ReadConversationResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReadConversationResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+// DMs
+type DM struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConversationId int32  `protobuf:"varint,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	SenderId       int32  `protobuf:"varint,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	Content        string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	IsRead         bool   `protobuf:"varint,5,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	CreatedAt      string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DM) Reset() {
+	*x = DM{}
+	mi := &file_lenic_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DM) ProtoMessage() {}
+
+func (x *DM) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DM.ProtoReflect.Descriptor instead.
+func (*DM) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DM) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DM) GetConversationId() int32 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *DM) GetSenderId() int32 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *DM) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *DM) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+func (x *DM) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SendDMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SendDMResponse) Reset() {
+	*x = SendDMResponse{}
+	mi := &file_lenic_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendDMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendDMResponse) ProtoMessage() {}
+
+func (x *SendDMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendDMResponse.ProtoReflect.Descriptor instead.
+func (*SendDMResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SendDMResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetConversationDMsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// at most 200, defaults to 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the next-page-token trailer of the previous page, empty for the first
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetConversationDMsRequest) Reset() {
+	*x = GetConversationDMsRequest{}
+	mi := &file_lenic_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationDMsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationDMsRequest) ProtoMessage() {}
+
+func (x *GetConversationDMsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationDMsRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationDMsRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetConversationDMsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetConversationDMsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetConversationDMsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type Post struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostGuid  string `protobuf:"bytes,2,opt,name=post_guid,json=postGuid,proto3" json:"post_guid,omitempty"`
+	AuthorId  int32  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Title     string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Content   string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// true is VISIBILITY_PUBLIC, false with no visibility is
+	// VISIBILITY_FOLLOWERS. Use visibility
+	//
+	// Deprecated: Marked as deprecated in lenic.proto.
+	IsPublic   bool       `protobuf:"varint,8,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	Rating     int32      `protobuf:"varint,9,opt,name=rating,proto3" json:"rating,omitempty"`
+	Active     bool       `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
+	Visibility Visibility `protobuf:"varint,11,opt,name=visibility,proto3,enum=lenic.Visibility" json:"visibility,omitempty"`
+	// only read by CreatePost/UpdatePost for VISIBILITY_SPECIFIC_USERS
+	AudienceIds []int32 `protobuf:"varint,12,rep,packed,name=audience_ids,json=audienceIds,proto3" json:"audience_ids,omitempty"`
+	EditCount   int32   `protobuf:"varint,13,opt,name=edit_count,json=editCount,proto3" json:"edit_count,omitempty"`
+	// empty until the post is edited
+	EditedAt string `protobuf:"bytes,14,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	// the vote of the caller: 1, -1 or 0, voting the same way again takes
+	// it back
+	MyRating  int32 `protobuf:"varint,15,opt,name=my_rating,json=myRating,proto3" json:"my_rating,omitempty"`
+	Upvotes   int32 `protobuf:"varint,16,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
+	Downvotes int32 `protobuf:"varint,17,opt,name=downvotes,proto3" json:"downvotes,omitempty"`
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_lenic_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Post) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Post) GetPostGuid() string {
+	if x != nil {
+		return x.PostGuid
+	}
+	return ""
+}
+
+func (x *Post) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Post) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Post) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Post) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Post) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in lenic.proto.
+func (x *Post) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *Post) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Post) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Post) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Post) GetAudienceIds() []int32 {
+	if x != nil {
+		return x.AudienceIds
+	}
+	return nil
+}
+
+func (x *Post) GetEditCount() int32 {
+	if x != nil {
+		return x.EditCount
+	}
+	return 0
+}
+
+func (x *Post) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
+
+func (x *Post) GetMyRating() int32 {
+	if x != nil {
+		return x.MyRating
+	}
+	return 0
+}
+
+func (x *Post) GetUpvotes() int32 {
+	if x != nil {
+		return x.Upvotes
+	}
+	return 0
+}
+
+func (x *Post) GetDownvotes() int32 {
+	if x != nil {
+		return x.Downvotes
+	}
+	return 0
+}
+
+type CreatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *CreatePostResponse) Reset() {
+	*x = CreatePostResponse{}
+	mi := &file_lenic_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostResponse) ProtoMessage() {}
+
+func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreatePostResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetPostRequest) Reset() {
+	*x = GetPostRequest{}
+	mi := &file_lenic_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRequest) ProtoMessage() {}
+
+func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetPostRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// PostRevision is a version of a post an edit replaced, revision 1 is the
+// original and created_at is when the version was written
+type PostRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostGuid   string     `protobuf:"bytes,1,opt,name=post_guid,json=postGuid,proto3" json:"post_guid,omitempty"`
+	Revision   int32      `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Title      string     `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content    string     `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Visibility Visibility `protobuf:"varint,5,opt,name=visibility,proto3,enum=lenic.Visibility" json:"visibility,omitempty"`
+	CreatedAt  string     `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *PostRevision) Reset() {
+	*x = PostRevision{}
+	mi := &file_lenic_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevision) ProtoMessage() {}
+
+func (x *PostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevision.ProtoReflect.Descriptor instead.
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PostRevision) GetPostGuid() string {
+	if x != nil {
+		return x.PostGuid
+	}
+	return ""
+}
+
+func (x *PostRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PostRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PostRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PostRevision) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *PostRevision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetPostRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetPostRevisionsRequest) Reset() {
+	*x = GetPostRevisionsRequest{}
+	mi := &file_lenic_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionsRequest) ProtoMessage() {}
+
+func (x *GetPostRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetPostRevisionsRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetPostRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid     string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Revision int32  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *GetPostRevisionRequest) Reset() {
+	*x = GetPostRevisionRequest{}
+	mi := &file_lenic_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionRequest) ProtoMessage() {}
+
+func (x *GetPostRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetPostRevisionRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetPostRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetUserPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// at most 200, defaults to 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the next-page-token trailer of the previous page, empty for the first
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetUserPostsRequest) Reset() {
+	*x = GetUserPostsRequest{}
+	mi := &file_lenic_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPostsRequest) ProtoMessage() {}
+
+func (x *GetUserPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPostsRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetUserPostsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetUserPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetUserPublicPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// at most 200, defaults to 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the next-page-token trailer of the previous page, empty for the first
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetUserPublicPostsRequest) Reset() {
+	*x = GetUserPublicPostsRequest{}
+	mi := &file_lenic_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPublicPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPublicPostsRequest) ProtoMessage() {}
+
+func (x *GetUserPublicPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPublicPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPublicPostsRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetUserPublicPostsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetUserPublicPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserPublicPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// at most 200, defaults to 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the next-page-token trailer of the previous page, empty for the first
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetFeedRequest) Reset() {
+	*x = GetFeedRequest{}
+	mi := &file_lenic_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedRequest) ProtoMessage() {}
+
+func (x *GetFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetFeedRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetFeedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetFeedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SearchPostsRequest matches posts holding every word of query, words in
+// "double quotes" must appear in that order. author, since and until are
+// optional, since and until take "2006-01-02" or "2006-01-02 15:04:05"
+type SearchPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query  string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Author string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Since  string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	Until  string `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`
+	// 20 when 0, at most 100
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchPostsRequest) Reset() {
+	*x = SearchPostsRequest{}
+	mi := &file_lenic_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsRequest) ProtoMessage() {}
+
+func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SearchPostsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type UpdatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OK/NOK
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *UpdatePostResponse) Reset() {
+	*x = UpdatePostResponse{}
+	mi := &file_lenic_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostResponse) ProtoMessage() {}
+
+func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UpdatePostResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type DeletePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_lenic_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeletePostRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OK/NOK
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	mi := &file_lenic_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeletePostResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+// Post Rating
+type PostRating struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int32 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *PostRating) Reset() {
+	*x = PostRating{}
+	mi := &file_lenic_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRating) ProtoMessage() {}
+
+func (x *PostRating) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRating.ProtoReflect.Descriptor instead.
+func (*PostRating) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PostRating) GetPostId() int32 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *PostRating) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetPostRatingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetPostRatingsRequest) Reset() {
+	*x = GetPostRatingsRequest{}
+	mi := &file_lenic_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRatingsRequest) ProtoMessage() {}
+
+func (x *GetPostRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRatingsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetPostRatingsRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type PostVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// 1 or -1
+	Rating int32 `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *PostVote) Reset() {
+	*x = PostVote{}
+	mi := &file_lenic_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostVote) ProtoMessage() {}
+
+func (x *PostVote) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostVote.ProtoReflect.Descriptor instead.
+func (*PostVote) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PostVote) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *PostVote) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type RatePostUpResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OK/NOK
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *RatePostUpResponse) Reset() {
+	*x = RatePostUpResponse{}
+	mi := &file_lenic_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatePostUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatePostUpResponse) ProtoMessage() {}
+
+func (x *RatePostUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatePostUpResponse.ProtoReflect.Descriptor instead.
+func (*RatePostUpResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RatePostUpResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type RatePostDownResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OK/NOK
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *RatePostDownResponse) Reset() {
+	*x = RatePostDownResponse{}
+	mi := &file_lenic_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatePostDownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatePostDownResponse) ProtoMessage() {}
+
+func (x *RatePostDownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatePostDownResponse.ProtoReflect.Descriptor instead.
+func (*RatePostDownResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RatePostDownResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type SetPostRatingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int32 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// 1, -1 or 0 to take the vote back
+	Rating int32 `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *SetPostRatingRequest) Reset() {
+	*x = SetPostRatingRequest{}
+	mi := &file_lenic_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPostRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPostRatingRequest) ProtoMessage() {}
+
+func (x *SetPostRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPostRatingRequest.ProtoReflect.Descriptor instead.
+func (*SetPostRatingRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SetPostRatingRequest) GetPostId() int32 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SetPostRatingRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type SetPostRatingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the score of the post after the vote
+	Rating   int32 `protobuf:"varint,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	MyRating int32 `protobuf:"varint,2,opt,name=my_rating,json=myRating,proto3" json:"my_rating,omitempty"`
+}
+
+func (x *SetPostRatingResponse) Reset() {
+	*x = SetPostRatingResponse{}
+	mi := &file_lenic_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPostRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPostRatingResponse) ProtoMessage() {}
+
+func (x *SetPostRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPostRatingResponse.ProtoReflect.Descriptor instead.
+func (*SetPostRatingResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetPostRatingResponse) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SetPostRatingResponse) GetMyRating() int32 {
+	if x != nil {
+		return x.MyRating
+	}
+	return 0
+}
+
+// Comment
+type Comment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostGuid  string `protobuf:"bytes,2,opt,name=post_guid,json=postGuid,proto3" json:"post_guid,omitempty"`
+	AuthorId  int32  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Content   string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Rating    int32  `protobuf:"varint,7,opt,name=rating,proto3" json:"rating,omitempty"`
+	Active    bool   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	Edited    bool   `protobuf:"varint,9,opt,name=edited,proto3" json:"edited,omitempty"`
+	EditCount int32  `protobuf:"varint,10,opt,name=edit_count,json=editCount,proto3" json:"edit_count,omitempty"`
+	// read like the same fields of Post
+	MyRating  int32 `protobuf:"varint,11,opt,name=my_rating,json=myRating,proto3" json:"my_rating,omitempty"`
+	Upvotes   int32 `protobuf:"varint,12,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
+	Downvotes int32 `protobuf:"varint,13,opt,name=downvotes,proto3" json:"downvotes,omitempty"`
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_lenic_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Comment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetPostGuid() string {
+	if x != nil {
+		return x.PostGuid
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Comment) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Comment) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Comment) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Comment) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *Comment) GetEditCount() int32 {
+	if x != nil {
+		return x.EditCount
+	}
+	return 0
+}
+
+func (x *Comment) GetMyRating() int32 {
+	if x != nil {
+		return x.MyRating
+	}
+	return 0
 }
 
-func (x *PostRating) GetPostId() int32 {
+func (x *Comment) GetUpvotes() int32 {
 	if x != nil {
-		return x.PostId
+		return x.Upvotes
 	}
 	return 0
 }
 
-func (x *PostRating) GetUserId() int32 {
+func (x *Comment) GetDownvotes() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Downvotes
 	}
 	return 0
 }
 
-type RatePostUpResponse struct {
+// CommentRevision is a version of a comment an edit replaced, revision 1
+// is the original and created_at is when the version was written
+type CommentRevision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	CommentId int32  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Revision  int32  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Content   string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *RatePostUpResponse) Reset() {
-	*x = RatePostUpResponse{}
-	mi := &file_lenic_proto_msgTypes[35]
+func (x *CommentRevision) Reset() {
+	*x = CommentRevision{}
+	mi := &file_lenic_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RatePostUpResponse) String() string {
+func (x *CommentRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RatePostUpResponse) ProtoMessage() {}
+func (*CommentRevision) ProtoMessage() {}
 
-func (x *RatePostUpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[35]
+func (x *CommentRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,41 +4044,62 @@ func (x *RatePostUpResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RatePostUpResponse.ProtoReflect.Descriptor instead.
-func (*RatePostUpResponse) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CommentRevision.ProtoReflect.Descriptor instead.
+func (*CommentRevision) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *RatePostUpResponse) GetResponse() string {
+func (x *CommentRevision) GetCommentId() int32 {
 	if x != nil {
-		return x.Response
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *CommentRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *CommentRevision) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
 	return ""
 }
 
-type RatePostDownResponse struct {
+func (x *CommentRevision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetCommentRevisionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *RatePostDownResponse) Reset() {
-	*x = RatePostDownResponse{}
-	mi := &file_lenic_proto_msgTypes[36]
+func (x *GetCommentRevisionsRequest) Reset() {
+	*x = GetCommentRevisionsRequest{}
+	mi := &file_lenic_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RatePostDownResponse) String() string {
+func (x *GetCommentRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RatePostDownResponse) ProtoMessage() {}
+func (*GetCommentRevisionsRequest) ProtoMessage() {}
 
-func (x *RatePostDownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[36]
+func (x *GetCommentRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1929,48 +4110,46 @@ func (x *RatePostDownResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RatePostDownResponse.ProtoReflect.Descriptor instead.
-func (*RatePostDownResponse) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetCommentRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *RatePostDownResponse) GetResponse() string {
+func (x *GetCommentRevisionsRequest) GetId() int32 {
 	if x != nil {
-		return x.Response
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type Comment struct {
+// SearchCommentsRequest is read like SearchPostsRequest
+type SearchCommentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	PostGuid  string `protobuf:"bytes,2,opt,name=post_guid,json=postGuid,proto3" json:"post_guid,omitempty"`
-	AuthorId  int32  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Content   string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt string `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Rating    int32  `protobuf:"varint,7,opt,name=rating,proto3" json:"rating,omitempty"`
-	Active    bool   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	Query  string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Author string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Since  string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	Until  string `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`
+	Limit  int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
-	mi := &file_lenic_proto_msgTypes[37]
+func (x *SearchCommentsRequest) Reset() {
+	*x = SearchCommentsRequest{}
+	mi := &file_lenic_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Comment) String() string {
+func (x *SearchCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*SearchCommentsRequest) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[37]
+func (x *SearchCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1981,67 +4160,46 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *Comment) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use SearchCommentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *Comment) GetPostGuid() string {
+func (x *SearchCommentsRequest) GetQuery() string {
 	if x != nil {
-		return x.PostGuid
+		return x.Query
 	}
 	return ""
 }
 
-func (x *Comment) GetAuthorId() int32 {
-	if x != nil {
-		return x.AuthorId
-	}
-	return 0
-}
-
-func (x *Comment) GetContent() string {
+func (x *SearchCommentsRequest) GetAuthor() string {
 	if x != nil {
-		return x.Content
+		return x.Author
 	}
 	return ""
 }
 
-func (x *Comment) GetCreatedAt() string {
+func (x *SearchCommentsRequest) GetSince() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Since
 	}
 	return ""
 }
 
-func (x *Comment) GetUpdatedAt() string {
+func (x *SearchCommentsRequest) GetUntil() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Until
 	}
 	return ""
 }
 
-func (x *Comment) GetRating() int32 {
+func (x *SearchCommentsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Rating
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *Comment) GetActive() bool {
-	if x != nil {
-		return x.Active
-	}
-	return false
-}
-
 type CreateCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2052,7 +4210,7 @@ type CreateCommentResponse struct {
 
 func (x *CreateCommentResponse) Reset() {
 	*x = CreateCommentResponse{}
-	mi := &file_lenic_proto_msgTypes[38]
+	mi := &file_lenic_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2064,7 +4222,7 @@ func (x *CreateCommentResponse) String() string {
 func (*CreateCommentResponse) ProtoMessage() {}
 
 func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[38]
+	mi := &file_lenic_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2077,7 +4235,7 @@ func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCommentResponse.ProtoReflect.Descriptor instead.
 func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{38}
+	return file_lenic_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *CreateCommentResponse) GetId() int32 {
@@ -2097,7 +4255,7 @@ type GetCommentRequest struct {
 
 func (x *GetCommentRequest) Reset() {
 	*x = GetCommentRequest{}
-	mi := &file_lenic_proto_msgTypes[39]
+	mi := &file_lenic_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2109,7 +4267,7 @@ func (x *GetCommentRequest) String() string {
 func (*GetCommentRequest) ProtoMessage() {}
 
 func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[39]
+	mi := &file_lenic_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2122,7 +4280,7 @@ func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
 func (*GetCommentRequest) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{39}
+	return file_lenic_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetCommentRequest) GetId() int32 {
@@ -2138,11 +4296,15 @@ type GetCommentsFromPostRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// at most 200, defaults to 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// the next-page-token trailer of the previous page, empty for the first
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *GetCommentsFromPostRequest) Reset() {
 	*x = GetCommentsFromPostRequest{}
-	mi := &file_lenic_proto_msgTypes[40]
+	mi := &file_lenic_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2154,7 +4316,7 @@ func (x *GetCommentsFromPostRequest) String() string {
 func (*GetCommentsFromPostRequest) ProtoMessage() {}
 
 func (x *GetCommentsFromPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[40]
+	mi := &file_lenic_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2167,7 +4329,7 @@ func (x *GetCommentsFromPostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommentsFromPostRequest.ProtoReflect.Descriptor instead.
 func (*GetCommentsFromPostRequest) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{40}
+	return file_lenic_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *GetCommentsFromPostRequest) GetUuid() string {
@@ -2177,17 +4339,32 @@ func (x *GetCommentsFromPostRequest) GetUuid() string {
 	return ""
 }
 
+func (x *GetCommentsFromPostRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCommentsFromPostRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type UpdateCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// OK/NOK
 	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
 func (x *UpdateCommentResponse) Reset() {
 	*x = UpdateCommentResponse{}
-	mi := &file_lenic_proto_msgTypes[41]
+	mi := &file_lenic_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2199,7 +4376,7 @@ func (x *UpdateCommentResponse) String() string {
 func (*UpdateCommentResponse) ProtoMessage() {}
 
 func (x *UpdateCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[41]
+	mi := &file_lenic_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2212,7 +4389,7 @@ func (x *UpdateCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCommentResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCommentResponse) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{41}
+	return file_lenic_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *UpdateCommentResponse) GetResponse() string {
@@ -2232,7 +4409,7 @@ type DeleteCommentRequest struct {
 
 func (x *DeleteCommentRequest) Reset() {
 	*x = DeleteCommentRequest{}
-	mi := &file_lenic_proto_msgTypes[42]
+	mi := &file_lenic_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2244,7 +4421,7 @@ func (x *DeleteCommentRequest) String() string {
 func (*DeleteCommentRequest) ProtoMessage() {}
 
 func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[42]
+	mi := &file_lenic_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2257,7 +4434,7 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{42}
+	return file_lenic_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *DeleteCommentRequest) GetId() int32 {
@@ -2272,12 +4449,13 @@ type DeleteCommentResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// OK/NOK
 	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
 func (x *DeleteCommentResponse) Reset() {
 	*x = DeleteCommentResponse{}
-	mi := &file_lenic_proto_msgTypes[43]
+	mi := &file_lenic_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2289,7 +4467,7 @@ func (x *DeleteCommentResponse) String() string {
 func (*DeleteCommentResponse) ProtoMessage() {}
 
 func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[43]
+	mi := &file_lenic_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2302,7 +4480,7 @@ func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{43}
+	return file_lenic_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *DeleteCommentResponse) GetResponse() string {
@@ -2312,6 +4490,7 @@ func (x *DeleteCommentResponse) GetResponse() string {
 	return ""
 }
 
+// Comment Rating
 type CommentRating struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2323,7 +4502,7 @@ type CommentRating struct {
 
 func (x *CommentRating) Reset() {
 	*x = CommentRating{}
-	mi := &file_lenic_proto_msgTypes[44]
+	mi := &file_lenic_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2335,7 +4514,7 @@ func (x *CommentRating) String() string {
 func (*CommentRating) ProtoMessage() {}
 
 func (x *CommentRating) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[44]
+	mi := &file_lenic_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2348,7 +4527,7 @@ func (x *CommentRating) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentRating.ProtoReflect.Descriptor instead.
 func (*CommentRating) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{44}
+	return file_lenic_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *CommentRating) GetCommentId() int32 {
@@ -2370,12 +4549,13 @@ type RateCommentUpResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// OK/NOK
 	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
 func (x *RateCommentUpResponse) Reset() {
 	*x = RateCommentUpResponse{}
-	mi := &file_lenic_proto_msgTypes[45]
+	mi := &file_lenic_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2387,7 +4567,7 @@ func (x *RateCommentUpResponse) String() string {
 func (*RateCommentUpResponse) ProtoMessage() {}
 
 func (x *RateCommentUpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[45]
+	mi := &file_lenic_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2400,7 +4580,7 @@ func (x *RateCommentUpResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RateCommentUpResponse.ProtoReflect.Descriptor instead.
 func (*RateCommentUpResponse) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{45}
+	return file_lenic_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *RateCommentUpResponse) GetResponse() string {
@@ -2415,12 +4595,13 @@ type RateCommentDownResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// OK/NOK
 	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 }
 
 func (x *RateCommentDownResponse) Reset() {
 	*x = RateCommentDownResponse{}
-	mi := &file_lenic_proto_msgTypes[46]
+	mi := &file_lenic_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2432,7 +4613,7 @@ func (x *RateCommentDownResponse) String() string {
 func (*RateCommentDownResponse) ProtoMessage() {}
 
 func (x *RateCommentDownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lenic_proto_msgTypes[46]
+	mi := &file_lenic_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2445,7 +4626,7 @@ func (x *RateCommentDownResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RateCommentDownResponse.ProtoReflect.Descriptor instead.
 func (*RateCommentDownResponse) Descriptor() ([]byte, []int) {
-	return file_lenic_proto_rawDescGZIP(), []int{46}
+	return file_lenic_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *RateCommentDownResponse) GetResponse() string {
@@ -2455,6 +4636,114 @@ func (x *RateCommentDownResponse) GetResponse() string {
 	return ""
 }
 
+type SetCommentRatingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId int32 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// 1, -1 or 0 to take the vote back
+	Rating int32 `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *SetCommentRatingRequest) Reset() {
+	*x = SetCommentRatingRequest{}
+	mi := &file_lenic_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommentRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommentRatingRequest) ProtoMessage() {}
+
+func (x *SetCommentRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommentRatingRequest.ProtoReflect.Descriptor instead.
+func (*SetCommentRatingRequest) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SetCommentRatingRequest) GetCommentId() int32 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *SetCommentRatingRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type SetCommentRatingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the score of the comment after the vote
+	Rating   int32 `protobuf:"varint,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	MyRating int32 `protobuf:"varint,2,opt,name=my_rating,json=myRating,proto3" json:"my_rating,omitempty"`
+}
+
+func (x *SetCommentRatingResponse) Reset() {
+	*x = SetCommentRatingResponse{}
+	mi := &file_lenic_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommentRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommentRatingResponse) ProtoMessage() {}
+
+func (x *SetCommentRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lenic_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommentRatingResponse.ProtoReflect.Descriptor instead.
+func (*SetCommentRatingResponse) Descriptor() ([]byte, []int) {
+	return file_lenic_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SetCommentRatingResponse) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SetCommentRatingResponse) GetMyRating() int32 {
+	if x != nil {
+		return x.MyRating
+	}
+	return 0
+}
+
 var File_lenic_proto protoreflect.FileDescriptor
 
 var file_lenic_proto_rawDesc = []byte{
@@ -2466,7 +4755,7 @@ var file_lenic_proto_rawDesc = []byte{
 	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x25, 0x0a, 0x0d,
 	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
 	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x9c, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x6b, 0x65, 0x6e, 0x22, 0xbb, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
 	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
 	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
@@ -2484,320 +4773,689 @@ var file_lenic_proto_rawDesc = []byte{
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
 	0x74, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x22, 0x24, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7a, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x71, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
 	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x71, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x34, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a,
+	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4f, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x34, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a,
-	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x55, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x37,
+	0x0a, 0x19, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x31, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x11, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x49, 0x64, 0x22, 0x30, 0x0a, 0x12, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x64, 0x22, 0x32, 0x0a,
+	0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x53, 0x0a, 0x0f, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
 	0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
 	0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x64, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x12, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49,
-	0x64, 0x22, 0x32, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x0f, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x14, 0x55, 0x6e,
-	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92,
-	0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x31, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x31, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x32, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x32, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0x2b, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x76,
-	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x39, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x6f, 0x77, 0x65, 0x64, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x13, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x14, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x73, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x29, 0x0a, 0x17, 0x52,
-	0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f,
-	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xac,
-	0x01, 0x0a, 0x02, 0x44, 0x4d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
-	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x20, 0x0a,
-	0x0e, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x2b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8b, 0x02, 0x0a,
-	0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x67, 0x75,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x47, 0x75,
-	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x22, 0x24, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x56, 0x0a, 0x10, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x92, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x79, 0x12, 0x29, 0x0a, 0x10,
+	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x3f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x42, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x73, 0x22, 0x53, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x37, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f,
-	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22,
-	0x30, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x52, 0x0a, 0x0b, 0x4d, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x0c, 0x4d, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x31, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x31, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x32, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x32, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2b, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x75, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x29, 0x0a, 0x17,
+	0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xac, 0x01, 0x0a, 0x02, 0x44, 0x4d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x20,
+	0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x67, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf6, 0x03, 0x0a, 0x04, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x47, 0x75, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x79, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x24, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x22, 0xc9, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x47, 0x75, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2d,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x48, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x73, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x68, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x30, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27,
+	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x0a, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x30, 0x0a, 0x12, 0x52,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a,
+	0x14, 0x52, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3e, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x30, 0x0a, 0x12, 0x52, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x52,
+	0x65, 0x22, 0x47, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x4c, 0x0a, 0x15, 0x53, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x79, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6d, 0x79, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xe7, 0x02, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x67, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x47, 0x75, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x65, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x79, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2c, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x6c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46,
+	0x72, 0x6f, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x33,
+	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x14, 0x52, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x44,
-	0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdb, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x47, 0x75, 0x69, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x33, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x33, 0x0a, 0x15, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x15, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x47, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x15, 0x52, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35,
+	0x0a, 0x17, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x6f, 0x77,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x17, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xff, 0x0f, 0x0a,
-	0x05, 0x4c, 0x65, 0x6e, 0x69, 0x63, 0x12, 0x32, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
-	0x13, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2d, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x6c, 0x65,
-	0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x37, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x19,
-	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
-	0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x6c,
-	0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c,
-	0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12,
-	0x1e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x30, 0x01, 0x12, 0x41,
-	0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6c,
-	0x65, 0x6e, 0x69, 0x63, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x47, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x12, 0x1a, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x55, 0x6e,
-	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x6c, 0x65, 0x6e,
-	0x69, 0x63, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x4f, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x79, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6d, 0x79, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2a, 0xaf, 0x01, 0x0a, 0x0a, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x49, 0x53, 0x49, 0x42,
+	0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54,
+	0x59, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x49,
+	0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x4c, 0x49, 0x53, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54,
+	0x59, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x10, 0x03, 0x12, 0x1c, 0x0a,
+	0x18, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x5f, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x53, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x56,
+	0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x43, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x53, 0x10, 0x05, 0x32, 0xd7, 0x1d, 0x0a, 0x05, 0x4c,
+	0x65, 0x6e, 0x69, 0x63, 0x12, 0x32, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x13, 0x2e,
+	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x37, 0x0a,
+	0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x6c,
+	0x65, 0x6e, 0x69, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e,
+	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
+	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0a,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x46, 0x6f, 0x6c,
 	0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3c, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73,
-	0x73, 0x12, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x1d,
-	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
-	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6c, 0x65,
-	0x6e, 0x69, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4a, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6f,
-	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x20, 0x2e, 0x6c, 0x65, 0x6e,
-	0x69, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63,
-	0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12,
-	0x53, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x4d, 0x12, 0x09,
-	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x44, 0x4d, 0x1a, 0x15, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
-	0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x43, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x4d, 0x73, 0x12, 0x20, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x4d,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63,
-	0x2e, 0x44, 0x4d, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
-	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x6c, 0x65, 0x6e,
-	0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x6c, 0x65,
-	0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
-	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x30, 0x01, 0x12, 0x2f, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x12, 0x15, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b,
-	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x30, 0x01, 0x12, 0x3a, 0x0a,
-	0x0a, 0x52, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x12, 0x11, 0x2e, 0x6c, 0x65,
-	0x6e, 0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x19,
-	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x55,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x52, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x11, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
-	0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x1b, 0x2e, 0x6c,
-	0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x6f, 0x77,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e,
-	0x50, 0x6f, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x41, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e,
-	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x36, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x18, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
-	0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x21, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0d, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x12, 0x14, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x1c, 0x2e, 0x6c,
-	0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x52, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x14, 0x2e,
-	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x1a, 0x1e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2d,
-	0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6e, 0x61,
-	0x63, 0x61, 0x72, 0x64, 0x6f, 0x38, 0x39, 0x2f, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x5f, 0x61, 0x70,
-	0x69, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x47, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12,
+	0x1a, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x65,
+	0x6e, 0x69, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63,
+	0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x0c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1a, 0x2e,
+	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x2e,
+	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x20, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x20, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x30, 0x01, 0x12, 0x55, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x1d, 0x2e, 0x6c,
+	0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x65,
+	0x6e, 0x69, 0x63, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x13, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x0b, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x6c,
+	0x65, 0x6e, 0x69, 0x63, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x4d, 0x75, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x4d, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e,
+	0x4d, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a,
+	0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x61, 0x73, 0x73, 0x12, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x1a, 0x1d, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x18, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x1f, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63,
+	0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x41,
+	0x64, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x19, 0x2e,
+	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63,
+	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x73, 0x12, 0x1d, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x30,
+	0x01, 0x12, 0x4a, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x20, 0x2e, 0x6c, 0x65,
+	0x6e, 0x69, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01,
+	0x12, 0x53, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x4d, 0x12,
+	0x09, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x44, 0x4d, 0x1a, 0x15, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x4d, 0x73, 0x12, 0x20, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x44, 0x4d, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b,
+	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x2e,
+	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x20,
+	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x30, 0x01, 0x12,
+	0x2f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x12, 0x15, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x30, 0x01,
+	0x12, 0x37, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x2e, 0x6c, 0x65,
+	0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0a,
+	0x52, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x12, 0x11, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x19, 0x2e,
+	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x52, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x11, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x1b, 0x2e, 0x6c, 0x65,
+	0x6e, 0x69, 0x63, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x6f, 0x77, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x53,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x0b, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x1c,
+	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x6c, 0x65,
+	0x6e, 0x69, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46,
+	0x72, 0x6f, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x12, 0x40, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x1c, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x12, 0x52, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c,
+	0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0d, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x12, 0x14, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x1c, 0x2e,
+	0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x52,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x14,
+	0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x1a, 0x1e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x52, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63,
+	0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63,
+	0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x65, 0x6e,
+	0x69, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x6c, 0x65, 0x6e, 0x69,
+	0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x65, 0x6e, 0x69, 0x63, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x6e, 0x61, 0x63, 0x61, 0x72, 0x64, 0x6f, 0x38, 0x39, 0x2f, 0x6c, 0x65,
+	0x6e, 0x69, 0x63, 0x5f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2812,126 +5470,216 @@ func file_lenic_proto_rawDescGZIP() []byte {
 	return file_lenic_proto_rawDescData
 }
 
-var file_lenic_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_lenic_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lenic_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
 var file_lenic_proto_goTypes = []any{
-	(*LoginRequest)(nil),                // 0: lenic.LoginRequest
-	(*LoginResponse)(nil),               // 1: lenic.LoginResponse
-	(*User)(nil),                        // 2: lenic.User
-	(*CreateUserResponse)(nil),          // 3: lenic.CreateUserResponse
-	(*GetUserRequest)(nil),              // 4: lenic.GetUserRequest
-	(*SearchUsersRequest)(nil),          // 5: lenic.SearchUsersRequest
-	(*GetUserFollowersRequest)(nil),     // 6: lenic.GetUserFollowersRequest
-	(*GetUserFollowingRequest)(nil),     // 7: lenic.GetUserFollowingRequest
-	(*UpdateUserPassResponse)(nil),      // 8: lenic.UpdateUserPassResponse
-	(*DeleteUserRequest)(nil),           // 9: lenic.DeleteUserRequest
-	(*DeleteUserResponse)(nil),          // 10: lenic.DeleteUserResponse
-	(*FollowUserRequest)(nil),           // 11: lenic.FollowUserRequest
-	(*FollowUserResponse)(nil),          // 12: lenic.FollowUserResponse
-	(*AcceptFollowRequest)(nil),         // 13: lenic.AcceptFollowRequest
-	(*AcceptFollowResponse)(nil),        // 14: lenic.AcceptFollowResponse
-	(*UnfollowRequest)(nil),             // 15: lenic.UnfollowRequest
-	(*UnfollowUserResponse)(nil),        // 16: lenic.UnfollowUserResponse
-	(*Conversation)(nil),                // 17: lenic.Conversation
-	(*StartConversationResponse)(nil),   // 18: lenic.StartConversationResponse
-	(*GetUserConversationsRequest)(nil), // 19: lenic.GetUserConversationsRequest
-	(*ReadConversationRequest)(nil),     // 20: lenic.ReadConversationRequest
-	(*ReadConversationResponse)(nil),    // 21: lenic.ReadConversationResponse
-	(*DM)(nil),                          // 22: lenic.DM
-	(*SendDMResponse)(nil),              // 23: lenic.SendDMResponse
-	(*GetConversationDMsRequest)(nil),   // 24: lenic.GetConversationDMsRequest
-	(*Post)(nil),                        // 25: lenic.Post
-	(*CreatePostResponse)(nil),          // 26: lenic.CreatePostResponse
-	(*GetPostRequest)(nil),              // 27: lenic.GetPostRequest
-	(*GetUserPostsRequest)(nil),         // 28: lenic.GetUserPostsRequest
-	(*GetUserPublicPostsRequest)(nil),   // 29: lenic.GetUserPublicPostsRequest
-	(*GetFeedRequest)(nil),              // 30: lenic.GetFeedRequest
-	(*UpdatePostResponse)(nil),          // 31: lenic.UpdatePostResponse
-	(*DeletePostRequest)(nil),           // 32: lenic.DeletePostRequest
-	(*DeletePostResponse)(nil),          // 33: lenic.DeletePostResponse
-	(*PostRating)(nil),                  // 34: lenic.PostRating
-	(*RatePostUpResponse)(nil),          // 35: lenic.RatePostUpResponse
-	(*RatePostDownResponse)(nil),        // 36: lenic.RatePostDownResponse
-	(*Comment)(nil),                     // 37: lenic.Comment
-	(*CreateCommentResponse)(nil),       // 38: lenic.CreateCommentResponse
-	(*GetCommentRequest)(nil),           // 39: lenic.GetCommentRequest
-	(*GetCommentsFromPostRequest)(nil),  // 40: lenic.GetCommentsFromPostRequest
-	(*UpdateCommentResponse)(nil),       // 41: lenic.UpdateCommentResponse
-	(*DeleteCommentRequest)(nil),        // 42: lenic.DeleteCommentRequest
-	(*DeleteCommentResponse)(nil),       // 43: lenic.DeleteCommentResponse
-	(*CommentRating)(nil),               // 44: lenic.CommentRating
-	(*RateCommentUpResponse)(nil),       // 45: lenic.RateCommentUpResponse
-	(*RateCommentDownResponse)(nil),     // 46: lenic.RateCommentDownResponse
+	(Visibility)(0),                     // 0: lenic.Visibility
+	(*LoginRequest)(nil),                // 1: lenic.LoginRequest
+	(*LoginResponse)(nil),               // 2: lenic.LoginResponse
+	(*User)(nil),                        // 3: lenic.User
+	(*CreateUserResponse)(nil),          // 4: lenic.CreateUserResponse
+	(*GetUserRequest)(nil),              // 5: lenic.GetUserRequest
+	(*SearchUsersRequest)(nil),          // 6: lenic.SearchUsersRequest
+	(*GetUserFollowersRequest)(nil),     // 7: lenic.GetUserFollowersRequest
+	(*GetUserFollowingRequest)(nil),     // 8: lenic.GetUserFollowingRequest
+	(*UpdateUserPassResponse)(nil),      // 9: lenic.UpdateUserPassResponse
+	(*DeleteUserRequest)(nil),           // 10: lenic.DeleteUserRequest
+	(*DeleteUserResponse)(nil),          // 11: lenic.DeleteUserResponse
+	(*RestoreAccountRequest)(nil),       // 12: lenic.RestoreAccountRequest
+	(*RestoreAccountResponse)(nil),      // 13: lenic.RestoreAccountResponse
+	(*SetAccountPrivacyRequest)(nil),    // 14: lenic.SetAccountPrivacyRequest
+	(*SetAccountPrivacyResponse)(nil),   // 15: lenic.SetAccountPrivacyResponse
+	(*CloseFriendRequest)(nil),          // 16: lenic.CloseFriendRequest
+	(*CloseFriendResponse)(nil),         // 17: lenic.CloseFriendResponse
+	(*GetCloseFriendsRequest)(nil),      // 18: lenic.GetCloseFriendsRequest
+	(*FollowUserRequest)(nil),           // 19: lenic.FollowUserRequest
+	(*FollowUserResponse)(nil),          // 20: lenic.FollowUserResponse
+	(*AcceptFollowRequest)(nil),         // 21: lenic.AcceptFollowRequest
+	(*AcceptFollowResponse)(nil),        // 22: lenic.AcceptFollowResponse
+	(*UnfollowRequest)(nil),             // 23: lenic.UnfollowRequest
+	(*UnfollowUserResponse)(nil),        // 24: lenic.UnfollowUserResponse
+	(*RejectFollowRequest)(nil),         // 25: lenic.RejectFollowRequest
+	(*RejectFollowResponse)(nil),        // 26: lenic.RejectFollowResponse
+	(*CancelFollowRequestRequest)(nil),  // 27: lenic.CancelFollowRequestRequest
+	(*CancelFollowRequestResponse)(nil), // 28: lenic.CancelFollowRequestResponse
+	(*ListFollowRequestsRequest)(nil),   // 29: lenic.ListFollowRequestsRequest
+	(*GetFollowSuggestionsRequest)(nil), // 30: lenic.GetFollowSuggestionsRequest
+	(*FollowSuggestion)(nil),            // 31: lenic.FollowSuggestion
+	(*Relationship)(nil),                // 32: lenic.Relationship
+	(*GetRelationshipRequest)(nil),      // 33: lenic.GetRelationshipRequest
+	(*GetRelationshipsRequest)(nil),     // 34: lenic.GetRelationshipsRequest
+	(*GetRelationshipsResponse)(nil),    // 35: lenic.GetRelationshipsResponse
+	(*BlockRequest)(nil),                // 36: lenic.BlockRequest
+	(*BlockResponse)(nil),               // 37: lenic.BlockResponse
+	(*MuteRequest)(nil),                 // 38: lenic.MuteRequest
+	(*MuteResponse)(nil),                // 39: lenic.MuteResponse
+	(*Conversation)(nil),                // 40: lenic.Conversation
+	(*StartConversationResponse)(nil),   // 41: lenic.StartConversationResponse
+	(*GetUserConversationsRequest)(nil), // 42: lenic.GetUserConversationsRequest
+	(*ReadConversationRequest)(nil),     // 43: lenic.ReadConversationRequest
+	(*ReadConversationResponse)(nil),    // 44: lenic.ReadConversationResponse
+	(*DM)(nil),                          // 45: lenic.DM
+	(*SendDMResponse)(nil),              // 46: lenic.SendDMResponse
+	(*GetConversationDMsRequest)(nil),   // 47: lenic.GetConversationDMsRequest
+	(*Post)(nil),                        // 48: lenic.Post
+	(*CreatePostResponse)(nil),          // 49: lenic.CreatePostResponse
+	(*GetPostRequest)(nil),              // 50: lenic.GetPostRequest
+	(*PostRevision)(nil),                // 51: lenic.PostRevision
+	(*GetPostRevisionsRequest)(nil),     // 52: lenic.GetPostRevisionsRequest
+	(*GetPostRevisionRequest)(nil),      // 53: lenic.GetPostRevisionRequest
+	(*GetUserPostsRequest)(nil),         // 54: lenic.GetUserPostsRequest
+	(*GetUserPublicPostsRequest)(nil),   // 55: lenic.GetUserPublicPostsRequest
+	(*GetFeedRequest)(nil),              // 56: lenic.GetFeedRequest
+	(*SearchPostsRequest)(nil),          // 57: lenic.SearchPostsRequest
+	(*UpdatePostResponse)(nil),          // 58: lenic.UpdatePostResponse
+	(*DeletePostRequest)(nil),           // 59: lenic.DeletePostRequest
+	(*DeletePostResponse)(nil),          // 60: lenic.DeletePostResponse
+	(*PostRating)(nil),                  // 61: lenic.PostRating
+	(*GetPostRatingsRequest)(nil),       // 62: lenic.GetPostRatingsRequest
+	(*PostVote)(nil),                    // 63: lenic.PostVote
+	(*RatePostUpResponse)(nil),          // 64: lenic.RatePostUpResponse
+	(*RatePostDownResponse)(nil),        // 65: lenic.RatePostDownResponse
+	(*SetPostRatingRequest)(nil),        // 66: lenic.SetPostRatingRequest
+	(*SetPostRatingResponse)(nil),       // 67: lenic.SetPostRatingResponse
+	(*Comment)(nil),                     // 68: lenic.Comment
+	(*CommentRevision)(nil),             // 69: lenic.CommentRevision
+	(*GetCommentRevisionsRequest)(nil),  // 70: lenic.GetCommentRevisionsRequest
+	(*SearchCommentsRequest)(nil),       // 71: lenic.SearchCommentsRequest
+	(*CreateCommentResponse)(nil),       // 72: lenic.CreateCommentResponse
+	(*GetCommentRequest)(nil),           // 73: lenic.GetCommentRequest
+	(*GetCommentsFromPostRequest)(nil),  // 74: lenic.GetCommentsFromPostRequest
+	(*UpdateCommentResponse)(nil),       // 75: lenic.UpdateCommentResponse
+	(*DeleteCommentRequest)(nil),        // 76: lenic.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),       // 77: lenic.DeleteCommentResponse
+	(*CommentRating)(nil),               // 78: lenic.CommentRating
+	(*RateCommentUpResponse)(nil),       // 79: lenic.RateCommentUpResponse
+	(*RateCommentDownResponse)(nil),     // 80: lenic.RateCommentDownResponse
+	(*SetCommentRatingRequest)(nil),     // 81: lenic.SetCommentRatingRequest
+	(*SetCommentRatingResponse)(nil),    // 82: lenic.SetCommentRatingResponse
 }
 var file_lenic_proto_depIdxs = []int32{
-	0,  // 0: lenic.Lenic.Login:input_type -> lenic.LoginRequest
-	2,  // 1: lenic.Lenic.CreateUser:input_type -> lenic.User
-	4,  // 2: lenic.Lenic.GetUser:input_type -> lenic.GetUserRequest
-	5,  // 3: lenic.Lenic.SearchUsers:input_type -> lenic.SearchUsersRequest
-	6,  // 4: lenic.Lenic.GetUserFollowers:input_type -> lenic.GetUserFollowersRequest
-	7,  // 5: lenic.Lenic.GetUserFollowing:input_type -> lenic.GetUserFollowingRequest
-	11, // 6: lenic.Lenic.FollowUser:input_type -> lenic.FollowUserRequest
-	13, // 7: lenic.Lenic.AcceptFollow:input_type -> lenic.AcceptFollowRequest
-	15, // 8: lenic.Lenic.UnfollowUser:input_type -> lenic.UnfollowRequest
-	2,  // 9: lenic.Lenic.UpdateUserPass:input_type -> lenic.User
-	9,  // 10: lenic.Lenic.DeleteUser:input_type -> lenic.DeleteUserRequest
-	17, // 11: lenic.Lenic.StartConversation:input_type -> lenic.Conversation
-	19, // 12: lenic.Lenic.GetUserConversations:input_type -> lenic.GetUserConversationsRequest
-	20, // 13: lenic.Lenic.ReadConversation:input_type -> lenic.ReadConversationRequest
-	22, // 14: lenic.Lenic.SendDM:input_type -> lenic.DM
-	24, // 15: lenic.Lenic.GetConversationDMs:input_type -> lenic.GetConversationDMsRequest
-	25, // 16: lenic.Lenic.CreatePost:input_type -> lenic.Post
-	27, // 17: lenic.Lenic.GetPost:input_type -> lenic.GetPostRequest
-	28, // 18: lenic.Lenic.GetUserPosts:input_type -> lenic.GetUserPostsRequest
-	29, // 19: lenic.Lenic.GetUserPublicPosts:input_type -> lenic.GetUserPublicPostsRequest
-	30, // 20: lenic.Lenic.GetFeed:input_type -> lenic.GetFeedRequest
-	34, // 21: lenic.Lenic.RatePostUp:input_type -> lenic.PostRating
-	34, // 22: lenic.Lenic.RatePostDown:input_type -> lenic.PostRating
-	25, // 23: lenic.Lenic.UpdatePost:input_type -> lenic.Post
-	32, // 24: lenic.Lenic.DeletePost:input_type -> lenic.DeletePostRequest
-	37, // 25: lenic.Lenic.CreateComment:input_type -> lenic.Comment
-	39, // 26: lenic.Lenic.GetComment:input_type -> lenic.GetCommentRequest
-	40, // 27: lenic.Lenic.GetCommentsFromPost:input_type -> lenic.GetCommentsFromPostRequest
-	44, // 28: lenic.Lenic.RateCommentUp:input_type -> lenic.CommentRating
-	44, // 29: lenic.Lenic.RateCommentDown:input_type -> lenic.CommentRating
-	37, // 30: lenic.Lenic.UpdateComment:input_type -> lenic.Comment
-	42, // 31: lenic.Lenic.DeleteComment:input_type -> lenic.DeleteCommentRequest
-	1,  // 32: lenic.Lenic.Login:output_type -> lenic.LoginResponse
-	3,  // 33: lenic.Lenic.CreateUser:output_type -> lenic.CreateUserResponse
-	2,  // 34: lenic.Lenic.GetUser:output_type -> lenic.User
-	2,  // 35: lenic.Lenic.SearchUsers:output_type -> lenic.User
-	2,  // 36: lenic.Lenic.GetUserFollowers:output_type -> lenic.User
-	2,  // 37: lenic.Lenic.GetUserFollowing:output_type -> lenic.User
-	12, // 38: lenic.Lenic.FollowUser:output_type -> lenic.FollowUserResponse
-	14, // 39: lenic.Lenic.AcceptFollow:output_type -> lenic.AcceptFollowResponse
-	16, // 40: lenic.Lenic.UnfollowUser:output_type -> lenic.UnfollowUserResponse
-	8,  // 41: lenic.Lenic.UpdateUserPass:output_type -> lenic.UpdateUserPassResponse
-	10, // 42: lenic.Lenic.DeleteUser:output_type -> lenic.DeleteUserResponse
-	18, // 43: lenic.Lenic.StartConversation:output_type -> lenic.StartConversationResponse
-	17, // 44: lenic.Lenic.GetUserConversations:output_type -> lenic.Conversation
-	21, // 45: lenic.Lenic.ReadConversation:output_type -> lenic.ReadConversationResponse
-	23, // 46: lenic.Lenic.SendDM:output_type -> lenic.SendDMResponse
-	22, // 47: lenic.Lenic.GetConversationDMs:output_type -> lenic.DM
-	26, // 48: lenic.Lenic.CreatePost:output_type -> lenic.CreatePostResponse
-	25, // 49: lenic.Lenic.GetPost:output_type -> lenic.Post
-	25, // 50: lenic.Lenic.GetUserPosts:output_type -> lenic.Post
-	25, // 51: lenic.Lenic.GetUserPublicPosts:output_type -> lenic.Post
-	25, // 52: lenic.Lenic.GetFeed:output_type -> lenic.Post
-	35, // 53: lenic.Lenic.RatePostUp:output_type -> lenic.RatePostUpResponse
-	36, // 54: lenic.Lenic.RatePostDown:output_type -> lenic.RatePostDownResponse
-	31, // 55: lenic.Lenic.UpdatePost:output_type -> lenic.UpdatePostResponse
-	33, // 56: lenic.Lenic.DeletePost:output_type -> lenic.DeletePostResponse
-	38, // 57: lenic.Lenic.CreateComment:output_type -> lenic.CreateCommentResponse
-	37, // 58: lenic.Lenic.GetComment:output_type -> lenic.Comment
-	37, // 59: lenic.Lenic.GetCommentsFromPost:output_type -> lenic.Comment
-	45, // 60: lenic.Lenic.RateCommentUp:output_type -> lenic.RateCommentUpResponse
-	46, // 61: lenic.Lenic.RateCommentDown:output_type -> lenic.RateCommentDownResponse
-	41, // 62: lenic.Lenic.UpdateComment:output_type -> lenic.UpdateCommentResponse
-	43, // 63: lenic.Lenic.DeleteComment:output_type -> lenic.DeleteCommentResponse
-	32, // [32:64] is the sub-list for method output_type
-	0,  // [0:32] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	3,  // 0: lenic.FollowSuggestion.user:type_name -> lenic.User
+	32, // 1: lenic.GetRelationshipsResponse.relationships:type_name -> lenic.Relationship
+	0,  // 2: lenic.Post.visibility:type_name -> lenic.Visibility
+	0,  // 3: lenic.PostRevision.visibility:type_name -> lenic.Visibility
+	3,  // 4: lenic.PostVote.user:type_name -> lenic.User
+	1,  // 5: lenic.Lenic.Login:input_type -> lenic.LoginRequest
+	3,  // 6: lenic.Lenic.CreateUser:input_type -> lenic.User
+	5,  // 7: lenic.Lenic.GetUser:input_type -> lenic.GetUserRequest
+	6,  // 8: lenic.Lenic.SearchUsers:input_type -> lenic.SearchUsersRequest
+	7,  // 9: lenic.Lenic.GetUserFollowers:input_type -> lenic.GetUserFollowersRequest
+	8,  // 10: lenic.Lenic.GetUserFollowing:input_type -> lenic.GetUserFollowingRequest
+	19, // 11: lenic.Lenic.FollowUser:input_type -> lenic.FollowUserRequest
+	21, // 12: lenic.Lenic.AcceptFollow:input_type -> lenic.AcceptFollowRequest
+	23, // 13: lenic.Lenic.UnfollowUser:input_type -> lenic.UnfollowRequest
+	25, // 14: lenic.Lenic.RejectFollow:input_type -> lenic.RejectFollowRequest
+	27, // 15: lenic.Lenic.CancelFollowRequest:input_type -> lenic.CancelFollowRequestRequest
+	29, // 16: lenic.Lenic.ListIncomingFollowRequests:input_type -> lenic.ListFollowRequestsRequest
+	29, // 17: lenic.Lenic.ListOutgoingFollowRequests:input_type -> lenic.ListFollowRequestsRequest
+	30, // 18: lenic.Lenic.GetFollowSuggestions:input_type -> lenic.GetFollowSuggestionsRequest
+	33, // 19: lenic.Lenic.GetRelationship:input_type -> lenic.GetRelationshipRequest
+	34, // 20: lenic.Lenic.GetRelationships:input_type -> lenic.GetRelationshipsRequest
+	36, // 21: lenic.Lenic.BlockUser:input_type -> lenic.BlockRequest
+	36, // 22: lenic.Lenic.UnblockUser:input_type -> lenic.BlockRequest
+	38, // 23: lenic.Lenic.MuteUser:input_type -> lenic.MuteRequest
+	38, // 24: lenic.Lenic.UnmuteUser:input_type -> lenic.MuteRequest
+	3,  // 25: lenic.Lenic.UpdateUserPass:input_type -> lenic.User
+	10, // 26: lenic.Lenic.DeleteUser:input_type -> lenic.DeleteUserRequest
+	12, // 27: lenic.Lenic.RestoreAccount:input_type -> lenic.RestoreAccountRequest
+	14, // 28: lenic.Lenic.SetAccountPrivacy:input_type -> lenic.SetAccountPrivacyRequest
+	16, // 29: lenic.Lenic.AddCloseFriend:input_type -> lenic.CloseFriendRequest
+	16, // 30: lenic.Lenic.RemoveCloseFriend:input_type -> lenic.CloseFriendRequest
+	18, // 31: lenic.Lenic.GetCloseFriends:input_type -> lenic.GetCloseFriendsRequest
+	40, // 32: lenic.Lenic.StartConversation:input_type -> lenic.Conversation
+	42, // 33: lenic.Lenic.GetUserConversations:input_type -> lenic.GetUserConversationsRequest
+	43, // 34: lenic.Lenic.ReadConversation:input_type -> lenic.ReadConversationRequest
+	45, // 35: lenic.Lenic.SendDM:input_type -> lenic.DM
+	47, // 36: lenic.Lenic.GetConversationDMs:input_type -> lenic.GetConversationDMsRequest
+	48, // 37: lenic.Lenic.CreatePost:input_type -> lenic.Post
+	50, // 38: lenic.Lenic.GetPost:input_type -> lenic.GetPostRequest
+	52, // 39: lenic.Lenic.GetPostRevisions:input_type -> lenic.GetPostRevisionsRequest
+	53, // 40: lenic.Lenic.GetPostRevision:input_type -> lenic.GetPostRevisionRequest
+	54, // 41: lenic.Lenic.GetUserPosts:input_type -> lenic.GetUserPostsRequest
+	55, // 42: lenic.Lenic.GetUserPublicPosts:input_type -> lenic.GetUserPublicPostsRequest
+	56, // 43: lenic.Lenic.GetFeed:input_type -> lenic.GetFeedRequest
+	57, // 44: lenic.Lenic.SearchPosts:input_type -> lenic.SearchPostsRequest
+	62, // 45: lenic.Lenic.GetPostRatings:input_type -> lenic.GetPostRatingsRequest
+	61, // 46: lenic.Lenic.RatePostUp:input_type -> lenic.PostRating
+	61, // 47: lenic.Lenic.RatePostDown:input_type -> lenic.PostRating
+	66, // 48: lenic.Lenic.SetPostRating:input_type -> lenic.SetPostRatingRequest
+	48, // 49: lenic.Lenic.UpdatePost:input_type -> lenic.Post
+	59, // 50: lenic.Lenic.DeletePost:input_type -> lenic.DeletePostRequest
+	68, // 51: lenic.Lenic.CreateComment:input_type -> lenic.Comment
+	73, // 52: lenic.Lenic.GetComment:input_type -> lenic.GetCommentRequest
+	74, // 53: lenic.Lenic.GetCommentsFromPost:input_type -> lenic.GetCommentsFromPostRequest
+	71, // 54: lenic.Lenic.SearchComments:input_type -> lenic.SearchCommentsRequest
+	70, // 55: lenic.Lenic.GetCommentRevisions:input_type -> lenic.GetCommentRevisionsRequest
+	78, // 56: lenic.Lenic.RateCommentUp:input_type -> lenic.CommentRating
+	78, // 57: lenic.Lenic.RateCommentDown:input_type -> lenic.CommentRating
+	81, // 58: lenic.Lenic.SetCommentRating:input_type -> lenic.SetCommentRatingRequest
+	68, // 59: lenic.Lenic.UpdateComment:input_type -> lenic.Comment
+	76, // 60: lenic.Lenic.DeleteComment:input_type -> lenic.DeleteCommentRequest
+	2,  // 61: lenic.Lenic.Login:output_type -> lenic.LoginResponse
+	4,  // 62: lenic.Lenic.CreateUser:output_type -> lenic.CreateUserResponse
+	3,  // 63: lenic.Lenic.GetUser:output_type -> lenic.User
+	3,  // 64: lenic.Lenic.SearchUsers:output_type -> lenic.User
+	3,  // 65: lenic.Lenic.GetUserFollowers:output_type -> lenic.User
+	3,  // 66: lenic.Lenic.GetUserFollowing:output_type -> lenic.User
+	20, // 67: lenic.Lenic.FollowUser:output_type -> lenic.FollowUserResponse
+	22, // 68: lenic.Lenic.AcceptFollow:output_type -> lenic.AcceptFollowResponse
+	24, // 69: lenic.Lenic.UnfollowUser:output_type -> lenic.UnfollowUserResponse
+	26, // 70: lenic.Lenic.RejectFollow:output_type -> lenic.RejectFollowResponse
+	28, // 71: lenic.Lenic.CancelFollowRequest:output_type -> lenic.CancelFollowRequestResponse
+	3,  // 72: lenic.Lenic.ListIncomingFollowRequests:output_type -> lenic.User
+	3,  // 73: lenic.Lenic.ListOutgoingFollowRequests:output_type -> lenic.User
+	31, // 74: lenic.Lenic.GetFollowSuggestions:output_type -> lenic.FollowSuggestion
+	32, // 75: lenic.Lenic.GetRelationship:output_type -> lenic.Relationship
+	35, // 76: lenic.Lenic.GetRelationships:output_type -> lenic.GetRelationshipsResponse
+	37, // 77: lenic.Lenic.BlockUser:output_type -> lenic.BlockResponse
+	37, // 78: lenic.Lenic.UnblockUser:output_type -> lenic.BlockResponse
+	39, // 79: lenic.Lenic.MuteUser:output_type -> lenic.MuteResponse
+	39, // 80: lenic.Lenic.UnmuteUser:output_type -> lenic.MuteResponse
+	9,  // 81: lenic.Lenic.UpdateUserPass:output_type -> lenic.UpdateUserPassResponse
+	11, // 82: lenic.Lenic.DeleteUser:output_type -> lenic.DeleteUserResponse
+	13, // 83: lenic.Lenic.RestoreAccount:output_type -> lenic.RestoreAccountResponse
+	15, // 84: lenic.Lenic.SetAccountPrivacy:output_type -> lenic.SetAccountPrivacyResponse
+	17, // 85: lenic.Lenic.AddCloseFriend:output_type -> lenic.CloseFriendResponse
+	17, // 86: lenic.Lenic.RemoveCloseFriend:output_type -> lenic.CloseFriendResponse
+	3,  // 87: lenic.Lenic.GetCloseFriends:output_type -> lenic.User
+	41, // 88: lenic.Lenic.StartConversation:output_type -> lenic.StartConversationResponse
+	40, // 89: lenic.Lenic.GetUserConversations:output_type -> lenic.Conversation
+	44, // 90: lenic.Lenic.ReadConversation:output_type -> lenic.ReadConversationResponse
+	46, // 91: lenic.Lenic.SendDM:output_type -> lenic.SendDMResponse
+	45, // 92: lenic.Lenic.GetConversationDMs:output_type -> lenic.DM
+	49, // 93: lenic.Lenic.CreatePost:output_type -> lenic.CreatePostResponse
+	48, // 94: lenic.Lenic.GetPost:output_type -> lenic.Post
+	51, // 95: lenic.Lenic.GetPostRevisions:output_type -> lenic.PostRevision
+	51, // 96: lenic.Lenic.GetPostRevision:output_type -> lenic.PostRevision
+	48, // 97: lenic.Lenic.GetUserPosts:output_type -> lenic.Post
+	48, // 98: lenic.Lenic.GetUserPublicPosts:output_type -> lenic.Post
+	48, // 99: lenic.Lenic.GetFeed:output_type -> lenic.Post
+	48, // 100: lenic.Lenic.SearchPosts:output_type -> lenic.Post
+	63, // 101: lenic.Lenic.GetPostRatings:output_type -> lenic.PostVote
+	64, // 102: lenic.Lenic.RatePostUp:output_type -> lenic.RatePostUpResponse
+	65, // 103: lenic.Lenic.RatePostDown:output_type -> lenic.RatePostDownResponse
+	67, // 104: lenic.Lenic.SetPostRating:output_type -> lenic.SetPostRatingResponse
+	58, // 105: lenic.Lenic.UpdatePost:output_type -> lenic.UpdatePostResponse
+	60, // 106: lenic.Lenic.DeletePost:output_type -> lenic.DeletePostResponse
+	72, // 107: lenic.Lenic.CreateComment:output_type -> lenic.CreateCommentResponse
+	68, // 108: lenic.Lenic.GetComment:output_type -> lenic.Comment
+	68, // 109: lenic.Lenic.GetCommentsFromPost:output_type -> lenic.Comment
+	68, // 110: lenic.Lenic.SearchComments:output_type -> lenic.Comment
+	69, // 111: lenic.Lenic.GetCommentRevisions:output_type -> lenic.CommentRevision
+	79, // 112: lenic.Lenic.RateCommentUp:output_type -> lenic.RateCommentUpResponse
+	80, // 113: lenic.Lenic.RateCommentDown:output_type -> lenic.RateCommentDownResponse
+	82, // 114: lenic.Lenic.SetCommentRating:output_type -> lenic.SetCommentRatingResponse
+	75, // 115: lenic.Lenic.UpdateComment:output_type -> lenic.UpdateCommentResponse
+	77, // 116: lenic.Lenic.DeleteComment:output_type -> lenic.DeleteCommentResponse
+	61, // [61:117] is the sub-list for method output_type
+	5,  // [5:61] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_lenic_proto_init() }
@@ -2944,13 +5692,14 @@ func file_lenic_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lenic_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   47,
+			NumEnums:      1,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_lenic_proto_goTypes,
 		DependencyIndexes: file_lenic_proto_depIdxs,
+		EnumInfos:         file_lenic_proto_enumTypes,
 		MessageInfos:      file_lenic_proto_msgTypes,
 	}.Build()
 	File_lenic_proto = out.File
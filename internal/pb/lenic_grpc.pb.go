@@ -19,38 +19,62 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Lenic_Login_FullMethodName                = "/lenic.Lenic/Login"
-	Lenic_CreateUser_FullMethodName           = "/lenic.Lenic/CreateUser"
-	Lenic_GetUser_FullMethodName              = "/lenic.Lenic/GetUser"
-	Lenic_SearchUsers_FullMethodName          = "/lenic.Lenic/SearchUsers"
-	Lenic_GetUserFollowers_FullMethodName     = "/lenic.Lenic/GetUserFollowers"
-	Lenic_GetUserFollowing_FullMethodName     = "/lenic.Lenic/GetUserFollowing"
-	Lenic_FollowUser_FullMethodName           = "/lenic.Lenic/FollowUser"
-	Lenic_AcceptFollow_FullMethodName         = "/lenic.Lenic/AcceptFollow"
-	Lenic_UnfollowUser_FullMethodName         = "/lenic.Lenic/UnfollowUser"
-	Lenic_UpdateUserPass_FullMethodName       = "/lenic.Lenic/UpdateUserPass"
-	Lenic_DeleteUser_FullMethodName           = "/lenic.Lenic/DeleteUser"
-	Lenic_StartConversation_FullMethodName    = "/lenic.Lenic/StartConversation"
-	Lenic_GetUserConversations_FullMethodName = "/lenic.Lenic/GetUserConversations"
-	Lenic_ReadConversation_FullMethodName     = "/lenic.Lenic/ReadConversation"
-	Lenic_SendDM_FullMethodName               = "/lenic.Lenic/SendDM"
-	Lenic_GetConversationDMs_FullMethodName   = "/lenic.Lenic/GetConversationDMs"
-	Lenic_CreatePost_FullMethodName           = "/lenic.Lenic/CreatePost"
-	Lenic_GetPost_FullMethodName              = "/lenic.Lenic/GetPost"
-	Lenic_GetUserPosts_FullMethodName         = "/lenic.Lenic/GetUserPosts"
-	Lenic_GetUserPublicPosts_FullMethodName   = "/lenic.Lenic/GetUserPublicPosts"
-	Lenic_GetFeed_FullMethodName              = "/lenic.Lenic/GetFeed"
-	Lenic_RatePostUp_FullMethodName           = "/lenic.Lenic/RatePostUp"
-	Lenic_RatePostDown_FullMethodName         = "/lenic.Lenic/RatePostDown"
-	Lenic_UpdatePost_FullMethodName           = "/lenic.Lenic/UpdatePost"
-	Lenic_DeletePost_FullMethodName           = "/lenic.Lenic/DeletePost"
-	Lenic_CreateComment_FullMethodName        = "/lenic.Lenic/CreateComment"
-	Lenic_GetComment_FullMethodName           = "/lenic.Lenic/GetComment"
-	Lenic_GetCommentsFromPost_FullMethodName  = "/lenic.Lenic/GetCommentsFromPost"
-	Lenic_RateCommentUp_FullMethodName        = "/lenic.Lenic/RateCommentUp"
-	Lenic_RateCommentDown_FullMethodName      = "/lenic.Lenic/RateCommentDown"
-	Lenic_UpdateComment_FullMethodName        = "/lenic.Lenic/UpdateComment"
-	Lenic_DeleteComment_FullMethodName        = "/lenic.Lenic/DeleteComment"
+	Lenic_Login_FullMethodName                      = "/lenic.Lenic/Login"
+	Lenic_CreateUser_FullMethodName                 = "/lenic.Lenic/CreateUser"
+	Lenic_GetUser_FullMethodName                    = "/lenic.Lenic/GetUser"
+	Lenic_SearchUsers_FullMethodName                = "/lenic.Lenic/SearchUsers"
+	Lenic_GetUserFollowers_FullMethodName           = "/lenic.Lenic/GetUserFollowers"
+	Lenic_GetUserFollowing_FullMethodName           = "/lenic.Lenic/GetUserFollowing"
+	Lenic_FollowUser_FullMethodName                 = "/lenic.Lenic/FollowUser"
+	Lenic_AcceptFollow_FullMethodName               = "/lenic.Lenic/AcceptFollow"
+	Lenic_UnfollowUser_FullMethodName               = "/lenic.Lenic/UnfollowUser"
+	Lenic_RejectFollow_FullMethodName               = "/lenic.Lenic/RejectFollow"
+	Lenic_CancelFollowRequest_FullMethodName        = "/lenic.Lenic/CancelFollowRequest"
+	Lenic_ListIncomingFollowRequests_FullMethodName = "/lenic.Lenic/ListIncomingFollowRequests"
+	Lenic_ListOutgoingFollowRequests_FullMethodName = "/lenic.Lenic/ListOutgoingFollowRequests"
+	Lenic_GetFollowSuggestions_FullMethodName       = "/lenic.Lenic/GetFollowSuggestions"
+	Lenic_GetRelationship_FullMethodName            = "/lenic.Lenic/GetRelationship"
+	Lenic_GetRelationships_FullMethodName           = "/lenic.Lenic/GetRelationships"
+	Lenic_BlockUser_FullMethodName                  = "/lenic.Lenic/BlockUser"
+	Lenic_UnblockUser_FullMethodName                = "/lenic.Lenic/UnblockUser"
+	Lenic_MuteUser_FullMethodName                   = "/lenic.Lenic/MuteUser"
+	Lenic_UnmuteUser_FullMethodName                 = "/lenic.Lenic/UnmuteUser"
+	Lenic_UpdateUserPass_FullMethodName             = "/lenic.Lenic/UpdateUserPass"
+	Lenic_DeleteUser_FullMethodName                 = "/lenic.Lenic/DeleteUser"
+	Lenic_RestoreAccount_FullMethodName             = "/lenic.Lenic/RestoreAccount"
+	Lenic_SetAccountPrivacy_FullMethodName          = "/lenic.Lenic/SetAccountPrivacy"
+	Lenic_AddCloseFriend_FullMethodName             = "/lenic.Lenic/AddCloseFriend"
+	Lenic_RemoveCloseFriend_FullMethodName          = "/lenic.Lenic/RemoveCloseFriend"
+	Lenic_GetCloseFriends_FullMethodName            = "/lenic.Lenic/GetCloseFriends"
+	Lenic_StartConversation_FullMethodName          = "/lenic.Lenic/StartConversation"
+	Lenic_GetUserConversations_FullMethodName       = "/lenic.Lenic/GetUserConversations"
+	Lenic_ReadConversation_FullMethodName           = "/lenic.Lenic/ReadConversation"
+	Lenic_SendDM_FullMethodName                     = "/lenic.Lenic/SendDM"
+	Lenic_GetConversationDMs_FullMethodName         = "/lenic.Lenic/GetConversationDMs"
+	Lenic_CreatePost_FullMethodName                 = "/lenic.Lenic/CreatePost"
+	Lenic_GetPost_FullMethodName                    = "/lenic.Lenic/GetPost"
+	Lenic_GetPostRevisions_FullMethodName           = "/lenic.Lenic/GetPostRevisions"
+	Lenic_GetPostRevision_FullMethodName            = "/lenic.Lenic/GetPostRevision"
+	Lenic_GetUserPosts_FullMethodName               = "/lenic.Lenic/GetUserPosts"
+	Lenic_GetUserPublicPosts_FullMethodName         = "/lenic.Lenic/GetUserPublicPosts"
+	Lenic_GetFeed_FullMethodName                    = "/lenic.Lenic/GetFeed"
+	Lenic_SearchPosts_FullMethodName                = "/lenic.Lenic/SearchPosts"
+	Lenic_GetPostRatings_FullMethodName             = "/lenic.Lenic/GetPostRatings"
+	Lenic_RatePostUp_FullMethodName                 = "/lenic.Lenic/RatePostUp"
+	Lenic_RatePostDown_FullMethodName               = "/lenic.Lenic/RatePostDown"
+	Lenic_SetPostRating_FullMethodName              = "/lenic.Lenic/SetPostRating"
+	Lenic_UpdatePost_FullMethodName                 = "/lenic.Lenic/UpdatePost"
+	Lenic_DeletePost_FullMethodName                 = "/lenic.Lenic/DeletePost"
+	Lenic_CreateComment_FullMethodName              = "/lenic.Lenic/CreateComment"
+	Lenic_GetComment_FullMethodName                 = "/lenic.Lenic/GetComment"
+	Lenic_GetCommentsFromPost_FullMethodName        = "/lenic.Lenic/GetCommentsFromPost"
+	Lenic_SearchComments_FullMethodName             = "/lenic.Lenic/SearchComments"
+	Lenic_GetCommentRevisions_FullMethodName        = "/lenic.Lenic/GetCommentRevisions"
+	Lenic_RateCommentUp_FullMethodName              = "/lenic.Lenic/RateCommentUp"
+	Lenic_RateCommentDown_FullMethodName            = "/lenic.Lenic/RateCommentDown"
+	Lenic_SetCommentRating_FullMethodName           = "/lenic.Lenic/SetCommentRating"
+	Lenic_UpdateComment_FullMethodName              = "/lenic.Lenic/UpdateComment"
+	Lenic_DeleteComment_FullMethodName              = "/lenic.Lenic/DeleteComment"
 )
 
 // LenicClient is the client API for Lenic service.
@@ -58,35 +82,81 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LenicClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// CreateUser message User{2, 3, 4}
 	CreateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
+	// SearchUsers and the other list streams send one page, and the token
+	// of the next one in the next-page-token trailer when there is more
 	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
 	GetUserFollowers(ctx context.Context, in *GetUserFollowersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
 	GetUserFollowing(ctx context.Context, in *GetUserFollowingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
 	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error)
 	AcceptFollow(ctx context.Context, in *AcceptFollowRequest, opts ...grpc.CallOption) (*AcceptFollowResponse, error)
 	UnfollowUser(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error)
+	RejectFollow(ctx context.Context, in *RejectFollowRequest, opts ...grpc.CallOption) (*RejectFollowResponse, error)
+	CancelFollowRequest(ctx context.Context, in *CancelFollowRequestRequest, opts ...grpc.CallOption) (*CancelFollowRequestResponse, error)
+	ListIncomingFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
+	ListOutgoingFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
+	GetFollowSuggestions(ctx context.Context, in *GetFollowSuggestionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FollowSuggestion], error)
+	GetRelationship(ctx context.Context, in *GetRelationshipRequest, opts ...grpc.CallOption) (*Relationship, error)
+	GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error)
+	BlockUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	UnblockUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	MuteUser(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error)
+	UnmuteUser(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error)
+	// UpdateUserPass message User{2, 4}
 	UpdateUserPass(ctx context.Context, in *User, opts ...grpc.CallOption) (*UpdateUserPassResponse, error)
+	// DeleteUser deactivates the account, RestoreAccount brings it back
+	// within the grace period, after which it's deleted for good
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...grpc.CallOption) (*RestoreAccountResponse, error)
+	SetAccountPrivacy(ctx context.Context, in *SetAccountPrivacyRequest, opts ...grpc.CallOption) (*SetAccountPrivacyResponse, error)
+	AddCloseFriend(ctx context.Context, in *CloseFriendRequest, opts ...grpc.CallOption) (*CloseFriendResponse, error)
+	RemoveCloseFriend(ctx context.Context, in *CloseFriendRequest, opts ...grpc.CallOption) (*CloseFriendResponse, error)
+	GetCloseFriends(ctx context.Context, in *GetCloseFriendsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
+	// StartConversation message Conversation{2, 3}
 	StartConversation(ctx context.Context, in *Conversation, opts ...grpc.CallOption) (*StartConversationResponse, error)
 	GetUserConversations(ctx context.Context, in *GetUserConversationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Conversation], error)
 	ReadConversation(ctx context.Context, in *ReadConversationRequest, opts ...grpc.CallOption) (*ReadConversationResponse, error)
+	// SendDM message DM{2, 3, 4}
 	SendDM(ctx context.Context, in *DM, opts ...grpc.CallOption) (*SendDMResponse, error)
 	GetConversationDMs(ctx context.Context, in *GetConversationDMsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DM], error)
+	// CreatePost message Post{3, 4, 5, 11, 12}
 	CreatePost(ctx context.Context, in *Post, opts ...grpc.CallOption) (*CreatePostResponse, error)
 	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*Post, error)
+	GetPostRevisions(ctx context.Context, in *GetPostRevisionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PostRevision], error)
+	GetPostRevision(ctx context.Context, in *GetPostRevisionRequest, opts ...grpc.CallOption) (*PostRevision, error)
 	GetUserPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error)
 	GetUserPublicPosts(ctx context.Context, in *GetUserPublicPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error)
 	GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error)
+	// SearchPosts streams the posts the caller may see that match the query,
+	// best match first
+	SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error)
+	// GetPostRatings streams who voted on a post, leaving out private
+	// accounts the caller doesn't follow and users either side blocked
+	GetPostRatings(ctx context.Context, in *GetPostRatingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PostVote], error)
 	RatePostUp(ctx context.Context, in *PostRating, opts ...grpc.CallOption) (*RatePostUpResponse, error)
 	RatePostDown(ctx context.Context, in *PostRating, opts ...grpc.CallOption) (*RatePostDownResponse, error)
+	// SetPostRating sets the vote of the caller, repeating it changes
+	// nothing
+	SetPostRating(ctx context.Context, in *SetPostRatingRequest, opts ...grpc.CallOption) (*SetPostRatingResponse, error)
+	// UpdatePost message Post{2, 4, 5, 11, 12}
 	UpdatePost(ctx context.Context, in *Post, opts ...grpc.CallOption) (*UpdatePostResponse, error)
 	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	// CreateComment message Comment{2, 3, 4}
 	CreateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*CreateCommentResponse, error)
 	GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*Comment, error)
 	GetCommentsFromPost(ctx context.Context, in *GetCommentsFromPostRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Comment], error)
+	// SearchComments is SearchPosts for comments, a comment is only matched
+	// if the caller may see its post
+	SearchComments(ctx context.Context, in *SearchCommentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Comment], error)
+	// GetCommentRevisions is only open to the authors of the comment and
+	// of its post
+	GetCommentRevisions(ctx context.Context, in *GetCommentRevisionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommentRevision], error)
 	RateCommentUp(ctx context.Context, in *CommentRating, opts ...grpc.CallOption) (*RateCommentUpResponse, error)
 	RateCommentDown(ctx context.Context, in *CommentRating, opts ...grpc.CallOption) (*RateCommentDownResponse, error)
+	SetCommentRating(ctx context.Context, in *SetCommentRatingRequest, opts ...grpc.CallOption) (*SetCommentRatingResponse, error)
+	// UpdateComment message Comment{1, 4}
 	UpdateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*UpdateCommentResponse, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
 }
@@ -216,6 +286,143 @@ func (c *lenicClient) UnfollowUser(ctx context.Context, in *UnfollowRequest, opt
 	return out, nil
 }
 
+func (c *lenicClient) RejectFollow(ctx context.Context, in *RejectFollowRequest, opts ...grpc.CallOption) (*RejectFollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectFollowResponse)
+	err := c.cc.Invoke(ctx, Lenic_RejectFollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) CancelFollowRequest(ctx context.Context, in *CancelFollowRequestRequest, opts ...grpc.CallOption) (*CancelFollowRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelFollowRequestResponse)
+	err := c.cc.Invoke(ctx, Lenic_CancelFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) ListIncomingFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[3], Lenic_ListIncomingFollowRequests_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListFollowRequestsRequest, User]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_ListIncomingFollowRequestsClient = grpc.ServerStreamingClient[User]
+
+func (c *lenicClient) ListOutgoingFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[4], Lenic_ListOutgoingFollowRequests_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListFollowRequestsRequest, User]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_ListOutgoingFollowRequestsClient = grpc.ServerStreamingClient[User]
+
+func (c *lenicClient) GetFollowSuggestions(ctx context.Context, in *GetFollowSuggestionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FollowSuggestion], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[5], Lenic_GetFollowSuggestions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetFollowSuggestionsRequest, FollowSuggestion]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetFollowSuggestionsClient = grpc.ServerStreamingClient[FollowSuggestion]
+
+func (c *lenicClient) GetRelationship(ctx context.Context, in *GetRelationshipRequest, opts ...grpc.CallOption) (*Relationship, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Relationship)
+	err := c.cc.Invoke(ctx, Lenic_GetRelationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelationshipsResponse)
+	err := c.cc.Invoke(ctx, Lenic_GetRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) BlockUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, Lenic_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) UnblockUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, Lenic_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) MuteUser(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteResponse)
+	err := c.cc.Invoke(ctx, Lenic_MuteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) UnmuteUser(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteResponse)
+	err := c.cc.Invoke(ctx, Lenic_UnmuteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lenicClient) UpdateUserPass(ctx context.Context, in *User, opts ...grpc.CallOption) (*UpdateUserPassResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateUserPassResponse)
@@ -236,6 +443,65 @@ func (c *lenicClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opt
 	return out, nil
 }
 
+func (c *lenicClient) RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...grpc.CallOption) (*RestoreAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreAccountResponse)
+	err := c.cc.Invoke(ctx, Lenic_RestoreAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) SetAccountPrivacy(ctx context.Context, in *SetAccountPrivacyRequest, opts ...grpc.CallOption) (*SetAccountPrivacyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAccountPrivacyResponse)
+	err := c.cc.Invoke(ctx, Lenic_SetAccountPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) AddCloseFriend(ctx context.Context, in *CloseFriendRequest, opts ...grpc.CallOption) (*CloseFriendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseFriendResponse)
+	err := c.cc.Invoke(ctx, Lenic_AddCloseFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) RemoveCloseFriend(ctx context.Context, in *CloseFriendRequest, opts ...grpc.CallOption) (*CloseFriendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseFriendResponse)
+	err := c.cc.Invoke(ctx, Lenic_RemoveCloseFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) GetCloseFriends(ctx context.Context, in *GetCloseFriendsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[6], Lenic_GetCloseFriends_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetCloseFriendsRequest, User]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetCloseFriendsClient = grpc.ServerStreamingClient[User]
+
 func (c *lenicClient) StartConversation(ctx context.Context, in *Conversation, opts ...grpc.CallOption) (*StartConversationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StartConversationResponse)
@@ -248,7 +514,7 @@ func (c *lenicClient) StartConversation(ctx context.Context, in *Conversation, o
 
 func (c *lenicClient) GetUserConversations(ctx context.Context, in *GetUserConversationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Conversation], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[3], Lenic_GetUserConversations_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[7], Lenic_GetUserConversations_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -287,7 +553,7 @@ func (c *lenicClient) SendDM(ctx context.Context, in *DM, opts ...grpc.CallOptio
 
 func (c *lenicClient) GetConversationDMs(ctx context.Context, in *GetConversationDMsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DM], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[4], Lenic_GetConversationDMs_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[8], Lenic_GetConversationDMs_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -324,9 +590,38 @@ func (c *lenicClient) GetPost(ctx context.Context, in *GetPostRequest, opts ...g
 	return out, nil
 }
 
+func (c *lenicClient) GetPostRevisions(ctx context.Context, in *GetPostRevisionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PostRevision], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[9], Lenic_GetPostRevisions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetPostRevisionsRequest, PostRevision]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetPostRevisionsClient = grpc.ServerStreamingClient[PostRevision]
+
+func (c *lenicClient) GetPostRevision(ctx context.Context, in *GetPostRevisionRequest, opts ...grpc.CallOption) (*PostRevision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostRevision)
+	err := c.cc.Invoke(ctx, Lenic_GetPostRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lenicClient) GetUserPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[5], Lenic_GetUserPosts_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[10], Lenic_GetUserPosts_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -345,7 +640,7 @@ type Lenic_GetUserPostsClient = grpc.ServerStreamingClient[Post]
 
 func (c *lenicClient) GetUserPublicPosts(ctx context.Context, in *GetUserPublicPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[6], Lenic_GetUserPublicPosts_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[11], Lenic_GetUserPublicPosts_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -364,7 +659,7 @@ type Lenic_GetUserPublicPostsClient = grpc.ServerStreamingClient[Post]
 
 func (c *lenicClient) GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[7], Lenic_GetFeed_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[12], Lenic_GetFeed_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -381,6 +676,44 @@ func (c *lenicClient) GetFeed(ctx context.Context, in *GetFeedRequest, opts ...g
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Lenic_GetFeedClient = grpc.ServerStreamingClient[Post]
 
+func (c *lenicClient) SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[13], Lenic_SearchPosts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchPostsRequest, Post]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_SearchPostsClient = grpc.ServerStreamingClient[Post]
+
+func (c *lenicClient) GetPostRatings(ctx context.Context, in *GetPostRatingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PostVote], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[14], Lenic_GetPostRatings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetPostRatingsRequest, PostVote]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetPostRatingsClient = grpc.ServerStreamingClient[PostVote]
+
 func (c *lenicClient) RatePostUp(ctx context.Context, in *PostRating, opts ...grpc.CallOption) (*RatePostUpResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RatePostUpResponse)
@@ -401,6 +734,16 @@ func (c *lenicClient) RatePostDown(ctx context.Context, in *PostRating, opts ...
 	return out, nil
 }
 
+func (c *lenicClient) SetPostRating(ctx context.Context, in *SetPostRatingRequest, opts ...grpc.CallOption) (*SetPostRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPostRatingResponse)
+	err := c.cc.Invoke(ctx, Lenic_SetPostRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lenicClient) UpdatePost(ctx context.Context, in *Post, opts ...grpc.CallOption) (*UpdatePostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdatePostResponse)
@@ -443,7 +786,7 @@ func (c *lenicClient) GetComment(ctx context.Context, in *GetCommentRequest, opt
 
 func (c *lenicClient) GetCommentsFromPost(ctx context.Context, in *GetCommentsFromPostRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Comment], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[8], Lenic_GetCommentsFromPost_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[15], Lenic_GetCommentsFromPost_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -460,6 +803,44 @@ func (c *lenicClient) GetCommentsFromPost(ctx context.Context, in *GetCommentsFr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Lenic_GetCommentsFromPostClient = grpc.ServerStreamingClient[Comment]
 
+func (c *lenicClient) SearchComments(ctx context.Context, in *SearchCommentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Comment], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[16], Lenic_SearchComments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchCommentsRequest, Comment]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_SearchCommentsClient = grpc.ServerStreamingClient[Comment]
+
+func (c *lenicClient) GetCommentRevisions(ctx context.Context, in *GetCommentRevisionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommentRevision], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[17], Lenic_GetCommentRevisions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetCommentRevisionsRequest, CommentRevision]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetCommentRevisionsClient = grpc.ServerStreamingClient[CommentRevision]
+
 func (c *lenicClient) RateCommentUp(ctx context.Context, in *CommentRating, opts ...grpc.CallOption) (*RateCommentUpResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RateCommentUpResponse)
@@ -480,6 +861,16 @@ func (c *lenicClient) RateCommentDown(ctx context.Context, in *CommentRating, op
 	return out, nil
 }
 
+func (c *lenicClient) SetCommentRating(ctx context.Context, in *SetCommentRatingRequest, opts ...grpc.CallOption) (*SetCommentRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCommentRatingResponse)
+	err := c.cc.Invoke(ctx, Lenic_SetCommentRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lenicClient) UpdateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*UpdateCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateCommentResponse)
@@ -505,35 +896,81 @@ func (c *lenicClient) DeleteComment(ctx context.Context, in *DeleteCommentReques
 // for forward compatibility.
 type LenicServer interface {
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// CreateUser message User{2, 3, 4}
 	CreateUser(context.Context, *User) (*CreateUserResponse, error)
 	GetUser(context.Context, *GetUserRequest) (*User, error)
+	// SearchUsers and the other list streams send one page, and the token
+	// of the next one in the next-page-token trailer when there is more
 	SearchUsers(*SearchUsersRequest, grpc.ServerStreamingServer[User]) error
 	GetUserFollowers(*GetUserFollowersRequest, grpc.ServerStreamingServer[User]) error
 	GetUserFollowing(*GetUserFollowingRequest, grpc.ServerStreamingServer[User]) error
 	FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error)
 	AcceptFollow(context.Context, *AcceptFollowRequest) (*AcceptFollowResponse, error)
 	UnfollowUser(context.Context, *UnfollowRequest) (*UnfollowUserResponse, error)
+	RejectFollow(context.Context, *RejectFollowRequest) (*RejectFollowResponse, error)
+	CancelFollowRequest(context.Context, *CancelFollowRequestRequest) (*CancelFollowRequestResponse, error)
+	ListIncomingFollowRequests(*ListFollowRequestsRequest, grpc.ServerStreamingServer[User]) error
+	ListOutgoingFollowRequests(*ListFollowRequestsRequest, grpc.ServerStreamingServer[User]) error
+	GetFollowSuggestions(*GetFollowSuggestionsRequest, grpc.ServerStreamingServer[FollowSuggestion]) error
+	GetRelationship(context.Context, *GetRelationshipRequest) (*Relationship, error)
+	GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error)
+	BlockUser(context.Context, *BlockRequest) (*BlockResponse, error)
+	UnblockUser(context.Context, *BlockRequest) (*BlockResponse, error)
+	MuteUser(context.Context, *MuteRequest) (*MuteResponse, error)
+	UnmuteUser(context.Context, *MuteRequest) (*MuteResponse, error)
+	// UpdateUserPass message User{2, 4}
 	UpdateUserPass(context.Context, *User) (*UpdateUserPassResponse, error)
+	// DeleteUser deactivates the account, RestoreAccount brings it back
+	// within the grace period, after which it's deleted for good
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	RestoreAccount(context.Context, *RestoreAccountRequest) (*RestoreAccountResponse, error)
+	SetAccountPrivacy(context.Context, *SetAccountPrivacyRequest) (*SetAccountPrivacyResponse, error)
+	AddCloseFriend(context.Context, *CloseFriendRequest) (*CloseFriendResponse, error)
+	RemoveCloseFriend(context.Context, *CloseFriendRequest) (*CloseFriendResponse, error)
+	GetCloseFriends(*GetCloseFriendsRequest, grpc.ServerStreamingServer[User]) error
+	// StartConversation message Conversation{2, 3}
 	StartConversation(context.Context, *Conversation) (*StartConversationResponse, error)
 	GetUserConversations(*GetUserConversationsRequest, grpc.ServerStreamingServer[Conversation]) error
 	ReadConversation(context.Context, *ReadConversationRequest) (*ReadConversationResponse, error)
+	// SendDM message DM{2, 3, 4}
 	SendDM(context.Context, *DM) (*SendDMResponse, error)
 	GetConversationDMs(*GetConversationDMsRequest, grpc.ServerStreamingServer[DM]) error
+	// CreatePost message Post{3, 4, 5, 11, 12}
 	CreatePost(context.Context, *Post) (*CreatePostResponse, error)
 	GetPost(context.Context, *GetPostRequest) (*Post, error)
+	GetPostRevisions(*GetPostRevisionsRequest, grpc.ServerStreamingServer[PostRevision]) error
+	GetPostRevision(context.Context, *GetPostRevisionRequest) (*PostRevision, error)
 	GetUserPosts(*GetUserPostsRequest, grpc.ServerStreamingServer[Post]) error
 	GetUserPublicPosts(*GetUserPublicPostsRequest, grpc.ServerStreamingServer[Post]) error
 	GetFeed(*GetFeedRequest, grpc.ServerStreamingServer[Post]) error
+	// SearchPosts streams the posts the caller may see that match the query,
+	// best match first
+	SearchPosts(*SearchPostsRequest, grpc.ServerStreamingServer[Post]) error
+	// GetPostRatings streams who voted on a post, leaving out private
+	// accounts the caller doesn't follow and users either side blocked
+	GetPostRatings(*GetPostRatingsRequest, grpc.ServerStreamingServer[PostVote]) error
 	RatePostUp(context.Context, *PostRating) (*RatePostUpResponse, error)
 	RatePostDown(context.Context, *PostRating) (*RatePostDownResponse, error)
+	// SetPostRating sets the vote of the caller, repeating it changes
+	// nothing
+	SetPostRating(context.Context, *SetPostRatingRequest) (*SetPostRatingResponse, error)
+	// UpdatePost message Post{2, 4, 5, 11, 12}
 	UpdatePost(context.Context, *Post) (*UpdatePostResponse, error)
 	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	// CreateComment message Comment{2, 3, 4}
 	CreateComment(context.Context, *Comment) (*CreateCommentResponse, error)
 	GetComment(context.Context, *GetCommentRequest) (*Comment, error)
 	GetCommentsFromPost(*GetCommentsFromPostRequest, grpc.ServerStreamingServer[Comment]) error
+	// SearchComments is SearchPosts for comments, a comment is only matched
+	// if the caller may see its post
+	SearchComments(*SearchCommentsRequest, grpc.ServerStreamingServer[Comment]) error
+	// GetCommentRevisions is only open to the authors of the comment and
+	// of its post
+	GetCommentRevisions(*GetCommentRevisionsRequest, grpc.ServerStreamingServer[CommentRevision]) error
 	RateCommentUp(context.Context, *CommentRating) (*RateCommentUpResponse, error)
 	RateCommentDown(context.Context, *CommentRating) (*RateCommentDownResponse, error)
+	SetCommentRating(context.Context, *SetCommentRatingRequest) (*SetCommentRatingResponse, error)
+	// UpdateComment message Comment{1, 4}
 	UpdateComment(context.Context, *Comment) (*UpdateCommentResponse, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
 	mustEmbedUnimplementedLenicServer()
@@ -573,12 +1010,60 @@ func (UnimplementedLenicServer) AcceptFollow(context.Context, *AcceptFollowReque
 func (UnimplementedLenicServer) UnfollowUser(context.Context, *UnfollowRequest) (*UnfollowUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
 }
+func (UnimplementedLenicServer) RejectFollow(context.Context, *RejectFollowRequest) (*RejectFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollow not implemented")
+}
+func (UnimplementedLenicServer) CancelFollowRequest(context.Context, *CancelFollowRequestRequest) (*CancelFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFollowRequest not implemented")
+}
+func (UnimplementedLenicServer) ListIncomingFollowRequests(*ListFollowRequestsRequest, grpc.ServerStreamingServer[User]) error {
+	return status.Errorf(codes.Unimplemented, "method ListIncomingFollowRequests not implemented")
+}
+func (UnimplementedLenicServer) ListOutgoingFollowRequests(*ListFollowRequestsRequest, grpc.ServerStreamingServer[User]) error {
+	return status.Errorf(codes.Unimplemented, "method ListOutgoingFollowRequests not implemented")
+}
+func (UnimplementedLenicServer) GetFollowSuggestions(*GetFollowSuggestionsRequest, grpc.ServerStreamingServer[FollowSuggestion]) error {
+	return status.Errorf(codes.Unimplemented, "method GetFollowSuggestions not implemented")
+}
+func (UnimplementedLenicServer) GetRelationship(context.Context, *GetRelationshipRequest) (*Relationship, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationship not implemented")
+}
+func (UnimplementedLenicServer) GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationships not implemented")
+}
+func (UnimplementedLenicServer) BlockUser(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedLenicServer) UnblockUser(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedLenicServer) MuteUser(context.Context, *MuteRequest) (*MuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (UnimplementedLenicServer) UnmuteUser(context.Context, *MuteRequest) (*MuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
+}
 func (UnimplementedLenicServer) UpdateUserPass(context.Context, *User) (*UpdateUserPassResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPass not implemented")
 }
 func (UnimplementedLenicServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedLenicServer) RestoreAccount(context.Context, *RestoreAccountRequest) (*RestoreAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAccount not implemented")
+}
+func (UnimplementedLenicServer) SetAccountPrivacy(context.Context, *SetAccountPrivacyRequest) (*SetAccountPrivacyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountPrivacy not implemented")
+}
+func (UnimplementedLenicServer) AddCloseFriend(context.Context, *CloseFriendRequest) (*CloseFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCloseFriend not implemented")
+}
+func (UnimplementedLenicServer) RemoveCloseFriend(context.Context, *CloseFriendRequest) (*CloseFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCloseFriend not implemented")
+}
+func (UnimplementedLenicServer) GetCloseFriends(*GetCloseFriendsRequest, grpc.ServerStreamingServer[User]) error {
+	return status.Errorf(codes.Unimplemented, "method GetCloseFriends not implemented")
+}
 func (UnimplementedLenicServer) StartConversation(context.Context, *Conversation) (*StartConversationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartConversation not implemented")
 }
@@ -600,6 +1085,12 @@ func (UnimplementedLenicServer) CreatePost(context.Context, *Post) (*CreatePostR
 func (UnimplementedLenicServer) GetPost(context.Context, *GetPostRequest) (*Post, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
 }
+func (UnimplementedLenicServer) GetPostRevisions(*GetPostRevisionsRequest, grpc.ServerStreamingServer[PostRevision]) error {
+	return status.Errorf(codes.Unimplemented, "method GetPostRevisions not implemented")
+}
+func (UnimplementedLenicServer) GetPostRevision(context.Context, *GetPostRevisionRequest) (*PostRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostRevision not implemented")
+}
 func (UnimplementedLenicServer) GetUserPosts(*GetUserPostsRequest, grpc.ServerStreamingServer[Post]) error {
 	return status.Errorf(codes.Unimplemented, "method GetUserPosts not implemented")
 }
@@ -609,12 +1100,21 @@ func (UnimplementedLenicServer) GetUserPublicPosts(*GetUserPublicPostsRequest, g
 func (UnimplementedLenicServer) GetFeed(*GetFeedRequest, grpc.ServerStreamingServer[Post]) error {
 	return status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
 }
+func (UnimplementedLenicServer) SearchPosts(*SearchPostsRequest, grpc.ServerStreamingServer[Post]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedLenicServer) GetPostRatings(*GetPostRatingsRequest, grpc.ServerStreamingServer[PostVote]) error {
+	return status.Errorf(codes.Unimplemented, "method GetPostRatings not implemented")
+}
 func (UnimplementedLenicServer) RatePostUp(context.Context, *PostRating) (*RatePostUpResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RatePostUp not implemented")
 }
 func (UnimplementedLenicServer) RatePostDown(context.Context, *PostRating) (*RatePostDownResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RatePostDown not implemented")
 }
+func (UnimplementedLenicServer) SetPostRating(context.Context, *SetPostRatingRequest) (*SetPostRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPostRating not implemented")
+}
 func (UnimplementedLenicServer) UpdatePost(context.Context, *Post) (*UpdatePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
 }
@@ -630,12 +1130,21 @@ func (UnimplementedLenicServer) GetComment(context.Context, *GetCommentRequest)
 func (UnimplementedLenicServer) GetCommentsFromPost(*GetCommentsFromPostRequest, grpc.ServerStreamingServer[Comment]) error {
 	return status.Errorf(codes.Unimplemented, "method GetCommentsFromPost not implemented")
 }
+func (UnimplementedLenicServer) SearchComments(*SearchCommentsRequest, grpc.ServerStreamingServer[Comment]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchComments not implemented")
+}
+func (UnimplementedLenicServer) GetCommentRevisions(*GetCommentRevisionsRequest, grpc.ServerStreamingServer[CommentRevision]) error {
+	return status.Errorf(codes.Unimplemented, "method GetCommentRevisions not implemented")
+}
 func (UnimplementedLenicServer) RateCommentUp(context.Context, *CommentRating) (*RateCommentUpResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RateCommentUp not implemented")
 }
 func (UnimplementedLenicServer) RateCommentDown(context.Context, *CommentRating) (*RateCommentDownResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RateCommentDown not implemented")
 }
+func (UnimplementedLenicServer) SetCommentRating(context.Context, *SetCommentRatingRequest) (*SetCommentRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommentRating not implemented")
+}
 func (UnimplementedLenicServer) UpdateComment(context.Context, *Comment) (*UpdateCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
 }
@@ -660,146 +1169,323 @@ func RegisterLenicServer(s grpc.ServiceRegistrar, srv LenicServer) {
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&Lenic_ServiceDesc, srv)
+	s.RegisterService(&Lenic_ServiceDesc, srv)
+}
+
+func _Lenic_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).CreateUser(ctx, req.(*User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_SearchUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchUsersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).SearchUsers(m, &grpc.GenericServerStream[SearchUsersRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_SearchUsersServer = grpc.ServerStreamingServer[User]
+
+func _Lenic_GetUserFollowers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetUserFollowersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetUserFollowers(m, &grpc.GenericServerStream[GetUserFollowersRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetUserFollowersServer = grpc.ServerStreamingServer[User]
+
+func _Lenic_GetUserFollowing_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetUserFollowingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetUserFollowing(m, &grpc.GenericServerStream[GetUserFollowingRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetUserFollowingServer = grpc.ServerStreamingServer[User]
+
+func _Lenic_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_FollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_AcceptFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).AcceptFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_AcceptFollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).AcceptFollow(ctx, req.(*AcceptFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_UnfollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).UnfollowUser(ctx, req.(*UnfollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_RejectFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).RejectFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_RejectFollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).RejectFollow(ctx, req.(*RejectFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_CancelFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).CancelFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_CancelFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).CancelFollowRequest(ctx, req.(*CancelFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_ListIncomingFollowRequests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFollowRequestsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).ListIncomingFollowRequests(m, &grpc.GenericServerStream[ListFollowRequestsRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_ListIncomingFollowRequestsServer = grpc.ServerStreamingServer[User]
+
+func _Lenic_ListOutgoingFollowRequests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFollowRequestsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).ListOutgoingFollowRequests(m, &grpc.GenericServerStream[ListFollowRequestsRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_ListOutgoingFollowRequestsServer = grpc.ServerStreamingServer[User]
+
+func _Lenic_GetFollowSuggestions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFollowSuggestionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetFollowSuggestions(m, &grpc.GenericServerStream[GetFollowSuggestionsRequest, FollowSuggestion]{ServerStream: stream})
 }
 
-func _Lenic_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetFollowSuggestionsServer = grpc.ServerStreamingServer[FollowSuggestion]
+
+func _Lenic_GetRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LenicServer).Login(ctx, in)
+		return srv.(LenicServer).GetRelationship(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Lenic_Login_FullMethodName,
+		FullMethod: Lenic_GetRelationship_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LenicServer).Login(ctx, req.(*LoginRequest))
+		return srv.(LenicServer).GetRelationship(ctx, req.(*GetRelationshipRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lenic_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(User)
+func _Lenic_GetRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LenicServer).CreateUser(ctx, in)
+		return srv.(LenicServer).GetRelationships(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Lenic_CreateUser_FullMethodName,
+		FullMethod: Lenic_GetRelationships_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LenicServer).CreateUser(ctx, req.(*User))
+		return srv.(LenicServer).GetRelationships(ctx, req.(*GetRelationshipsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lenic_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _Lenic_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LenicServer).GetUser(ctx, in)
+		return srv.(LenicServer).BlockUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Lenic_GetUser_FullMethodName,
+		FullMethod: Lenic_BlockUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LenicServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(LenicServer).BlockUser(ctx, req.(*BlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lenic_SearchUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SearchUsersRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(LenicServer).SearchUsers(m, &grpc.GenericServerStream[SearchUsersRequest, User]{ServerStream: stream})
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type Lenic_SearchUsersServer = grpc.ServerStreamingServer[User]
-
-func _Lenic_GetUserFollowers_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetUserFollowersRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(LenicServer).GetUserFollowers(m, &grpc.GenericServerStream[GetUserFollowersRequest, User]{ServerStream: stream})
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type Lenic_GetUserFollowersServer = grpc.ServerStreamingServer[User]
-
-func _Lenic_GetUserFollowing_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetUserFollowingRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(LenicServer).GetUserFollowing(m, &grpc.GenericServerStream[GetUserFollowingRequest, User]{ServerStream: stream})
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type Lenic_GetUserFollowingServer = grpc.ServerStreamingServer[User]
-
-func _Lenic_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowUserRequest)
+func _Lenic_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LenicServer).FollowUser(ctx, in)
+		return srv.(LenicServer).UnblockUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Lenic_FollowUser_FullMethodName,
+		FullMethod: Lenic_UnblockUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LenicServer).FollowUser(ctx, req.(*FollowUserRequest))
+		return srv.(LenicServer).UnblockUser(ctx, req.(*BlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lenic_AcceptFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AcceptFollowRequest)
+func _Lenic_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LenicServer).AcceptFollow(ctx, in)
+		return srv.(LenicServer).MuteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Lenic_AcceptFollow_FullMethodName,
+		FullMethod: Lenic_MuteUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LenicServer).AcceptFollow(ctx, req.(*AcceptFollowRequest))
+		return srv.(LenicServer).MuteUser(ctx, req.(*MuteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lenic_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnfollowRequest)
+func _Lenic_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LenicServer).UnfollowUser(ctx, in)
+		return srv.(LenicServer).UnmuteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Lenic_UnfollowUser_FullMethodName,
+		FullMethod: Lenic_UnmuteUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LenicServer).UnfollowUser(ctx, req.(*UnfollowRequest))
+		return srv.(LenicServer).UnmuteUser(ctx, req.(*MuteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -840,6 +1526,89 @@ func _Lenic_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lenic_RestoreAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).RestoreAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_RestoreAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).RestoreAccount(ctx, req.(*RestoreAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_SetAccountPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountPrivacyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).SetAccountPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_SetAccountPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).SetAccountPrivacy(ctx, req.(*SetAccountPrivacyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_AddCloseFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).AddCloseFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_AddCloseFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).AddCloseFriend(ctx, req.(*CloseFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_RemoveCloseFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).RemoveCloseFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_RemoveCloseFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).RemoveCloseFriend(ctx, req.(*CloseFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_GetCloseFriends_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCloseFriendsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetCloseFriends(m, &grpc.GenericServerStream[GetCloseFriendsRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetCloseFriendsServer = grpc.ServerStreamingServer[User]
+
 func _Lenic_StartConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Conversation)
 	if err := dec(in); err != nil {
@@ -952,6 +1721,35 @@ func _Lenic_GetPost_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lenic_GetPostRevisions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPostRevisionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetPostRevisions(m, &grpc.GenericServerStream[GetPostRevisionsRequest, PostRevision]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetPostRevisionsServer = grpc.ServerStreamingServer[PostRevision]
+
+func _Lenic_GetPostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).GetPostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_GetPostRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).GetPostRevision(ctx, req.(*GetPostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lenic_GetUserPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetUserPostsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -985,6 +1783,28 @@ func _Lenic_GetFeed_Handler(srv interface{}, stream grpc.ServerStream) error {
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Lenic_GetFeedServer = grpc.ServerStreamingServer[Post]
 
+func _Lenic_SearchPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchPostsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).SearchPosts(m, &grpc.GenericServerStream[SearchPostsRequest, Post]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_SearchPostsServer = grpc.ServerStreamingServer[Post]
+
+func _Lenic_GetPostRatings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPostRatingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetPostRatings(m, &grpc.GenericServerStream[GetPostRatingsRequest, PostVote]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetPostRatingsServer = grpc.ServerStreamingServer[PostVote]
+
 func _Lenic_RatePostUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PostRating)
 	if err := dec(in); err != nil {
@@ -1021,6 +1841,24 @@ func _Lenic_RatePostDown_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lenic_SetPostRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPostRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).SetPostRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_SetPostRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).SetPostRating(ctx, req.(*SetPostRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lenic_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Post)
 	if err := dec(in); err != nil {
@@ -1104,6 +1942,28 @@ func _Lenic_GetCommentsFromPost_Handler(srv interface{}, stream grpc.ServerStrea
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Lenic_GetCommentsFromPostServer = grpc.ServerStreamingServer[Comment]
 
+func _Lenic_SearchComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchCommentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).SearchComments(m, &grpc.GenericServerStream[SearchCommentsRequest, Comment]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_SearchCommentsServer = grpc.ServerStreamingServer[Comment]
+
+func _Lenic_GetCommentRevisions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCommentRevisionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetCommentRevisions(m, &grpc.GenericServerStream[GetCommentRevisionsRequest, CommentRevision]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetCommentRevisionsServer = grpc.ServerStreamingServer[CommentRevision]
+
 func _Lenic_RateCommentUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CommentRating)
 	if err := dec(in); err != nil {
@@ -1140,6 +2000,24 @@ func _Lenic_RateCommentDown_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lenic_SetCommentRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommentRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).SetCommentRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_SetCommentRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).SetCommentRating(ctx, req.(*SetCommentRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lenic_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Comment)
 	if err := dec(in); err != nil {
@@ -1207,6 +2085,38 @@ var Lenic_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnfollowUser",
 			Handler:    _Lenic_UnfollowUser_Handler,
 		},
+		{
+			MethodName: "RejectFollow",
+			Handler:    _Lenic_RejectFollow_Handler,
+		},
+		{
+			MethodName: "CancelFollowRequest",
+			Handler:    _Lenic_CancelFollowRequest_Handler,
+		},
+		{
+			MethodName: "GetRelationship",
+			Handler:    _Lenic_GetRelationship_Handler,
+		},
+		{
+			MethodName: "GetRelationships",
+			Handler:    _Lenic_GetRelationships_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _Lenic_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _Lenic_UnblockUser_Handler,
+		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _Lenic_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _Lenic_UnmuteUser_Handler,
+		},
 		{
 			MethodName: "UpdateUserPass",
 			Handler:    _Lenic_UpdateUserPass_Handler,
@@ -1215,6 +2125,22 @@ var Lenic_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _Lenic_DeleteUser_Handler,
 		},
+		{
+			MethodName: "RestoreAccount",
+			Handler:    _Lenic_RestoreAccount_Handler,
+		},
+		{
+			MethodName: "SetAccountPrivacy",
+			Handler:    _Lenic_SetAccountPrivacy_Handler,
+		},
+		{
+			MethodName: "AddCloseFriend",
+			Handler:    _Lenic_AddCloseFriend_Handler,
+		},
+		{
+			MethodName: "RemoveCloseFriend",
+			Handler:    _Lenic_RemoveCloseFriend_Handler,
+		},
 		{
 			MethodName: "StartConversation",
 			Handler:    _Lenic_StartConversation_Handler,
@@ -1235,6 +2161,10 @@ var Lenic_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPost",
 			Handler:    _Lenic_GetPost_Handler,
 		},
+		{
+			MethodName: "GetPostRevision",
+			Handler:    _Lenic_GetPostRevision_Handler,
+		},
 		{
 			MethodName: "RatePostUp",
 			Handler:    _Lenic_RatePostUp_Handler,
@@ -1243,6 +2173,10 @@ var Lenic_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RatePostDown",
 			Handler:    _Lenic_RatePostDown_Handler,
 		},
+		{
+			MethodName: "SetPostRating",
+			Handler:    _Lenic_SetPostRating_Handler,
+		},
 		{
 			MethodName: "UpdatePost",
 			Handler:    _Lenic_UpdatePost_Handler,
@@ -1267,6 +2201,10 @@ var Lenic_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RateCommentDown",
 			Handler:    _Lenic_RateCommentDown_Handler,
 		},
+		{
+			MethodName: "SetCommentRating",
+			Handler:    _Lenic_SetCommentRating_Handler,
+		},
 		{
 			MethodName: "UpdateComment",
 			Handler:    _Lenic_UpdateComment_Handler,
@@ -1292,6 +2230,26 @@ var Lenic_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Lenic_GetUserFollowing_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListIncomingFollowRequests",
+			Handler:       _Lenic_ListIncomingFollowRequests_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListOutgoingFollowRequests",
+			Handler:       _Lenic_ListOutgoingFollowRequests_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetFollowSuggestions",
+			Handler:       _Lenic_GetFollowSuggestions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetCloseFriends",
+			Handler:       _Lenic_GetCloseFriends_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetUserConversations",
 			Handler:       _Lenic_GetUserConversations_Handler,
@@ -1302,6 +2260,11 @@ var Lenic_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Lenic_GetConversationDMs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetPostRevisions",
+			Handler:       _Lenic_GetPostRevisions_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetUserPosts",
 			Handler:       _Lenic_GetUserPosts_Handler,
@@ -1317,11 +2280,31 @@ var Lenic_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Lenic_GetFeed_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SearchPosts",
+			Handler:       _Lenic_SearchPosts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetPostRatings",
+			Handler:       _Lenic_GetPostRatings_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetCommentsFromPost",
 			Handler:       _Lenic_GetCommentsFromPost_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SearchComments",
+			Handler:       _Lenic_SearchComments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetCommentRevisions",
+			Handler:       _Lenic_GetCommentRevisions_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "lenic.proto",
 }
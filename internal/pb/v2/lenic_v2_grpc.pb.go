@@ -0,0 +1,2359 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.30.0--dev
+// source: lenic_v2.proto
+
+// lenic.v2 is lenic with Timestamp times, in UTC, and 64-bit ids. The v1
+// service is still served for older clients, see internal/bridge. Calls
+// fail with a status carrying a google.rpc.ErrorInfo, and a
+// google.rpc.BadRequest when fields of the request are invalid
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Lenic_Login_FullMethodName                      = "/lenic.v2.Lenic/Login"
+	Lenic_CreateUser_FullMethodName                 = "/lenic.v2.Lenic/CreateUser"
+	Lenic_GetUser_FullMethodName                    = "/lenic.v2.Lenic/GetUser"
+	Lenic_SearchUsers_FullMethodName                = "/lenic.v2.Lenic/SearchUsers"
+	Lenic_GetUserFollowers_FullMethodName           = "/lenic.v2.Lenic/GetUserFollowers"
+	Lenic_GetUserFollowing_FullMethodName           = "/lenic.v2.Lenic/GetUserFollowing"
+	Lenic_FollowUser_FullMethodName                 = "/lenic.v2.Lenic/FollowUser"
+	Lenic_AcceptFollow_FullMethodName               = "/lenic.v2.Lenic/AcceptFollow"
+	Lenic_UnfollowUser_FullMethodName               = "/lenic.v2.Lenic/UnfollowUser"
+	Lenic_RejectFollow_FullMethodName               = "/lenic.v2.Lenic/RejectFollow"
+	Lenic_CancelFollowRequest_FullMethodName        = "/lenic.v2.Lenic/CancelFollowRequest"
+	Lenic_ListIncomingFollowRequests_FullMethodName = "/lenic.v2.Lenic/ListIncomingFollowRequests"
+	Lenic_ListOutgoingFollowRequests_FullMethodName = "/lenic.v2.Lenic/ListOutgoingFollowRequests"
+	Lenic_GetFollowSuggestions_FullMethodName       = "/lenic.v2.Lenic/GetFollowSuggestions"
+	Lenic_GetRelationship_FullMethodName            = "/lenic.v2.Lenic/GetRelationship"
+	Lenic_GetRelationships_FullMethodName           = "/lenic.v2.Lenic/GetRelationships"
+	Lenic_BlockUser_FullMethodName                  = "/lenic.v2.Lenic/BlockUser"
+	Lenic_UnblockUser_FullMethodName                = "/lenic.v2.Lenic/UnblockUser"
+	Lenic_MuteUser_FullMethodName                   = "/lenic.v2.Lenic/MuteUser"
+	Lenic_UnmuteUser_FullMethodName                 = "/lenic.v2.Lenic/UnmuteUser"
+	Lenic_UpdateUserPass_FullMethodName             = "/lenic.v2.Lenic/UpdateUserPass"
+	Lenic_UpdateUser_FullMethodName                 = "/lenic.v2.Lenic/UpdateUser"
+	Lenic_DeleteUser_FullMethodName                 = "/lenic.v2.Lenic/DeleteUser"
+	Lenic_RestoreAccount_FullMethodName             = "/lenic.v2.Lenic/RestoreAccount"
+	Lenic_SetAccountPrivacy_FullMethodName          = "/lenic.v2.Lenic/SetAccountPrivacy"
+	Lenic_AddCloseFriend_FullMethodName             = "/lenic.v2.Lenic/AddCloseFriend"
+	Lenic_RemoveCloseFriend_FullMethodName          = "/lenic.v2.Lenic/RemoveCloseFriend"
+	Lenic_GetCloseFriends_FullMethodName            = "/lenic.v2.Lenic/GetCloseFriends"
+	Lenic_StartConversation_FullMethodName          = "/lenic.v2.Lenic/StartConversation"
+	Lenic_GetUserConversations_FullMethodName       = "/lenic.v2.Lenic/GetUserConversations"
+	Lenic_ReadConversation_FullMethodName           = "/lenic.v2.Lenic/ReadConversation"
+	Lenic_SendDM_FullMethodName                     = "/lenic.v2.Lenic/SendDM"
+	Lenic_GetConversationDMs_FullMethodName         = "/lenic.v2.Lenic/GetConversationDMs"
+	Lenic_CreatePost_FullMethodName                 = "/lenic.v2.Lenic/CreatePost"
+	Lenic_GetPost_FullMethodName                    = "/lenic.v2.Lenic/GetPost"
+	Lenic_GetPostRevisions_FullMethodName           = "/lenic.v2.Lenic/GetPostRevisions"
+	Lenic_GetPostRevision_FullMethodName            = "/lenic.v2.Lenic/GetPostRevision"
+	Lenic_GetUserPosts_FullMethodName               = "/lenic.v2.Lenic/GetUserPosts"
+	Lenic_GetUserPublicPosts_FullMethodName         = "/lenic.v2.Lenic/GetUserPublicPosts"
+	Lenic_GetFeed_FullMethodName                    = "/lenic.v2.Lenic/GetFeed"
+	Lenic_SearchPosts_FullMethodName                = "/lenic.v2.Lenic/SearchPosts"
+	Lenic_GetPostRatings_FullMethodName             = "/lenic.v2.Lenic/GetPostRatings"
+	Lenic_RatePostUp_FullMethodName                 = "/lenic.v2.Lenic/RatePostUp"
+	Lenic_RatePostDown_FullMethodName               = "/lenic.v2.Lenic/RatePostDown"
+	Lenic_SetPostRating_FullMethodName              = "/lenic.v2.Lenic/SetPostRating"
+	Lenic_UpdatePost_FullMethodName                 = "/lenic.v2.Lenic/UpdatePost"
+	Lenic_DeletePost_FullMethodName                 = "/lenic.v2.Lenic/DeletePost"
+	Lenic_CreateComment_FullMethodName              = "/lenic.v2.Lenic/CreateComment"
+	Lenic_GetComment_FullMethodName                 = "/lenic.v2.Lenic/GetComment"
+	Lenic_GetCommentsFromPost_FullMethodName        = "/lenic.v2.Lenic/GetCommentsFromPost"
+	Lenic_SearchComments_FullMethodName             = "/lenic.v2.Lenic/SearchComments"
+	Lenic_GetCommentRevisions_FullMethodName        = "/lenic.v2.Lenic/GetCommentRevisions"
+	Lenic_RateCommentUp_FullMethodName              = "/lenic.v2.Lenic/RateCommentUp"
+	Lenic_RateCommentDown_FullMethodName            = "/lenic.v2.Lenic/RateCommentDown"
+	Lenic_SetCommentRating_FullMethodName           = "/lenic.v2.Lenic/SetCommentRating"
+	Lenic_UpdateComment_FullMethodName              = "/lenic.v2.Lenic/UpdateComment"
+	Lenic_DeleteComment_FullMethodName              = "/lenic.v2.Lenic/DeleteComment"
+)
+
+// LenicClient is the client API for Lenic service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LenicClient interface {
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// CreateUser message User{2, 3, 4}
+	CreateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
+	// SearchUsers and the other list streams send one page, and the token
+	// of the next one in the next-page-token trailer when there is more
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
+	GetUserFollowers(ctx context.Context, in *GetUserFollowersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
+	GetUserFollowing(ctx context.Context, in *GetUserFollowingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
+	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error)
+	AcceptFollow(ctx context.Context, in *AcceptFollowRequest, opts ...grpc.CallOption) (*AcceptFollowResponse, error)
+	UnfollowUser(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error)
+	RejectFollow(ctx context.Context, in *RejectFollowRequest, opts ...grpc.CallOption) (*RejectFollowResponse, error)
+	CancelFollowRequest(ctx context.Context, in *CancelFollowRequestRequest, opts ...grpc.CallOption) (*CancelFollowRequestResponse, error)
+	ListIncomingFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
+	ListOutgoingFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
+	GetFollowSuggestions(ctx context.Context, in *GetFollowSuggestionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FollowSuggestion], error)
+	GetRelationship(ctx context.Context, in *GetRelationshipRequest, opts ...grpc.CallOption) (*Relationship, error)
+	GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error)
+	BlockUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	UnblockUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	MuteUser(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error)
+	UnmuteUser(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error)
+	// UpdateUserPass message User{2, 4}
+	UpdateUserPass(ctx context.Context, in *User, opts ...grpc.CallOption) (*UpdateUserPassResponse, error)
+	// UpdateUser writes the fields of the profile in the update mask, the
+	// user is picked by user.username
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
+	// DeleteUser deactivates the account, RestoreAccount brings it back
+	// within the grace period, after which it's deleted for good
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...grpc.CallOption) (*RestoreAccountResponse, error)
+	SetAccountPrivacy(ctx context.Context, in *SetAccountPrivacyRequest, opts ...grpc.CallOption) (*SetAccountPrivacyResponse, error)
+	AddCloseFriend(ctx context.Context, in *CloseFriendRequest, opts ...grpc.CallOption) (*CloseFriendResponse, error)
+	RemoveCloseFriend(ctx context.Context, in *CloseFriendRequest, opts ...grpc.CallOption) (*CloseFriendResponse, error)
+	GetCloseFriends(ctx context.Context, in *GetCloseFriendsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
+	// StartConversation message Conversation{2, 3}
+	StartConversation(ctx context.Context, in *Conversation, opts ...grpc.CallOption) (*StartConversationResponse, error)
+	GetUserConversations(ctx context.Context, in *GetUserConversationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Conversation], error)
+	ReadConversation(ctx context.Context, in *ReadConversationRequest, opts ...grpc.CallOption) (*ReadConversationResponse, error)
+	// SendDM message DM{2, 3, 4}
+	SendDM(ctx context.Context, in *DM, opts ...grpc.CallOption) (*SendDMResponse, error)
+	GetConversationDMs(ctx context.Context, in *GetConversationDMsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DM], error)
+	// CreatePost message Post{3, 4, 5, 11, 12}
+	CreatePost(ctx context.Context, in *Post, opts ...grpc.CallOption) (*CreatePostResponse, error)
+	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*Post, error)
+	GetPostRevisions(ctx context.Context, in *GetPostRevisionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PostRevision], error)
+	GetPostRevision(ctx context.Context, in *GetPostRevisionRequest, opts ...grpc.CallOption) (*PostRevision, error)
+	GetUserPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error)
+	GetUserPublicPosts(ctx context.Context, in *GetUserPublicPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error)
+	GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error)
+	// SearchPosts streams the posts the caller may see that match the query,
+	// best match first
+	SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error)
+	// GetPostRatings streams who voted on a post, leaving out private
+	// accounts the caller doesn't follow and users either side blocked
+	GetPostRatings(ctx context.Context, in *GetPostRatingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PostVote], error)
+	RatePostUp(ctx context.Context, in *PostRating, opts ...grpc.CallOption) (*RatePostUpResponse, error)
+	RatePostDown(ctx context.Context, in *PostRating, opts ...grpc.CallOption) (*RatePostDownResponse, error)
+	// SetPostRating sets the vote of the caller, repeating it changes
+	// nothing
+	SetPostRating(ctx context.Context, in *SetPostRatingRequest, opts ...grpc.CallOption) (*SetPostRatingResponse, error)
+	// UpdatePost message Post{2, 4, 5, 11, 12}, the post is picked by
+	// post_guid and only the fields in the update mask are written
+	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error)
+	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	// CreateComment message Comment{2, 3, 4}
+	CreateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*CreateCommentResponse, error)
+	GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	GetCommentsFromPost(ctx context.Context, in *GetCommentsFromPostRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Comment], error)
+	// SearchComments is SearchPosts for comments, a comment is only matched
+	// if the caller may see its post
+	SearchComments(ctx context.Context, in *SearchCommentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Comment], error)
+	// GetCommentRevisions is only open to the authors of the comment and
+	// of its post
+	GetCommentRevisions(ctx context.Context, in *GetCommentRevisionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommentRevision], error)
+	RateCommentUp(ctx context.Context, in *CommentRating, opts ...grpc.CallOption) (*RateCommentUpResponse, error)
+	RateCommentDown(ctx context.Context, in *CommentRating, opts ...grpc.CallOption) (*RateCommentDownResponse, error)
+	SetCommentRating(ctx context.Context, in *SetCommentRatingRequest, opts ...grpc.CallOption) (*SetCommentRatingResponse, error)
+	// UpdateComment message Comment{1, 4}, the comment is picked by id
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+}
+
+type lenicClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLenicClient(cc grpc.ClientConnInterface) LenicClient {
+	return &lenicClient{cc}
+}
+
+func (c *lenicClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, Lenic_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) CreateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, Lenic_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, Lenic_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[0], Lenic_SearchUsers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchUsersRequest, User]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_SearchUsersClient = grpc.ServerStreamingClient[User]
+
+func (c *lenicClient) GetUserFollowers(ctx context.Context, in *GetUserFollowersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[1], Lenic_GetUserFollowers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetUserFollowersRequest, User]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetUserFollowersClient = grpc.ServerStreamingClient[User]
+
+func (c *lenicClient) GetUserFollowing(ctx context.Context, in *GetUserFollowingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[2], Lenic_GetUserFollowing_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetUserFollowingRequest, User]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetUserFollowingClient = grpc.ServerStreamingClient[User]
+
+func (c *lenicClient) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowUserResponse)
+	err := c.cc.Invoke(ctx, Lenic_FollowUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) AcceptFollow(ctx context.Context, in *AcceptFollowRequest, opts ...grpc.CallOption) (*AcceptFollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptFollowResponse)
+	err := c.cc.Invoke(ctx, Lenic_AcceptFollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) UnfollowUser(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowUserResponse)
+	err := c.cc.Invoke(ctx, Lenic_UnfollowUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) RejectFollow(ctx context.Context, in *RejectFollowRequest, opts ...grpc.CallOption) (*RejectFollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectFollowResponse)
+	err := c.cc.Invoke(ctx, Lenic_RejectFollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) CancelFollowRequest(ctx context.Context, in *CancelFollowRequestRequest, opts ...grpc.CallOption) (*CancelFollowRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelFollowRequestResponse)
+	err := c.cc.Invoke(ctx, Lenic_CancelFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) ListIncomingFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[3], Lenic_ListIncomingFollowRequests_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListFollowRequestsRequest, User]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_ListIncomingFollowRequestsClient = grpc.ServerStreamingClient[User]
+
+func (c *lenicClient) ListOutgoingFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[4], Lenic_ListOutgoingFollowRequests_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListFollowRequestsRequest, User]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_ListOutgoingFollowRequestsClient = grpc.ServerStreamingClient[User]
+
+func (c *lenicClient) GetFollowSuggestions(ctx context.Context, in *GetFollowSuggestionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FollowSuggestion], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[5], Lenic_GetFollowSuggestions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetFollowSuggestionsRequest, FollowSuggestion]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetFollowSuggestionsClient = grpc.ServerStreamingClient[FollowSuggestion]
+
+func (c *lenicClient) GetRelationship(ctx context.Context, in *GetRelationshipRequest, opts ...grpc.CallOption) (*Relationship, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Relationship)
+	err := c.cc.Invoke(ctx, Lenic_GetRelationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelationshipsResponse)
+	err := c.cc.Invoke(ctx, Lenic_GetRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) BlockUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, Lenic_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) UnblockUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, Lenic_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) MuteUser(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteResponse)
+	err := c.cc.Invoke(ctx, Lenic_MuteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) UnmuteUser(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteResponse)
+	err := c.cc.Invoke(ctx, Lenic_UnmuteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) UpdateUserPass(ctx context.Context, in *User, opts ...grpc.CallOption) (*UpdateUserPassResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserPassResponse)
+	err := c.cc.Invoke(ctx, Lenic_UpdateUserPass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, Lenic_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, Lenic_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...grpc.CallOption) (*RestoreAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreAccountResponse)
+	err := c.cc.Invoke(ctx, Lenic_RestoreAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) SetAccountPrivacy(ctx context.Context, in *SetAccountPrivacyRequest, opts ...grpc.CallOption) (*SetAccountPrivacyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAccountPrivacyResponse)
+	err := c.cc.Invoke(ctx, Lenic_SetAccountPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) AddCloseFriend(ctx context.Context, in *CloseFriendRequest, opts ...grpc.CallOption) (*CloseFriendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseFriendResponse)
+	err := c.cc.Invoke(ctx, Lenic_AddCloseFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) RemoveCloseFriend(ctx context.Context, in *CloseFriendRequest, opts ...grpc.CallOption) (*CloseFriendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseFriendResponse)
+	err := c.cc.Invoke(ctx, Lenic_RemoveCloseFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) GetCloseFriends(ctx context.Context, in *GetCloseFriendsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[6], Lenic_GetCloseFriends_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetCloseFriendsRequest, User]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetCloseFriendsClient = grpc.ServerStreamingClient[User]
+
+func (c *lenicClient) StartConversation(ctx context.Context, in *Conversation, opts ...grpc.CallOption) (*StartConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartConversationResponse)
+	err := c.cc.Invoke(ctx, Lenic_StartConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) GetUserConversations(ctx context.Context, in *GetUserConversationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Conversation], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[7], Lenic_GetUserConversations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetUserConversationsRequest, Conversation]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetUserConversationsClient = grpc.ServerStreamingClient[Conversation]
+
+func (c *lenicClient) ReadConversation(ctx context.Context, in *ReadConversationRequest, opts ...grpc.CallOption) (*ReadConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadConversationResponse)
+	err := c.cc.Invoke(ctx, Lenic_ReadConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) SendDM(ctx context.Context, in *DM, opts ...grpc.CallOption) (*SendDMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendDMResponse)
+	err := c.cc.Invoke(ctx, Lenic_SendDM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) GetConversationDMs(ctx context.Context, in *GetConversationDMsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DM], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[8], Lenic_GetConversationDMs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetConversationDMsRequest, DM]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetConversationDMsClient = grpc.ServerStreamingClient[DM]
+
+func (c *lenicClient) CreatePost(ctx context.Context, in *Post, opts ...grpc.CallOption) (*CreatePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePostResponse)
+	err := c.cc.Invoke(ctx, Lenic_CreatePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*Post, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Post)
+	err := c.cc.Invoke(ctx, Lenic_GetPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) GetPostRevisions(ctx context.Context, in *GetPostRevisionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PostRevision], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[9], Lenic_GetPostRevisions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetPostRevisionsRequest, PostRevision]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetPostRevisionsClient = grpc.ServerStreamingClient[PostRevision]
+
+func (c *lenicClient) GetPostRevision(ctx context.Context, in *GetPostRevisionRequest, opts ...grpc.CallOption) (*PostRevision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostRevision)
+	err := c.cc.Invoke(ctx, Lenic_GetPostRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) GetUserPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[10], Lenic_GetUserPosts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetUserPostsRequest, Post]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetUserPostsClient = grpc.ServerStreamingClient[Post]
+
+func (c *lenicClient) GetUserPublicPosts(ctx context.Context, in *GetUserPublicPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[11], Lenic_GetUserPublicPosts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetUserPublicPostsRequest, Post]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetUserPublicPostsClient = grpc.ServerStreamingClient[Post]
+
+func (c *lenicClient) GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[12], Lenic_GetFeed_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetFeedRequest, Post]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetFeedClient = grpc.ServerStreamingClient[Post]
+
+func (c *lenicClient) SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Post], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[13], Lenic_SearchPosts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchPostsRequest, Post]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_SearchPostsClient = grpc.ServerStreamingClient[Post]
+
+func (c *lenicClient) GetPostRatings(ctx context.Context, in *GetPostRatingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PostVote], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[14], Lenic_GetPostRatings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetPostRatingsRequest, PostVote]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetPostRatingsClient = grpc.ServerStreamingClient[PostVote]
+
+func (c *lenicClient) RatePostUp(ctx context.Context, in *PostRating, opts ...grpc.CallOption) (*RatePostUpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatePostUpResponse)
+	err := c.cc.Invoke(ctx, Lenic_RatePostUp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) RatePostDown(ctx context.Context, in *PostRating, opts ...grpc.CallOption) (*RatePostDownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatePostDownResponse)
+	err := c.cc.Invoke(ctx, Lenic_RatePostDown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) SetPostRating(ctx context.Context, in *SetPostRatingRequest, opts ...grpc.CallOption) (*SetPostRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPostRatingResponse)
+	err := c.cc.Invoke(ctx, Lenic_SetPostRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePostResponse)
+	err := c.cc.Invoke(ctx, Lenic_UpdatePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePostResponse)
+	err := c.cc.Invoke(ctx, Lenic_DeletePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) CreateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCommentResponse)
+	err := c.cc.Invoke(ctx, Lenic_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, Lenic_GetComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) GetCommentsFromPost(ctx context.Context, in *GetCommentsFromPostRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Comment], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[15], Lenic_GetCommentsFromPost_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetCommentsFromPostRequest, Comment]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetCommentsFromPostClient = grpc.ServerStreamingClient[Comment]
+
+func (c *lenicClient) SearchComments(ctx context.Context, in *SearchCommentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Comment], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[16], Lenic_SearchComments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchCommentsRequest, Comment]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_SearchCommentsClient = grpc.ServerStreamingClient[Comment]
+
+func (c *lenicClient) GetCommentRevisions(ctx context.Context, in *GetCommentRevisionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommentRevision], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Lenic_ServiceDesc.Streams[17], Lenic_GetCommentRevisions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetCommentRevisionsRequest, CommentRevision]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetCommentRevisionsClient = grpc.ServerStreamingClient[CommentRevision]
+
+func (c *lenicClient) RateCommentUp(ctx context.Context, in *CommentRating, opts ...grpc.CallOption) (*RateCommentUpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RateCommentUpResponse)
+	err := c.cc.Invoke(ctx, Lenic_RateCommentUp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) RateCommentDown(ctx context.Context, in *CommentRating, opts ...grpc.CallOption) (*RateCommentDownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RateCommentDownResponse)
+	err := c.cc.Invoke(ctx, Lenic_RateCommentDown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) SetCommentRating(ctx context.Context, in *SetCommentRatingRequest, opts ...grpc.CallOption) (*SetCommentRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCommentRatingResponse)
+	err := c.cc.Invoke(ctx, Lenic_SetCommentRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCommentResponse)
+	err := c.cc.Invoke(ctx, Lenic_UpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lenicClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, Lenic_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LenicServer is the server API for Lenic service.
+// All implementations must embed UnimplementedLenicServer
+// for forward compatibility.
+type LenicServer interface {
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// CreateUser message User{2, 3, 4}
+	CreateUser(context.Context, *User) (*CreateUserResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*User, error)
+	// SearchUsers and the other list streams send one page, and the token
+	// of the next one in the next-page-token trailer when there is more
+	SearchUsers(*SearchUsersRequest, grpc.ServerStreamingServer[User]) error
+	GetUserFollowers(*GetUserFollowersRequest, grpc.ServerStreamingServer[User]) error
+	GetUserFollowing(*GetUserFollowingRequest, grpc.ServerStreamingServer[User]) error
+	FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error)
+	AcceptFollow(context.Context, *AcceptFollowRequest) (*AcceptFollowResponse, error)
+	UnfollowUser(context.Context, *UnfollowRequest) (*UnfollowUserResponse, error)
+	RejectFollow(context.Context, *RejectFollowRequest) (*RejectFollowResponse, error)
+	CancelFollowRequest(context.Context, *CancelFollowRequestRequest) (*CancelFollowRequestResponse, error)
+	ListIncomingFollowRequests(*ListFollowRequestsRequest, grpc.ServerStreamingServer[User]) error
+	ListOutgoingFollowRequests(*ListFollowRequestsRequest, grpc.ServerStreamingServer[User]) error
+	GetFollowSuggestions(*GetFollowSuggestionsRequest, grpc.ServerStreamingServer[FollowSuggestion]) error
+	GetRelationship(context.Context, *GetRelationshipRequest) (*Relationship, error)
+	GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error)
+	BlockUser(context.Context, *BlockRequest) (*BlockResponse, error)
+	UnblockUser(context.Context, *BlockRequest) (*BlockResponse, error)
+	MuteUser(context.Context, *MuteRequest) (*MuteResponse, error)
+	UnmuteUser(context.Context, *MuteRequest) (*MuteResponse, error)
+	// UpdateUserPass message User{2, 4}
+	UpdateUserPass(context.Context, *User) (*UpdateUserPassResponse, error)
+	// UpdateUser writes the fields of the profile in the update mask, the
+	// user is picked by user.username
+	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
+	// DeleteUser deactivates the account, RestoreAccount brings it back
+	// within the grace period, after which it's deleted for good
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	RestoreAccount(context.Context, *RestoreAccountRequest) (*RestoreAccountResponse, error)
+	SetAccountPrivacy(context.Context, *SetAccountPrivacyRequest) (*SetAccountPrivacyResponse, error)
+	AddCloseFriend(context.Context, *CloseFriendRequest) (*CloseFriendResponse, error)
+	RemoveCloseFriend(context.Context, *CloseFriendRequest) (*CloseFriendResponse, error)
+	GetCloseFriends(*GetCloseFriendsRequest, grpc.ServerStreamingServer[User]) error
+	// StartConversation message Conversation{2, 3}
+	StartConversation(context.Context, *Conversation) (*StartConversationResponse, error)
+	GetUserConversations(*GetUserConversationsRequest, grpc.ServerStreamingServer[Conversation]) error
+	ReadConversation(context.Context, *ReadConversationRequest) (*ReadConversationResponse, error)
+	// SendDM message DM{2, 3, 4}
+	SendDM(context.Context, *DM) (*SendDMResponse, error)
+	GetConversationDMs(*GetConversationDMsRequest, grpc.ServerStreamingServer[DM]) error
+	// CreatePost message Post{3, 4, 5, 11, 12}
+	CreatePost(context.Context, *Post) (*CreatePostResponse, error)
+	GetPost(context.Context, *GetPostRequest) (*Post, error)
+	GetPostRevisions(*GetPostRevisionsRequest, grpc.ServerStreamingServer[PostRevision]) error
+	GetPostRevision(context.Context, *GetPostRevisionRequest) (*PostRevision, error)
+	GetUserPosts(*GetUserPostsRequest, grpc.ServerStreamingServer[Post]) error
+	GetUserPublicPosts(*GetUserPublicPostsRequest, grpc.ServerStreamingServer[Post]) error
+	GetFeed(*GetFeedRequest, grpc.ServerStreamingServer[Post]) error
+	// SearchPosts streams the posts the caller may see that match the query,
+	// best match first
+	SearchPosts(*SearchPostsRequest, grpc.ServerStreamingServer[Post]) error
+	// GetPostRatings streams who voted on a post, leaving out private
+	// accounts the caller doesn't follow and users either side blocked
+	GetPostRatings(*GetPostRatingsRequest, grpc.ServerStreamingServer[PostVote]) error
+	RatePostUp(context.Context, *PostRating) (*RatePostUpResponse, error)
+	RatePostDown(context.Context, *PostRating) (*RatePostDownResponse, error)
+	// SetPostRating sets the vote of the caller, repeating it changes
+	// nothing
+	SetPostRating(context.Context, *SetPostRatingRequest) (*SetPostRatingResponse, error)
+	// UpdatePost message Post{2, 4, 5, 11, 12}, the post is picked by
+	// post_guid and only the fields in the update mask are written
+	UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
+	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	// CreateComment message Comment{2, 3, 4}
+	CreateComment(context.Context, *Comment) (*CreateCommentResponse, error)
+	GetComment(context.Context, *GetCommentRequest) (*Comment, error)
+	GetCommentsFromPost(*GetCommentsFromPostRequest, grpc.ServerStreamingServer[Comment]) error
+	// SearchComments is SearchPosts for comments, a comment is only matched
+	// if the caller may see its post
+	SearchComments(*SearchCommentsRequest, grpc.ServerStreamingServer[Comment]) error
+	// GetCommentRevisions is only open to the authors of the comment and
+	// of its post
+	GetCommentRevisions(*GetCommentRevisionsRequest, grpc.ServerStreamingServer[CommentRevision]) error
+	RateCommentUp(context.Context, *CommentRating) (*RateCommentUpResponse, error)
+	RateCommentDown(context.Context, *CommentRating) (*RateCommentDownResponse, error)
+	SetCommentRating(context.Context, *SetCommentRatingRequest) (*SetCommentRatingResponse, error)
+	// UpdateComment message Comment{1, 4}, the comment is picked by id
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	mustEmbedUnimplementedLenicServer()
+}
+
+// UnimplementedLenicServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLenicServer struct{}
+
+func (UnimplementedLenicServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedLenicServer) CreateUser(context.Context, *User) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedLenicServer) GetUser(context.Context, *GetUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedLenicServer) SearchUsers(*SearchUsersRequest, grpc.ServerStreamingServer[User]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedLenicServer) GetUserFollowers(*GetUserFollowersRequest, grpc.ServerStreamingServer[User]) error {
+	return status.Errorf(codes.Unimplemented, "method GetUserFollowers not implemented")
+}
+func (UnimplementedLenicServer) GetUserFollowing(*GetUserFollowingRequest, grpc.ServerStreamingServer[User]) error {
+	return status.Errorf(codes.Unimplemented, "method GetUserFollowing not implemented")
+}
+func (UnimplementedLenicServer) FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (UnimplementedLenicServer) AcceptFollow(context.Context, *AcceptFollowRequest) (*AcceptFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFollow not implemented")
+}
+func (UnimplementedLenicServer) UnfollowUser(context.Context, *UnfollowRequest) (*UnfollowUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (UnimplementedLenicServer) RejectFollow(context.Context, *RejectFollowRequest) (*RejectFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollow not implemented")
+}
+func (UnimplementedLenicServer) CancelFollowRequest(context.Context, *CancelFollowRequestRequest) (*CancelFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFollowRequest not implemented")
+}
+func (UnimplementedLenicServer) ListIncomingFollowRequests(*ListFollowRequestsRequest, grpc.ServerStreamingServer[User]) error {
+	return status.Errorf(codes.Unimplemented, "method ListIncomingFollowRequests not implemented")
+}
+func (UnimplementedLenicServer) ListOutgoingFollowRequests(*ListFollowRequestsRequest, grpc.ServerStreamingServer[User]) error {
+	return status.Errorf(codes.Unimplemented, "method ListOutgoingFollowRequests not implemented")
+}
+func (UnimplementedLenicServer) GetFollowSuggestions(*GetFollowSuggestionsRequest, grpc.ServerStreamingServer[FollowSuggestion]) error {
+	return status.Errorf(codes.Unimplemented, "method GetFollowSuggestions not implemented")
+}
+func (UnimplementedLenicServer) GetRelationship(context.Context, *GetRelationshipRequest) (*Relationship, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationship not implemented")
+}
+func (UnimplementedLenicServer) GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationships not implemented")
+}
+func (UnimplementedLenicServer) BlockUser(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedLenicServer) UnblockUser(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedLenicServer) MuteUser(context.Context, *MuteRequest) (*MuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (UnimplementedLenicServer) UnmuteUser(context.Context, *MuteRequest) (*MuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
+}
+func (UnimplementedLenicServer) UpdateUserPass(context.Context, *User) (*UpdateUserPassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPass not implemented")
+}
+func (UnimplementedLenicServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedLenicServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedLenicServer) RestoreAccount(context.Context, *RestoreAccountRequest) (*RestoreAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAccount not implemented")
+}
+func (UnimplementedLenicServer) SetAccountPrivacy(context.Context, *SetAccountPrivacyRequest) (*SetAccountPrivacyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountPrivacy not implemented")
+}
+func (UnimplementedLenicServer) AddCloseFriend(context.Context, *CloseFriendRequest) (*CloseFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCloseFriend not implemented")
+}
+func (UnimplementedLenicServer) RemoveCloseFriend(context.Context, *CloseFriendRequest) (*CloseFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCloseFriend not implemented")
+}
+func (UnimplementedLenicServer) GetCloseFriends(*GetCloseFriendsRequest, grpc.ServerStreamingServer[User]) error {
+	return status.Errorf(codes.Unimplemented, "method GetCloseFriends not implemented")
+}
+func (UnimplementedLenicServer) StartConversation(context.Context, *Conversation) (*StartConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartConversation not implemented")
+}
+func (UnimplementedLenicServer) GetUserConversations(*GetUserConversationsRequest, grpc.ServerStreamingServer[Conversation]) error {
+	return status.Errorf(codes.Unimplemented, "method GetUserConversations not implemented")
+}
+func (UnimplementedLenicServer) ReadConversation(context.Context, *ReadConversationRequest) (*ReadConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadConversation not implemented")
+}
+func (UnimplementedLenicServer) SendDM(context.Context, *DM) (*SendDMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDM not implemented")
+}
+func (UnimplementedLenicServer) GetConversationDMs(*GetConversationDMsRequest, grpc.ServerStreamingServer[DM]) error {
+	return status.Errorf(codes.Unimplemented, "method GetConversationDMs not implemented")
+}
+func (UnimplementedLenicServer) CreatePost(context.Context, *Post) (*CreatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+}
+func (UnimplementedLenicServer) GetPost(context.Context, *GetPostRequest) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+}
+func (UnimplementedLenicServer) GetPostRevisions(*GetPostRevisionsRequest, grpc.ServerStreamingServer[PostRevision]) error {
+	return status.Errorf(codes.Unimplemented, "method GetPostRevisions not implemented")
+}
+func (UnimplementedLenicServer) GetPostRevision(context.Context, *GetPostRevisionRequest) (*PostRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostRevision not implemented")
+}
+func (UnimplementedLenicServer) GetUserPosts(*GetUserPostsRequest, grpc.ServerStreamingServer[Post]) error {
+	return status.Errorf(codes.Unimplemented, "method GetUserPosts not implemented")
+}
+func (UnimplementedLenicServer) GetUserPublicPosts(*GetUserPublicPostsRequest, grpc.ServerStreamingServer[Post]) error {
+	return status.Errorf(codes.Unimplemented, "method GetUserPublicPosts not implemented")
+}
+func (UnimplementedLenicServer) GetFeed(*GetFeedRequest, grpc.ServerStreamingServer[Post]) error {
+	return status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
+}
+func (UnimplementedLenicServer) SearchPosts(*SearchPostsRequest, grpc.ServerStreamingServer[Post]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedLenicServer) GetPostRatings(*GetPostRatingsRequest, grpc.ServerStreamingServer[PostVote]) error {
+	return status.Errorf(codes.Unimplemented, "method GetPostRatings not implemented")
+}
+func (UnimplementedLenicServer) RatePostUp(context.Context, *PostRating) (*RatePostUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RatePostUp not implemented")
+}
+func (UnimplementedLenicServer) RatePostDown(context.Context, *PostRating) (*RatePostDownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RatePostDown not implemented")
+}
+func (UnimplementedLenicServer) SetPostRating(context.Context, *SetPostRatingRequest) (*SetPostRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPostRating not implemented")
+}
+func (UnimplementedLenicServer) UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (UnimplementedLenicServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedLenicServer) CreateComment(context.Context, *Comment) (*CreateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedLenicServer) GetComment(context.Context, *GetCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComment not implemented")
+}
+func (UnimplementedLenicServer) GetCommentsFromPost(*GetCommentsFromPostRequest, grpc.ServerStreamingServer[Comment]) error {
+	return status.Errorf(codes.Unimplemented, "method GetCommentsFromPost not implemented")
+}
+func (UnimplementedLenicServer) SearchComments(*SearchCommentsRequest, grpc.ServerStreamingServer[Comment]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchComments not implemented")
+}
+func (UnimplementedLenicServer) GetCommentRevisions(*GetCommentRevisionsRequest, grpc.ServerStreamingServer[CommentRevision]) error {
+	return status.Errorf(codes.Unimplemented, "method GetCommentRevisions not implemented")
+}
+func (UnimplementedLenicServer) RateCommentUp(context.Context, *CommentRating) (*RateCommentUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateCommentUp not implemented")
+}
+func (UnimplementedLenicServer) RateCommentDown(context.Context, *CommentRating) (*RateCommentDownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateCommentDown not implemented")
+}
+func (UnimplementedLenicServer) SetCommentRating(context.Context, *SetCommentRatingRequest) (*SetCommentRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommentRating not implemented")
+}
+func (UnimplementedLenicServer) UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedLenicServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedLenicServer) mustEmbedUnimplementedLenicServer() {}
+func (UnimplementedLenicServer) testEmbeddedByValue()               {}
+
+// UnsafeLenicServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LenicServer will
+// result in compilation errors.
+type UnsafeLenicServer interface {
+	mustEmbedUnimplementedLenicServer()
+}
+
+func RegisterLenicServer(s grpc.ServiceRegistrar, srv LenicServer) {
+	// If the following call pancis, it indicates UnimplementedLenicServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Lenic_ServiceDesc, srv)
+}
+
+func _Lenic_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).CreateUser(ctx, req.(*User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_SearchUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchUsersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).SearchUsers(m, &grpc.GenericServerStream[SearchUsersRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_SearchUsersServer = grpc.ServerStreamingServer[User]
+
+func _Lenic_GetUserFollowers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetUserFollowersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetUserFollowers(m, &grpc.GenericServerStream[GetUserFollowersRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetUserFollowersServer = grpc.ServerStreamingServer[User]
+
+func _Lenic_GetUserFollowing_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetUserFollowingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetUserFollowing(m, &grpc.GenericServerStream[GetUserFollowingRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetUserFollowingServer = grpc.ServerStreamingServer[User]
+
+func _Lenic_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_FollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_AcceptFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).AcceptFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_AcceptFollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).AcceptFollow(ctx, req.(*AcceptFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_UnfollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).UnfollowUser(ctx, req.(*UnfollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_RejectFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).RejectFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_RejectFollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).RejectFollow(ctx, req.(*RejectFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_CancelFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).CancelFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_CancelFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).CancelFollowRequest(ctx, req.(*CancelFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_ListIncomingFollowRequests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFollowRequestsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).ListIncomingFollowRequests(m, &grpc.GenericServerStream[ListFollowRequestsRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_ListIncomingFollowRequestsServer = grpc.ServerStreamingServer[User]
+
+func _Lenic_ListOutgoingFollowRequests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFollowRequestsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).ListOutgoingFollowRequests(m, &grpc.GenericServerStream[ListFollowRequestsRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_ListOutgoingFollowRequestsServer = grpc.ServerStreamingServer[User]
+
+func _Lenic_GetFollowSuggestions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFollowSuggestionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetFollowSuggestions(m, &grpc.GenericServerStream[GetFollowSuggestionsRequest, FollowSuggestion]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetFollowSuggestionsServer = grpc.ServerStreamingServer[FollowSuggestion]
+
+func _Lenic_GetRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).GetRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_GetRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).GetRelationship(ctx, req.(*GetRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_GetRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).GetRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_GetRelationships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).GetRelationships(ctx, req.(*GetRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).BlockUser(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).UnblockUser(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).MuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_MuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).MuteUser(ctx, req.(*MuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).UnmuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_UnmuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).UnmuteUser(ctx, req.(*MuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_UpdateUserPass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).UpdateUserPass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_UpdateUserPass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).UpdateUserPass(ctx, req.(*User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_RestoreAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).RestoreAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_RestoreAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).RestoreAccount(ctx, req.(*RestoreAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_SetAccountPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountPrivacyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).SetAccountPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_SetAccountPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).SetAccountPrivacy(ctx, req.(*SetAccountPrivacyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_AddCloseFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).AddCloseFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_AddCloseFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).AddCloseFriend(ctx, req.(*CloseFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_RemoveCloseFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).RemoveCloseFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_RemoveCloseFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).RemoveCloseFriend(ctx, req.(*CloseFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_GetCloseFriends_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCloseFriendsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetCloseFriends(m, &grpc.GenericServerStream[GetCloseFriendsRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetCloseFriendsServer = grpc.ServerStreamingServer[User]
+
+func _Lenic_StartConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Conversation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).StartConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_StartConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).StartConversation(ctx, req.(*Conversation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_GetUserConversations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetUserConversationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetUserConversations(m, &grpc.GenericServerStream[GetUserConversationsRequest, Conversation]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetUserConversationsServer = grpc.ServerStreamingServer[Conversation]
+
+func _Lenic_ReadConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).ReadConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_ReadConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).ReadConversation(ctx, req.(*ReadConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_SendDM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DM)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).SendDM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_SendDM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).SendDM(ctx, req.(*DM))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_GetConversationDMs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetConversationDMsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetConversationDMs(m, &grpc.GenericServerStream[GetConversationDMsRequest, DM]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetConversationDMsServer = grpc.ServerStreamingServer[DM]
+
+func _Lenic_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Post)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).CreatePost(ctx, req.(*Post))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_GetPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).GetPost(ctx, req.(*GetPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_GetPostRevisions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPostRevisionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetPostRevisions(m, &grpc.GenericServerStream[GetPostRevisionsRequest, PostRevision]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetPostRevisionsServer = grpc.ServerStreamingServer[PostRevision]
+
+func _Lenic_GetPostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).GetPostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_GetPostRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).GetPostRevision(ctx, req.(*GetPostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_GetUserPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetUserPostsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetUserPosts(m, &grpc.GenericServerStream[GetUserPostsRequest, Post]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetUserPostsServer = grpc.ServerStreamingServer[Post]
+
+func _Lenic_GetUserPublicPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetUserPublicPostsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetUserPublicPosts(m, &grpc.GenericServerStream[GetUserPublicPostsRequest, Post]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetUserPublicPostsServer = grpc.ServerStreamingServer[Post]
+
+func _Lenic_GetFeed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFeedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetFeed(m, &grpc.GenericServerStream[GetFeedRequest, Post]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetFeedServer = grpc.ServerStreamingServer[Post]
+
+func _Lenic_SearchPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchPostsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).SearchPosts(m, &grpc.GenericServerStream[SearchPostsRequest, Post]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_SearchPostsServer = grpc.ServerStreamingServer[Post]
+
+func _Lenic_GetPostRatings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPostRatingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetPostRatings(m, &grpc.GenericServerStream[GetPostRatingsRequest, PostVote]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetPostRatingsServer = grpc.ServerStreamingServer[PostVote]
+
+func _Lenic_RatePostUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostRating)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).RatePostUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_RatePostUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).RatePostUp(ctx, req.(*PostRating))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_RatePostDown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostRating)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).RatePostDown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_RatePostDown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).RatePostDown(ctx, req.(*PostRating))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_SetPostRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPostRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).SetPostRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_SetPostRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).SetPostRating(ctx, req.(*SetPostRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_UpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).DeletePost(ctx, req.(*DeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Comment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).CreateComment(ctx, req.(*Comment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_GetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).GetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_GetComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).GetComment(ctx, req.(*GetCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_GetCommentsFromPost_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCommentsFromPostRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetCommentsFromPost(m, &grpc.GenericServerStream[GetCommentsFromPostRequest, Comment]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetCommentsFromPostServer = grpc.ServerStreamingServer[Comment]
+
+func _Lenic_SearchComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchCommentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).SearchComments(m, &grpc.GenericServerStream[SearchCommentsRequest, Comment]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_SearchCommentsServer = grpc.ServerStreamingServer[Comment]
+
+func _Lenic_GetCommentRevisions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCommentRevisionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LenicServer).GetCommentRevisions(m, &grpc.GenericServerStream[GetCommentRevisionsRequest, CommentRevision]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Lenic_GetCommentRevisionsServer = grpc.ServerStreamingServer[CommentRevision]
+
+func _Lenic_RateCommentUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentRating)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).RateCommentUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_RateCommentUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).RateCommentUp(ctx, req.(*CommentRating))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_RateCommentDown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentRating)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).RateCommentDown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_RateCommentDown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).RateCommentDown(ctx, req.(*CommentRating))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_SetCommentRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommentRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).SetCommentRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_SetCommentRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).SetCommentRating(ctx, req.(*SetCommentRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lenic_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LenicServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lenic_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LenicServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Lenic_ServiceDesc is the grpc.ServiceDesc for Lenic service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Lenic_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "lenic.v2.Lenic",
+	HandlerType: (*LenicServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _Lenic_Login_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _Lenic_CreateUser_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _Lenic_GetUser_Handler,
+		},
+		{
+			MethodName: "FollowUser",
+			Handler:    _Lenic_FollowUser_Handler,
+		},
+		{
+			MethodName: "AcceptFollow",
+			Handler:    _Lenic_AcceptFollow_Handler,
+		},
+		{
+			MethodName: "UnfollowUser",
+			Handler:    _Lenic_UnfollowUser_Handler,
+		},
+		{
+			MethodName: "RejectFollow",
+			Handler:    _Lenic_RejectFollow_Handler,
+		},
+		{
+			MethodName: "CancelFollowRequest",
+			Handler:    _Lenic_CancelFollowRequest_Handler,
+		},
+		{
+			MethodName: "GetRelationship",
+			Handler:    _Lenic_GetRelationship_Handler,
+		},
+		{
+			MethodName: "GetRelationships",
+			Handler:    _Lenic_GetRelationships_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _Lenic_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _Lenic_UnblockUser_Handler,
+		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _Lenic_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _Lenic_UnmuteUser_Handler,
+		},
+		{
+			MethodName: "UpdateUserPass",
+			Handler:    _Lenic_UpdateUserPass_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _Lenic_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _Lenic_DeleteUser_Handler,
+		},
+		{
+			MethodName: "RestoreAccount",
+			Handler:    _Lenic_RestoreAccount_Handler,
+		},
+		{
+			MethodName: "SetAccountPrivacy",
+			Handler:    _Lenic_SetAccountPrivacy_Handler,
+		},
+		{
+			MethodName: "AddCloseFriend",
+			Handler:    _Lenic_AddCloseFriend_Handler,
+		},
+		{
+			MethodName: "RemoveCloseFriend",
+			Handler:    _Lenic_RemoveCloseFriend_Handler,
+		},
+		{
+			MethodName: "StartConversation",
+			Handler:    _Lenic_StartConversation_Handler,
+		},
+		{
+			MethodName: "ReadConversation",
+			Handler:    _Lenic_ReadConversation_Handler,
+		},
+		{
+			MethodName: "SendDM",
+			Handler:    _Lenic_SendDM_Handler,
+		},
+		{
+			MethodName: "CreatePost",
+			Handler:    _Lenic_CreatePost_Handler,
+		},
+		{
+			MethodName: "GetPost",
+			Handler:    _Lenic_GetPost_Handler,
+		},
+		{
+			MethodName: "GetPostRevision",
+			Handler:    _Lenic_GetPostRevision_Handler,
+		},
+		{
+			MethodName: "RatePostUp",
+			Handler:    _Lenic_RatePostUp_Handler,
+		},
+		{
+			MethodName: "RatePostDown",
+			Handler:    _Lenic_RatePostDown_Handler,
+		},
+		{
+			MethodName: "SetPostRating",
+			Handler:    _Lenic_SetPostRating_Handler,
+		},
+		{
+			MethodName: "UpdatePost",
+			Handler:    _Lenic_UpdatePost_Handler,
+		},
+		{
+			MethodName: "DeletePost",
+			Handler:    _Lenic_DeletePost_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _Lenic_CreateComment_Handler,
+		},
+		{
+			MethodName: "GetComment",
+			Handler:    _Lenic_GetComment_Handler,
+		},
+		{
+			MethodName: "RateCommentUp",
+			Handler:    _Lenic_RateCommentUp_Handler,
+		},
+		{
+			MethodName: "RateCommentDown",
+			Handler:    _Lenic_RateCommentDown_Handler,
+		},
+		{
+			MethodName: "SetCommentRating",
+			Handler:    _Lenic_SetCommentRating_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _Lenic_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _Lenic_DeleteComment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SearchUsers",
+			Handler:       _Lenic_SearchUsers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetUserFollowers",
+			Handler:       _Lenic_GetUserFollowers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetUserFollowing",
+			Handler:       _Lenic_GetUserFollowing_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListIncomingFollowRequests",
+			Handler:       _Lenic_ListIncomingFollowRequests_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListOutgoingFollowRequests",
+			Handler:       _Lenic_ListOutgoingFollowRequests_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetFollowSuggestions",
+			Handler:       _Lenic_GetFollowSuggestions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetCloseFriends",
+			Handler:       _Lenic_GetCloseFriends_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetUserConversations",
+			Handler:       _Lenic_GetUserConversations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetConversationDMs",
+			Handler:       _Lenic_GetConversationDMs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetPostRevisions",
+			Handler:       _Lenic_GetPostRevisions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetUserPosts",
+			Handler:       _Lenic_GetUserPosts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetUserPublicPosts",
+			Handler:       _Lenic_GetUserPublicPosts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetFeed",
+			Handler:       _Lenic_GetFeed_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchPosts",
+			Handler:       _Lenic_SearchPosts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetPostRatings",
+			Handler:       _Lenic_GetPostRatings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetCommentsFromPost",
+			Handler:       _Lenic_GetCommentsFromPost_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchComments",
+			Handler:       _Lenic_SearchComments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetCommentRevisions",
+			Handler:       _Lenic_GetCommentRevisions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "lenic_v2.proto",
+}
@@ -0,0 +1,258 @@
+// Package search keeps an in-process inverted index of the text of active
+// posts and comments, ranked with BM25.
+//
+// Build loads the index from the repositories at startup and Wrap returns
+// repositories that update it after every committed create, update or
+// disable of a post or comment. The index knows nothing about who may see
+// what: callers load every hit and check it, which also skips rows hidden
+// or deleted since they were indexed.
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// BM25 parameters, the usual defaults
+const (
+	k1 = 1.2
+	b  = 0.75
+)
+
+// Index holds posts and comments in separate corpora, so each is ranked
+// against its own kind
+type Index struct {
+	// mu guards swapping the corpora, see Build
+	mu       sync.RWMutex
+	posts    *corpus
+	comments *corpus
+}
+
+func New() *Index {
+	return &Index{posts: newCorpus(), comments: newCorpus()}
+}
+
+func (i *Index) corpora() (*corpus, *corpus) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.posts, i.comments
+}
+
+// Query is what a search matches, every term and phrase must be found
+type Query struct {
+	Terms   []string
+	Phrases [][]string
+	// AuthorId limits hits to one author when not zero
+	AuthorId int
+	// Since and Until bound when the hits were created when not zero
+	Since time.Time
+	Until time.Time
+}
+
+// ParseQuery reads words and "quoted phrases" from s
+func ParseQuery(s string) Query {
+	q := Query{}
+	for i, part := range strings.Split(s, `"`) {
+		tokens := tokenize(part)
+		if i%2 == 1 && len(tokens) > 1 {
+			q.Phrases = append(q.Phrases, tokens)
+			continue
+		}
+		q.Terms = append(q.Terms, tokens...)
+	}
+	return q
+}
+
+// Empty reports whether q has nothing to match
+func (q Query) Empty() bool {
+	return len(q.Terms) == 0 && len(q.Phrases) == 0
+}
+
+// tokens are the words of q, phrases included
+func (q Query) tokens() []string {
+	tokens := append([]string{}, q.Terms...)
+	for _, phrase := range q.Phrases {
+		tokens = append(tokens, phrase...)
+	}
+	return tokens
+}
+
+func (i *Index) AddPost(id int, author_id int, createdAt time.Time, title string, content string) {
+	posts, _ := i.corpora()
+	posts.add(&doc{id: id, author: author_id, created: createdAt, tokens: tokenize(title + " " + content)})
+}
+
+func (i *Index) RemovePost(id int) {
+	posts, _ := i.corpora()
+	posts.remove(id)
+}
+
+func (i *Index) AddComment(id int, author_id int, createdAt time.Time, content string) {
+	_, comments := i.corpora()
+	comments.add(&doc{id: id, author: author_id, created: createdAt, tokens: tokenize(content)})
+}
+
+func (i *Index) RemoveComment(id int) {
+	_, comments := i.corpora()
+	comments.remove(id)
+}
+
+// SearchPosts returns the ids of the posts matching q, best first
+func (i *Index) SearchPosts(q Query) []int {
+	posts, _ := i.corpora()
+	return posts.search(q)
+}
+
+// SearchComments returns the ids of the comments matching q, best first
+func (i *Index) SearchComments(q Query) []int {
+	_, comments := i.corpora()
+	return comments.search(q)
+}
+
+type doc struct {
+	id      int
+	author  int
+	created time.Time
+	tokens  []string
+}
+
+type corpus struct {
+	mu   sync.RWMutex
+	docs map[int]*doc
+	// postings maps a token to the docs holding it and how many times
+	postings map[string]map[int]int
+	// length is the number of tokens of every doc
+	length int
+}
+
+func newCorpus() *corpus {
+	return &corpus{docs: map[int]*doc{}, postings: map[string]map[int]int{}}
+}
+
+func (c *corpus) add(d *doc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.drop(d.id)
+	c.docs[d.id] = d
+	c.length += len(d.tokens)
+	for _, t := range d.tokens {
+		if c.postings[t] == nil {
+			c.postings[t] = map[int]int{}
+		}
+		c.postings[t][d.id]++
+	}
+}
+
+func (c *corpus) remove(id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.drop(id)
+}
+
+// drop removes a doc, callers must hold the lock
+func (c *corpus) drop(id int) {
+	d, ok := c.docs[id]
+	if !ok {
+		return
+	}
+	delete(c.docs, id)
+	c.length -= len(d.tokens)
+	for _, t := range d.tokens {
+		delete(c.postings[t], id)
+		if len(c.postings[t]) == 0 {
+			delete(c.postings, t)
+		}
+	}
+}
+
+func (c *corpus) search(q Query) []int {
+	if q.Empty() {
+		return nil
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	tokens := q.tokens()
+	// candidates come from the rarest token, every other one must match
+	sort.Slice(tokens, func(i, j int) bool {
+		return len(c.postings[tokens[i]]) < len(c.postings[tokens[j]])
+	})
+	avgLength := float64(c.length) / math.Max(float64(len(c.docs)), 1)
+	scores := map[int]float64{}
+	for id := range c.postings[tokens[0]] {
+		d := c.docs[id]
+		if !q.accepts(d) {
+			continue
+		}
+		score, ok := 0.0, true
+		for _, t := range tokens {
+			tf := float64(c.postings[t][id])
+			if tf == 0 {
+				ok = false
+				break
+			}
+			df := float64(len(c.postings[t]))
+			idf := math.Log(1 + (float64(len(c.docs))-df+0.5)/(df+0.5))
+			score += idf * tf * (k1 + 1) / (tf + k1*(1-b+b*float64(len(d.tokens))/avgLength))
+		}
+		if ok {
+			scores[id] = score
+		}
+	}
+	ids := make([]int, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return c.docs[ids[i]].created.After(c.docs[ids[j]].created)
+	})
+	return ids
+}
+
+// accepts reports whether d passes the filters and phrases of q
+func (q Query) accepts(d *doc) bool {
+	if q.AuthorId != 0 && d.author != q.AuthorId {
+		return false
+	}
+	if !q.Since.IsZero() && d.created.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && d.created.After(q.Until) {
+		return false
+	}
+	for _, phrase := range q.Phrases {
+		if !containsPhrase(d.tokens, phrase) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsPhrase(tokens []string, phrase []string) bool {
+	for i := 0; i+len(phrase) <= len(tokens); i++ {
+		match := true
+		for j, t := range phrase {
+			if tokens[i+j] != t {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenize splits s into lower case words
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
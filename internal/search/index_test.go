@@ -0,0 +1,94 @@
+package search
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/memory"
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+)
+
+func TestSearchPosts(t *testing.T) {
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	i := New()
+	i.AddPost(1, 10, day, "gardening", "tomatoes grow in the sun")
+	i.AddPost(2, 10, day.Add(time.Hour), "tomatoes", "tomatoes, tomatoes and more tomatoes")
+	i.AddPost(3, 20, day.Add(2*time.Hour), "sun", "the sun on the tomatoes")
+	i.AddPost(4, 20, day.Add(3*time.Hour), "other", "nothing to see here")
+
+	for _, c := range []struct {
+		name string
+		q    Query
+		ids  []int
+	}{
+		{"most mentions first", ParseQuery("Tomatoes"), []int{2, 3, 1}},
+		{"every term", ParseQuery("tomatoes sun"), []int{3, 1}},
+		{"phrase", ParseQuery(`"the sun"`), []int{1, 3}},
+		{"phrase in order", ParseQuery(`"tomatoes the"`), []int{}},
+		{"author", Query{Terms: []string{"tomatoes"}, AuthorId: 20}, []int{3}},
+		{"since", Query{Terms: []string{"tomatoes"}, Since: day.Add(time.Hour)}, []int{2, 3}},
+		{"until", Query{Terms: []string{"tomatoes"}, Until: day.Add(time.Hour)}, []int{2, 1}},
+		{"unknown word", ParseQuery("potatoes"), []int{}},
+		{"empty", ParseQuery(` "" `), nil},
+	} {
+		got := i.SearchPosts(c.q)
+		if c.name == "phrase" {
+			// both hold the phrase once, only the set matters
+			slices.Sort(got)
+		}
+		if !slices.Equal(got, c.ids) {
+			t.Errorf("%s: found %v, want %v", c.name, got, c.ids)
+		}
+	}
+
+	i.RemovePost(2)
+	i.AddPost(3, 20, day, "sun", "the sun alone")
+	if got := i.SearchPosts(ParseQuery("tomatoes")); !slices.Equal(got, []int{1}) {
+		t.Errorf("after removing and rewriting found %v, want [1]", got)
+	}
+}
+
+func TestRestoreIndexesUser(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	ids := []int{}
+	for _, name := range []string{"ann", "bob"} {
+		id, err := store.CreateUser(ctx, &model.User{UserName: name, Email: name + "@example.com", HashPass: "x", Active: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	postId, err := store.CreatePost(ctx, &model.Post{GUID: "guid", AuthorId: ids[0], Title: "rhubarb", Active: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	commentId, err := store.CreateComment(ctx, &model.Comment{PostGUID: "guid", AuthorId: ids[1], Content: "rhubarb pie", Active: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.DeleteUser(ctx, "ann"); err != nil {
+		t.Fatal(err)
+	}
+
+	// built while ann is deleted, her post and the comments on it are left out
+	i := New()
+	if err := i.Build(ctx, store.Repositories()); err != nil {
+		t.Fatal(err)
+	}
+	if got := i.SearchPosts(ParseQuery("rhubarb")); len(got) != 0 {
+		t.Fatalf("index built with %v, want no posts", got)
+	}
+
+	if err := i.Wrap(store.Repositories()).Users.RestoreUser(ctx, "ann", time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := i.SearchPosts(ParseQuery("rhubarb")); !slices.Equal(got, []int{postId}) {
+		t.Errorf("restored posts found %v, want [%d]", got, postId)
+	}
+	if got := i.SearchComments(ParseQuery("rhubarb")); !slices.Equal(got, []int{commentId}) {
+		t.Errorf("restored comments found %v, want [%d]", got, commentId)
+	}
+}
@@ -0,0 +1,252 @@
+package search
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Anacardo89/lenic_api/internal/data/model"
+	"github.com/Anacardo89/lenic_api/internal/data/repo"
+	"github.com/Anacardo89/lenic_api/pkg/logger"
+)
+
+// Build indexes every active post and comment in r, replacing whatever
+// i held
+func (i *Index) Build(ctx context.Context, r repo.Repositories) error {
+	posts, comments := newCorpus(), newCorpus()
+	ps, err := r.Posts.GetPosts(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range *ps {
+		posts.add(postDoc(p))
+		cs, err := r.Comments.GetCommentsByPost(ctx, p.GUID, repo.Page{})
+		if err != nil {
+			return err
+		}
+		for _, c := range *cs {
+			comments.add(commentDoc(c))
+		}
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.posts, i.comments = posts, comments
+	return nil
+}
+
+func postDoc(p model.Post) *doc {
+	return &doc{id: p.Id, author: p.AuthorId, created: p.CreatedAt, tokens: tokenize(p.Title + " " + p.Content)}
+}
+
+func commentDoc(c model.Comment) *doc {
+	return &doc{id: c.Id, author: c.AuthorId, created: c.CreatedAt, tokens: tokenize(c.Content)}
+}
+
+// Wrap returns r with every committed write to the text or state of a post
+// or comment reflected in i
+func (i *Index) Wrap(r repo.Repositories) repo.Repositories {
+	return i.wrap(r, r, nil)
+}
+
+// wrap reads changed rows back through root once they're committed, tx is
+// set inside a transaction
+func (i *Index) wrap(r repo.Repositories, root repo.Repositories, tx *pending) repo.Repositories {
+	b := base{i: i, root: root, tx: tx}
+	w := r
+	w.Tx = unitOfWork{base: b, UnitOfWork: r.Tx}
+	w.Users = users{base: b, Users: r.Users}
+	w.Posts = posts{base: b, Posts: r.Posts}
+	w.Comments = comments{base: b, Comments: r.Comments}
+	return w
+}
+
+type base struct {
+	i    *Index
+	root repo.Repositories
+	tx   *pending
+}
+
+// after runs f once the write is committed, right away outside a
+// transaction
+func (b base) after(ctx context.Context, f func(ctx context.Context)) {
+	if b.tx != nil {
+		b.tx.add(f)
+		return
+	}
+	f(ctx)
+}
+
+// refreshPost indexes the post if it's active and drops it otherwise, a
+// failed read leaves the index as it was
+func (b base) refreshPost(p *model.Post, err error) {
+	if err != nil {
+		return
+	}
+	if p.Active != model.ContentActive {
+		b.i.RemovePost(p.Id)
+		return
+	}
+	posts, _ := b.i.corpora()
+	posts.add(postDoc(*p))
+}
+
+func (b base) refreshComment(ctx context.Context, id int) {
+	c, err := b.root.Comments.GetCommentById(ctx, id)
+	if err != nil {
+		return
+	}
+	if c.Active != model.ContentActive {
+		b.i.RemoveComment(c.Id)
+		return
+	}
+	_, comments := b.i.corpora()
+	comments.add(commentDoc(*c))
+}
+
+// pending holds the refreshes made in a transaction
+type pending struct {
+	mu        sync.Mutex
+	refreshes []func(ctx context.Context)
+}
+
+func (p *pending) add(f func(ctx context.Context)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.refreshes = append(p.refreshes, f)
+}
+
+func (p *pending) run(ctx context.Context) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, f := range p.refreshes {
+		f(ctx)
+	}
+}
+
+type unitOfWork struct {
+	base
+	repo.UnitOfWork
+}
+
+func (u unitOfWork) InTx(ctx context.Context, f func(r repo.Repositories) error) error {
+	if u.tx != nil {
+		return u.UnitOfWork.InTx(ctx, func(r repo.Repositories) error {
+			return f(u.i.wrap(r, u.root, u.tx))
+		})
+	}
+	tx := &pending{}
+	err := u.UnitOfWork.InTx(ctx, func(r repo.Repositories) error {
+		return f(u.i.wrap(r, u.root, tx))
+	})
+	if err == nil {
+		tx.run(ctx)
+	}
+	return err
+}
+
+type users struct {
+	base
+	repo.Users
+}
+
+// RestoreUser indexes the posts of the user and the comments they wrote or
+// that are on their posts, they're missing from the index if it was built
+// while the user was deleted
+func (u users) RestoreUser(ctx context.Context, user string, since time.Time) error {
+	err := u.Users.RestoreUser(ctx, user, since)
+	if err == nil {
+		u.after(ctx, func(ctx context.Context) {
+			if err := u.addUser(ctx, user); err != nil {
+				logger.Error.Println("could not index restored user: ", err)
+			}
+		})
+	}
+	return err
+}
+
+func (u users) addUser(ctx context.Context, user string) error {
+	usr, err := u.root.Users.GetUserByName(ctx, user)
+	if err != nil {
+		return err
+	}
+	ps, err := u.root.Posts.GetUserPosts(ctx, usr.Id, repo.Page{})
+	if err != nil {
+		return err
+	}
+	cs, err := u.root.Comments.GetUserThreadComments(ctx, usr.Id)
+	if err != nil {
+		return err
+	}
+	posts, comments := u.i.corpora()
+	for _, p := range *ps {
+		posts.add(postDoc(p))
+	}
+	for _, c := range *cs {
+		comments.add(commentDoc(c))
+	}
+	return nil
+}
+
+type posts struct {
+	base
+	repo.Posts
+}
+
+func (p posts) CreatePost(ctx context.Context, post *model.Post) (int, error) {
+	id, err := p.Posts.CreatePost(ctx, post)
+	if err == nil {
+		p.after(ctx, func(ctx context.Context) {
+			p.refreshPost(p.root.Posts.GetPostByID(ctx, id))
+		})
+	}
+	return id, err
+}
+
+func (p posts) UpdatePost(ctx context.Context, post model.Post) error {
+	err := p.Posts.UpdatePost(ctx, post)
+	if err == nil {
+		p.after(ctx, func(ctx context.Context) {
+			p.refreshPost(p.root.Posts.GetPostByGUID(ctx, post.GUID))
+		})
+	}
+	return err
+}
+
+func (p posts) DisablePost(ctx context.Context, guid string) error {
+	err := p.Posts.DisablePost(ctx, guid)
+	if err == nil {
+		p.after(ctx, func(ctx context.Context) {
+			p.refreshPost(p.root.Posts.GetPostByGUID(ctx, guid))
+		})
+	}
+	return err
+}
+
+type comments struct {
+	base
+	repo.Comments
+}
+
+func (cm comments) CreateComment(ctx context.Context, c *model.Comment) (int, error) {
+	id, err := cm.Comments.CreateComment(ctx, c)
+	if err == nil {
+		cm.after(ctx, func(ctx context.Context) { cm.refreshComment(ctx, id) })
+	}
+	return id, err
+}
+
+func (cm comments) UpdateCommentText(ctx context.Context, id int, text string) error {
+	err := cm.Comments.UpdateCommentText(ctx, id, text)
+	if err == nil {
+		cm.after(ctx, func(ctx context.Context) { cm.refreshComment(ctx, id) })
+	}
+	return err
+}
+
+func (cm comments) DisableComment(ctx context.Context, id int) error {
+	err := cm.Comments.DisableComment(ctx, id)
+	if err == nil {
+		cm.after(ctx, func(ctx context.Context) { cm.refreshComment(ctx, id) })
+	}
+	return err
+}
@@ -1,8 +1,15 @@
 package server
 
+import "time"
+
 type Config struct {
 	Host     string `yaml:"host"`
 	GrpcPort string `yaml:"grpcPort"`
+	// MetricsPort serves expvar's /debug/vars over HTTP, empty to disable
+	MetricsPort string `yaml:"metricsPort"`
+	// AccountGrace is how long a deleted account can be restored before
+	// it's purged, e.g. "720h", empty for 30 days
+	AccountGrace time.Duration `yaml:"accountGrace"`
 }
 
 var (
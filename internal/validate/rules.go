@@ -0,0 +1,284 @@
+package validate
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	minUsername = 3
+	// maxUsername and the other limits of stored text are the sizes of
+	// their columns
+	maxUsername = 50
+	maxEmail    = 255
+	minPassword = 8
+	// maxPassword is as much as bcrypt reads, in bytes
+	maxPassword = 72
+	maxTitle    = 255
+	maxPost     = 10000
+	maxComment  = 2000
+	maxDM       = 2000
+	maxQuery    = 200
+	// maxRelationships is how many users GetRelationships takes at once
+	maxRelationships = 100
+)
+
+// methodRules holds the rules of each v2 method's request, by method name
+var methodRules = map[string][]field{
+	"Login": {
+		check("username", required),
+		check("password", required),
+	},
+	"CreateUser": {
+		check("username", required, minLen(minUsername), maxLen(maxUsername), username),
+		check("email", required, maxLen(maxEmail), email),
+		check("pass", required, minLen(minPassword), maxBytes(maxPassword)),
+	},
+	"GetUser": {
+		check("username", required),
+	},
+	"SearchUsers": {
+		check("username", required, maxLen(maxUsername)),
+	},
+	"GetUserFollowers": {
+		check("username", required),
+	},
+	"GetUserFollowing": {
+		check("username", required),
+	},
+	"FollowUser": {
+		check("follower_id", required),
+		check("followed_id", required),
+	},
+	"AcceptFollow": {
+		check("follower_id", required),
+		check("followed_id", required),
+	},
+	"UnfollowUser": {
+		check("follower_id", required),
+		check("followed_id", required),
+	},
+	"RejectFollow": {
+		check("follower_id", required),
+		check("followed_id", required),
+	},
+	"CancelFollowRequest": {
+		check("follower_id", required),
+		check("followed_id", required),
+	},
+	"ListIncomingFollowRequests": {
+		check("username", required),
+	},
+	"ListOutgoingFollowRequests": {
+		check("username", required),
+	},
+	"GetRelationship": {
+		check("other_username", required),
+	},
+	"GetRelationships": {
+		check("other_usernames", maxItems(maxRelationships)),
+	},
+	"BlockUser": {
+		check("username", required),
+		check("target_username", required),
+	},
+	"UnblockUser": {
+		check("username", required),
+		check("target_username", required),
+	},
+	"MuteUser": {
+		check("username", required),
+		check("target_username", required),
+	},
+	"UnmuteUser": {
+		check("username", required),
+		check("target_username", required),
+	},
+	"UpdateUserPass": {
+		check("username", required),
+		check("pass", required, minLen(minPassword), maxBytes(maxPassword)),
+	},
+	"UpdateUser": {
+		check("user.username", required),
+		check("update_mask", required),
+		checkMasked("user.email", required, maxLen(maxEmail), email),
+		checkMasked("user.pass", required, minLen(minPassword), maxBytes(maxPassword)),
+	},
+	"DeleteUser": {
+		check("username", required),
+	},
+	"RestoreAccount": {
+		check("username", required),
+		check("password", required),
+	},
+	"SetAccountPrivacy": {
+		check("username", required),
+	},
+	"AddCloseFriend": {
+		check("username", required),
+		check("friend_username", required),
+	},
+	"RemoveCloseFriend": {
+		check("username", required),
+		check("friend_username", required),
+	},
+	"StartConversation": {
+		check("user1_id", required),
+		check("user2_id", required),
+	},
+	"ReadConversation": {
+		check("id", required),
+	},
+	"SendDM": {
+		check("conversation_id", required),
+		check("sender_id", required),
+		check("content", required, maxLen(maxDM)),
+	},
+	"GetConversationDMs": {
+		check("id", required),
+	},
+	"CreatePost": {
+		check("author_id", required),
+		check("title", required, maxLen(maxTitle)),
+		check("content", maxLen(maxPost)),
+	},
+	"GetPost": {
+		check("uuid", required),
+	},
+	"GetFeed": {
+		check("username", required),
+	},
+	"SearchPosts": {
+		check("query", required, maxLen(maxQuery)),
+	},
+	"UpdatePost": {
+		check("post.post_guid", required),
+		check("update_mask", required),
+		checkMasked("post.title", required, maxLen(maxTitle)),
+		checkMasked("post.content", maxLen(maxPost)),
+	},
+	"DeletePost": {
+		check("uuid", required),
+	},
+	"SetPostRating": {
+		check("post_id", required),
+		check("rating", vote),
+	},
+	"CreateComment": {
+		check("post_guid", required),
+		check("author_id", required),
+		check("content", required, maxLen(maxComment)),
+	},
+	"GetComment": {
+		check("id", required),
+	},
+	"SearchComments": {
+		check("query", required, maxLen(maxQuery)),
+	},
+	"UpdateComment": {
+		check("comment.id", required),
+		check("update_mask", required),
+		checkMasked("comment.content", required, maxLen(maxComment)),
+	},
+	"DeleteComment": {
+		check("id", required),
+	},
+	"SetCommentRating": {
+		check("comment_id", required),
+		check("rating", vote),
+	},
+}
+
+// required fails empty or blank strings, zero numbers and messages
+// without any field set, like an update mask without paths
+func required(v protoreflect.Value) string {
+	switch x := v.Interface().(type) {
+	case string:
+		if strings.TrimSpace(x) == "" {
+			return "is required"
+		}
+	case int32, int64:
+		if v.Int() == 0 {
+			return "is required"
+		}
+	case protoreflect.Message:
+		empty := true
+		x.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+			empty = false
+			return false
+		})
+		if empty {
+			return "is required"
+		}
+	}
+	return ""
+}
+
+func minLen(n int) rule {
+	return func(v protoreflect.Value) string {
+		if utf8.RuneCountInString(v.String()) < n {
+			return fmt.Sprintf("must be at least %d characters", n)
+		}
+		return ""
+	}
+}
+
+func maxLen(n int) rule {
+	return func(v protoreflect.Value) string {
+		if utf8.RuneCountInString(v.String()) > n {
+			return fmt.Sprintf("must be at most %d characters", n)
+		}
+		return ""
+	}
+}
+
+func maxBytes(n int) rule {
+	return func(v protoreflect.Value) string {
+		if len(v.String()) > n {
+			return fmt.Sprintf("must be at most %d bytes", n)
+		}
+		return ""
+	}
+}
+
+func maxItems(n int) rule {
+	return func(v protoreflect.Value) string {
+		if v.List().Len() > n {
+			return fmt.Sprintf("must have at most %d items", n)
+		}
+		return ""
+	}
+}
+
+// vote takes the ratings of SetPostRating and SetCommentRating
+func vote(v protoreflect.Value) string {
+	if r := v.Int(); r < -1 || r > 1 {
+		return "must be 1, -1 or 0"
+	}
+	return ""
+}
+
+var usernameChars = regexp.MustCompile(`^[A-Za-z0-9_.]+$`)
+
+func username(v protoreflect.Value) string {
+	if !usernameChars.MatchString(v.String()) {
+		return "may only hold letters, digits, '_' and '.'"
+	}
+	return ""
+}
+
+// email takes a bare address with a dotted domain, like name@example.com,
+// without a display name or angle brackets
+func email(v protoreflect.Value) string {
+	s := v.String()
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Address != s || !strings.Contains(s[strings.LastIndex(s, "@"):], ".") {
+		return "is not a valid email address"
+	}
+	return ""
+}
@@ -0,0 +1,136 @@
+// Package validate checks requests against the rules of their method
+// before they reach the handlers. The rules are declared per method in
+// rules.go, a request breaking any of them fails with InvalidArgument and
+// every field at fault, see apperr.Invalid.
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Anacardo89/lenic_api/internal/apperr"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// rule checks the value of a field and returns why it's invalid, or ""
+type rule func(v protoreflect.Value) string
+
+// field is a field of a request and the rules it must pass, in order. The
+// path of a field of a nested message is dotted, like "post.title", only
+// the last field on it may be repeated
+type field struct {
+	path  string
+	rules []rule
+	// masked is set for the fields of an update, they're only checked
+	// when the update_mask of the request lists them
+	masked bool
+}
+
+func check(path string, rules ...rule) field {
+	return field{path: path, rules: rules}
+}
+
+func checkMasked(path string, rules ...rule) field {
+	return field{path: path, rules: rules, masked: true}
+}
+
+// Request returns an error listing the fields of req that break the rules
+// of method, a full v2 method name. It's nil for a valid request and for
+// methods without rules
+func Request(method string, req proto.Message) error {
+	fields, ok := methodRules[method[strings.LastIndex(method, "/")+1:]]
+	if !ok {
+		return nil
+	}
+	m := req.ProtoReflect()
+	violations := []apperr.Violation{}
+	for _, f := range fields {
+		if f.masked && !inMask(m, f.path) {
+			continue
+		}
+		v := value(m, f.path)
+		for _, r := range f.rules {
+			if why := r(v); why != "" {
+				violations = append(violations, apperr.Violation{Field: f.path, Description: why})
+				break
+			}
+		}
+	}
+	if len(violations) > 0 {
+		return apperr.Invalid(violations...)
+	}
+	return nil
+}
+
+// value returns the value at path in m, the default value if a message on
+// the way isn't set
+func value(m protoreflect.Message, path string) protoreflect.Value {
+	names := strings.Split(path, ".")
+	for _, name := range names[:len(names)-1] {
+		m = m.Get(m.Descriptor().Fields().ByName(protoreflect.Name(name))).Message()
+	}
+	return m.Get(m.Descriptor().Fields().ByName(protoreflect.Name(names[len(names)-1])))
+}
+
+// inMask reports whether the update_mask of m lists path, without the
+// name of the resource it starts with
+func inMask(m protoreflect.Message, path string) bool {
+	mask := m.Get(m.Descriptor().Fields().ByName("update_mask")).Message()
+	paths := mask.Get(mask.Descriptor().Fields().ByName("paths")).List()
+	_, name, _ := strings.Cut(path, ".")
+	for i := 0; i < paths.Len(); i++ {
+		if paths.Get(i).String() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRules makes sure every method and path in methodRules exists in the
+// v2 service, so a typo fails on startup instead of skipping a check
+func checkRules() error {
+	service := pb.File_lenic_v2_proto.Services().ByName("Lenic")
+	for name, fields := range methodRules {
+		method := service.Methods().ByName(protoreflect.Name(name))
+		if method == nil {
+			return fmt.Errorf("validate: no method %s", name)
+		}
+		for _, f := range fields {
+			if err := checkPath(method.Input(), f); err != nil {
+				return fmt.Errorf("validate: %s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func checkPath(md protoreflect.MessageDescriptor, f field) error {
+	if f.masked && md.Fields().ByName("update_mask") == nil {
+		return fmt.Errorf("%s is masked but there's no update_mask", f.path)
+	}
+	names := strings.Split(f.path, ".")
+	for i, name := range names {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return fmt.Errorf("no field %s", f.path)
+		}
+		if i < len(names)-1 {
+			if fd.IsList() || fd.IsMap() {
+				return fmt.Errorf("%s is repeated", name)
+			}
+			if fd.Message() == nil {
+				return fmt.Errorf("%s is not a message", name)
+			}
+			md = fd.Message()
+		}
+	}
+	return nil
+}
+
+func init() {
+	if err := checkRules(); err != nil {
+		panic(err)
+	}
+}
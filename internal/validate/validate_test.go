@@ -0,0 +1,98 @@
+package validate
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Anacardo89/lenic_api/internal/apperr"
+	"github.com/Anacardo89/lenic_api/internal/pb/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// faults returns the fields Request finds at fault in req
+func faults(t *testing.T, method string, req proto.Message) []string {
+	t.Helper()
+	err := Request("/lenic.v2.Lenic/"+method, req)
+	if err == nil {
+		return nil
+	}
+	var e *apperr.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("%s returned %v, want an *apperr.Error", method, err)
+	}
+	fields := []string{}
+	for _, v := range e.Violations {
+		fields = append(fields, v.Field)
+	}
+	return fields
+}
+
+func mask(paths ...string) *fieldmaskpb.FieldMask {
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func TestRequest(t *testing.T) {
+	for _, c := range []struct {
+		name   string
+		method string
+		req    proto.Message
+		faults []string
+	}{
+		{"valid user", "CreateUser", &pb.User{Username: "ann.b_1", Email: "ann@example.com", Pass: "password"}, nil},
+		{"every field wrong", "CreateUser", &pb.User{Username: "a!", Email: "Ann <ann@example.com>", Pass: "short"}, []string{"username", "email", "pass"}},
+		{"long password", "CreateUser", &pb.User{Username: "ann", Email: "ann@example.com", Pass: strings.Repeat("é", 40)}, []string{"pass"}},
+		{"undotted domain", "CreateUser", &pb.User{Username: "ann", Email: "ann@localhost", Pass: "password"}, []string{"email"}},
+		{"blank title", "CreatePost", &pb.Post{AuthorId: 1, Title: "  "}, []string{"title"}},
+		{"no author", "CreatePost", &pb.Post{Title: "title"}, []string{"author_id"}},
+		{"long comment", "CreateComment", &pb.Comment{PostGuid: "guid", AuthorId: 1, Content: strings.Repeat("a", maxComment+1)}, []string{"content"}},
+		{"up vote", "SetPostRating", &pb.SetPostRatingRequest{PostId: 1, Rating: 1}, nil},
+		{"vote taken back", "SetCommentRating", &pb.SetCommentRatingRequest{CommentId: 1, Rating: 0}, nil},
+		{"vote of 2", "SetPostRating", &pb.SetPostRatingRequest{PostId: 1, Rating: 2}, []string{"rating"}},
+		{"vote without comment", "SetCommentRating", &pb.SetCommentRatingRequest{Rating: -2}, []string{"comment_id", "rating"}},
+		{"too many users", "GetRelationships", &pb.GetRelationshipsRequest{OtherUsernames: make([]string, maxRelationships+1)}, []string{"other_usernames"}},
+		{"enough users", "GetRelationships", &pb.GetRelationshipsRequest{OtherUsernames: make([]string, maxRelationships)}, nil},
+		{"method without rules", "GetUserPosts", &pb.GetUserPostsRequest{}, nil},
+	} {
+		if got := faults(t, c.method, c.req); !slices.Equal(got, c.faults) {
+			t.Errorf("%s: faults are %q, want %q", c.name, got, c.faults)
+		}
+	}
+}
+
+func TestMaskedFields(t *testing.T) {
+	for _, c := range []struct {
+		name   string
+		req    *pb.UpdatePostRequest
+		faults []string
+	}{
+		{"title only", &pb.UpdatePostRequest{Post: &pb.Post{PostGuid: "guid", Title: "title"}, UpdateMask: mask("title")}, nil},
+		{"empty title left out", &pb.UpdatePostRequest{Post: &pb.Post{PostGuid: "guid"}, UpdateMask: mask("content")}, nil},
+		{"empty title written", &pb.UpdatePostRequest{Post: &pb.Post{PostGuid: "guid"}, UpdateMask: mask("title")}, []string{"post.title"}},
+		{"no mask", &pb.UpdatePostRequest{Post: &pb.Post{PostGuid: "guid", Title: "title"}}, []string{"update_mask"}},
+		{"mask without paths", &pb.UpdatePostRequest{Post: &pb.Post{PostGuid: "guid", Title: "title"}, UpdateMask: mask()}, []string{"update_mask"}},
+		{"no post", &pb.UpdatePostRequest{UpdateMask: mask("title")}, []string{"post.post_guid", "post.title"}},
+	} {
+		if got := faults(t, "UpdatePost", c.req); !slices.Equal(got, c.faults) {
+			t.Errorf("%s: faults are %q, want %q", c.name, got, c.faults)
+		}
+	}
+}
+
+func TestCheckPath(t *testing.T) {
+	md := (&pb.GetRelationshipsRequest{}).ProtoReflect().Descriptor()
+	if err := checkPath(md, check("other_usernames", maxItems(1))); err != nil {
+		t.Errorf("a repeated last field failed: %v", err)
+	}
+	if err := checkPath(md, check("other_usernames.length")); err == nil {
+		t.Error("a path through a repeated field passed")
+	}
+	if err := checkPath(md, checkMasked("other_usernames")); err == nil {
+		t.Error("a masked field without an update_mask passed")
+	}
+	if err := checkRules(); err != nil {
+		t.Error(err)
+	}
+}
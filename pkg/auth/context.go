@@ -0,0 +1,16 @@
+package auth
+
+import "context"
+
+type claimsKey struct{}
+
+// NewContext returns a copy of ctx carrying the claims of the caller
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// FromContext returns the claims stored in ctx by the auth interceptors
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
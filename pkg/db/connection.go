@@ -3,8 +3,7 @@ package db
 import (
 	"database/sql"
 	"fmt"
-
-	_ "github.com/go-sql-driver/mysql"
+	"time"
 )
 
 var (
@@ -13,18 +12,73 @@ var (
 )
 
 type Config struct {
+	// Driver is mysql, postgres or sqlite, empty means mysql
+	Driver string `yaml:"driver"`
 	DBHost string `yaml:"dbHost"`
 	DBPort string `yaml:"dbPort"`
 	DBUser string `yaml:"dbUser"`
 	DBPass string `yaml:"dbPass"`
-	Dbase  string `yaml:"dbase"`
+	// Dbase is the database name, or the file path for sqlite
+	Dbase string `yaml:"dbase"`
+	// QueryTimeout bounds each query, e.g. "5s", empty for no limit
+	QueryTimeout time.Duration `yaml:"queryTimeout"`
+	// Replicas are the DSNs of read replicas of the database, in the
+	// format of the driver
+	Replicas []string `yaml:"replicas"`
+	// PrimaryPin is how long the reads of a user who wrote stay on the
+	// primary, e.g. "5s", empty for the default
+	PrimaryPin time.Duration `yaml:"primaryPin"`
+	// CacheSize is how many users, posts and comments each are kept in
+	// memory between requests, 0 turns the cache off
+	CacheSize int `yaml:"cacheSize"`
+	// CacheTTL is how long a cached entry is trusted, e.g. "1m", empty
+	// keeps it until it's evicted
+	CacheTTL time.Duration `yaml:"cacheTTL"`
 }
 
 func LoginDB(db *Config) (*sql.DB, error) {
-	dbConn := fmt.Sprintf("%s:%s@tcp(%s)/%s", db.DBUser, db.DBPass, db.DBHost, db.Dbase)
-	database, err := sql.Open("mysql", dbConn)
+	d, err := db.Dialect()
+	if err != nil {
+		return nil, err
+	}
+	database, err := sql.Open(d.Driver(), d.dsn(db))
 	if err != nil {
 		return nil, err
 	}
 	return database, nil
 }
+
+// LoginReplicas opens the read replicas of db
+func LoginReplicas(db *Config) ([]*sql.DB, error) {
+	d, err := db.Dialect()
+	if err != nil {
+		return nil, err
+	}
+	replicas := []*sql.DB{}
+	for _, dsn := range db.Replicas {
+		database, err := sql.Open(d.Driver(), dsn)
+		if err != nil {
+			return nil, err
+		}
+		replicas = append(replicas, database)
+	}
+	return replicas, nil
+}
+
+// ParseTime reads a date scanned into an interface{}, mysql hands them
+// over as bytes, postgres and sqlite as time.Time, or as a string when
+// sqlite can't tell a computed column is a date
+func ParseTime(v interface{}) (time.Time, error) {
+	switch t := v.(type) {
+	case time.Time:
+		return t.UTC(), nil
+	case []byte:
+		return time.Parse(DateLayout, string(t))
+	case string:
+		return time.Parse(DateLayout, t)
+	case nil:
+		return time.Time{}, nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported date type %T", v)
+	}
+}
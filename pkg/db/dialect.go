@@ -0,0 +1,197 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+// Dialect covers what the data layer has to spell differently for each
+// supported database. Queries are written with ? placeholders and in the
+// SQL the three databases share, except for upserts.
+type Dialect interface {
+	// Name is the name used in Config.Driver
+	Name() string
+	// Driver is the database/sql driver name
+	Driver() string
+	// Rebind rewrites the ? placeholders of q to the dialect's syntax
+	Rebind(q string) string
+	// OnConflict reports whether upserts are spelled ON CONFLICT instead of
+	// ON DUPLICATE KEY UPDATE
+	OnConflict() bool
+	// ReturningId reports whether the id of an inserted row has to be read
+	// with RETURNING id, for drivers that don't support LastInsertId
+	ReturningId() bool
+	// ForUpdate reports whether a read that's about to be written back
+	// locks its rows with SELECT ... FOR UPDATE. Without it the
+	// transaction has to hold the write lock from the start
+	ForUpdate() bool
+	// Retryable reports whether err aborted a transaction that can be run
+	// again as is, such as a deadlock victim
+	Retryable(err error) bool
+	// Duplicate reports whether err is a write that broke a unique key
+	Duplicate(err error) bool
+	// Canceled reports whether err is a query the database cancelled,
+	// for drivers that don't return the error of the query's context
+	// when it ends
+	Canceled(err error) bool
+	// FormatTime formats t as a query argument compared against the
+	// timestamp columns, to the precision the dialect stores them with
+	FormatTime(t time.Time) string
+
+	dsn(c *Config) string
+}
+
+// Dialect returns the dialect of the configured driver
+func (c *Config) Dialect() (Dialect, error) {
+	switch c.Driver {
+	case "", "mysql":
+		return mysqlDialect{}, nil
+	case "postgres":
+		return postgresDialect{}, nil
+	case "sqlite":
+		return sqliteDialect{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", c.Driver)
+	}
+}
+
+func (c *Config) hostPort() string {
+	if c.DBPort == "" {
+		return c.DBHost
+	}
+	return net.JoinHostPort(c.DBHost, c.DBPort)
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) Name() string           { return "mysql" }
+func (mysqlDialect) Driver() string         { return "mysql" }
+func (mysqlDialect) Rebind(q string) string { return q }
+func (mysqlDialect) OnConflict() bool       { return false }
+func (mysqlDialect) ReturningId() bool      { return false }
+func (mysqlDialect) ForUpdate() bool        { return true }
+
+// Retryable catches ER_LOCK_DEADLOCK, InnoDB has already rolled back the
+// transaction
+func (mysqlDialect) Retryable(err error) bool {
+	var e *mysql.MySQLError
+	return errors.As(err, &e) && e.Number == 1213
+}
+
+// Duplicate catches ER_DUP_ENTRY
+func (mysqlDialect) Duplicate(err error) bool {
+	var e *mysql.MySQLError
+	return errors.As(err, &e) && e.Number == 1062
+}
+
+// Canceled is never true, the driver returns the error of the context
+func (mysqlDialect) Canceled(err error) bool { return false }
+
+func (mysqlDialect) FormatTime(t time.Time) string { return t.UTC().Format(DateLayout) }
+
+func (mysqlDialect) dsn(c *Config) string {
+	return fmt.Sprintf("%s:%s@tcp(%s)/%s", c.DBUser, c.DBPass, c.hostPort(), c.Dbase)
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string      { return "postgres" }
+func (postgresDialect) Driver() string    { return "postgres" }
+func (postgresDialect) OnConflict() bool  { return true }
+func (postgresDialect) ReturningId() bool { return true }
+func (postgresDialect) ForUpdate() bool   { return true }
+
+// Rebind numbers the placeholders, $1, $2..., leaving quoted ? alone
+func (postgresDialect) Rebind(q string) string {
+	var b strings.Builder
+	n := 0
+	quoted := false
+	for _, r := range q {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == '?' && !quoted:
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Retryable catches deadlock_detected and serialization_failure
+func (postgresDialect) Retryable(err error) bool {
+	var e *pq.Error
+	return errors.As(err, &e) && (e.Code == "40P01" || e.Code == "40001")
+}
+
+// Duplicate catches unique_violation
+func (postgresDialect) Duplicate(err error) bool {
+	var e *pq.Error
+	return errors.As(err, &e) && e.Code == "23505"
+}
+
+// Canceled catches query_canceled, which the driver returns when it
+// cancels a query whose context ended, and statement timeouts
+func (postgresDialect) Canceled(err error) bool {
+	var e *pq.Error
+	return errors.As(err, &e) && e.Code == "57014"
+}
+
+// FormatTime keeps the microseconds postgres stores timestamps with
+func (postgresDialect) FormatTime(t time.Time) string {
+	return t.UTC().Format(DateLayout + ".999999")
+}
+
+func (postgresDialect) dsn(c *Config) string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.DBUser, c.DBPass),
+		Host:     c.hostPort(),
+		Path:     "/" + c.Dbase,
+		RawQuery: "sslmode=disable",
+	}
+	return u.String()
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string           { return "sqlite" }
+func (sqliteDialect) Driver() string         { return "sqlite3" }
+func (sqliteDialect) Rebind(q string) string { return q }
+func (sqliteDialect) OnConflict() bool       { return true }
+func (sqliteDialect) ReturningId() bool      { return false }
+func (sqliteDialect) ForUpdate() bool        { return false }
+
+// Retryable catches a write lock that outlived the busy timeout
+func (sqliteDialect) Retryable(err error) bool {
+	var e sqlite3.Error
+	return errors.As(err, &e) && (e.Code == sqlite3.ErrBusy || e.Code == sqlite3.ErrLocked)
+}
+
+func (sqliteDialect) Duplicate(err error) bool {
+	var e sqlite3.Error
+	return errors.As(err, &e) && (e.ExtendedCode == sqlite3.ErrConstraintUnique || e.ExtendedCode == sqlite3.ErrConstraintPrimaryKey)
+}
+
+// Canceled is never true, the driver returns the error of the context
+func (sqliteDialect) Canceled(err error) bool { return false }
+
+func (sqliteDialect) FormatTime(t time.Time) string { return t.UTC().Format(DateLayout) }
+
+// dsn turns on foreign keys, which the cascades rely on, and waits for
+// locks instead of failing right away when requests write concurrently.
+// Transactions take the write lock as they begin, there's no FOR UPDATE
+func (sqliteDialect) dsn(c *Config) string {
+	return "file:" + c.Dbase + "?_foreign_keys=1&_busy_timeout=5000&_journal_mode=WAL&_txlock=immediate"
+}
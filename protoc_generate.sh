@@ -1,4 +1,4 @@
 #!/bin/sh
 
 rm -rf pb
-protoc --go_out=. --go-grpc_out=. lenic.proto
\ No newline at end of file
+protoc --go_out=. --go-grpc_out=. lenic.proto lenic_v2.proto
\ No newline at end of file